@@ -26,11 +26,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/model"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/prometheus-operator/prometheus-operator/internal/goruntime"
 	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
 	"github.com/prometheus-operator/prometheus-operator/internal/metrics"
 	"github.com/prometheus-operator/prometheus-operator/pkg/admission"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
@@ -45,6 +48,9 @@ func main() {
 		logConfig            logging.Config
 		memlimitRatio        float64
 		nameValidationScheme string
+
+		enableConfigResourcesValidation bool
+		apiServer                       string
 	)
 
 	server.RegisterFlags(flagset, &serverConfig)
@@ -53,6 +59,8 @@ func main() {
 
 	flagset.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultGOMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
 	flagset.StringVar(&nameValidationScheme, "name-validation-scheme", defaultValidationScheme, "The name validation scheme to use ('legacy' or 'utf8').")
+	flagset.BoolVar(&enableConfigResourcesValidation, "enable-config-resources-validation", false, "Enable the validation endpoints for ServiceMonitor, PodMonitor, Probe and ScrapeConfig objects. It requires read access to the Namespace, Secret, ConfigMap, Prometheus, PrometheusAgent and ScrapeClassDefinition objects.")
+	flagset.StringVar(&apiServer, "apiserver", "", "API Server addr, e.g. ' - NOT RECOMMENDED FOR PRODUCTION - http://127.0.0.1:8080'. Omit parameter to run in on-cluster mode and utilize the service account token. Only used when --enable-config-resources-validation is set.")
 
 	_ = flagset.Parse(os.Args[1:])
 
@@ -85,8 +93,37 @@ func main() {
 	defer cancel()
 	wg, ctx := errgroup.WithContext(ctx)
 
+	var opts []admission.Option
+	if enableConfigResourcesValidation {
+		restConfig, err := k8sutil.NewClusterConfig(k8sutil.ClusterConfig{
+			Host: apiServer,
+		})
+		if err != nil {
+			logger.Error("failed to create Kubernetes client configuration", "err", err)
+			os.Exit(1)
+		}
+
+		kclient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create Kubernetes client", "err", err)
+			os.Exit(1)
+		}
+
+		mclient, err := monitoringclient.NewForConfig(restConfig)
+		if err != nil {
+			logger.Error("failed to create monitoring client", "err", err)
+			os.Exit(1)
+		}
+
+		opts = append(opts, admission.WithConfigResourcesValidation(kclient, mclient))
+	}
+
 	mux := http.NewServeMux()
-	admit := admission.New(logger.With("component", "admissionwebhook"), validationScheme)
+	admit := admission.New(logger.With("component", "admissionwebhook"), validationScheme, opts...)
+	if err := admit.Start(ctx); err != nil {
+		logger.Error("failed to start the admission webhook informers", "err", err)
+		os.Exit(1)
+	}
 	admit.Register(mux)
 
 	r := metrics.NewRegistry("prometheus_operator_admission_webhook")
@@ -34,6 +34,7 @@ import (
 	"github.com/prometheus/common/version"
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	eventsv1 "k8s.io/api/events/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -52,13 +53,18 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/kubelet"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
 	thanoscontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos"
+	thanoscompactorcontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/compactor"
+	thanosqueriercontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/querier"
+	thanosstorecontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos/store"
 	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
 )
 
@@ -98,6 +104,41 @@ func checkPrerequisites(
 	return true, nil
 }
 
+// newSecretProviders returns the secret providers enabled by the command-line
+// flags or nil if none is enabled.
+func newSecretProviders(logger *slog.Logger) (*assets.SecretProviders, error) {
+	if secretProviderFileDir == "" && secretProviderVaultAddress == "" {
+		return nil, nil
+	}
+
+	if secretProviderRefreshInterval <= 0 {
+		return nil, fmt.Errorf("invalid refresh interval %s", secretProviderRefreshInterval)
+	}
+
+	sp := assets.NewSecretProviders(logger.With("component", "secret-providers"), secretProviderRefreshInterval)
+
+	if secretProviderFileDir != "" {
+		if err := sp.Register("file", assets.NewFileSecretProvider(secretProviderFileDir)); err != nil {
+			return nil, err
+		}
+		logger.Info("secret provider enabled", "provider", "file", "dir", secretProviderFileDir)
+	}
+
+	if secretProviderVaultAddress != "" {
+		vp, err := assets.NewVaultSecretProvider(secretProviderVaultAddress, secretProviderVaultPathPrefix, secretProviderVaultTokenFile, &http.Client{Timeout: 10 * time.Second})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := sp.Register("vault", vp); err != nil {
+			return nil, err
+		}
+		logger.Info("secret provider enabled", "provider", "vault", "address", secretProviderVaultAddress, "path_prefix", secretProviderVaultPathPrefix)
+	}
+
+	return sp, nil
+}
+
 const (
 	defaultReloaderCPU    = "10m"
 	defaultReloaderMemory = "50Mi"
@@ -113,10 +154,12 @@ var (
 	impersonateUser string
 	apiServer       string
 	tlsClientConfig rest.TLSClientConfig
+	dryRun          bool
 
 	memlimitRatio float64
 
-	serverConfig = server.DefaultConfig(":8080", false)
+	serverConfig   = server.DefaultConfig(":8080", false)
+	enableDebugAPI bool
 
 	disableUnmanagedPrometheusConfiguration bool
 
@@ -128,16 +171,25 @@ var (
 	kubeletEndpointSlice bool
 	kubeletSyncPeriod    time.Duration
 
+	// Parameters for the secret providers.
+	secretProviderFileDir         string
+	secretProviderVaultAddress    string
+	secretProviderVaultPathPrefix string
+	secretProviderVaultTokenFile  string
+	secretProviderRefreshInterval time.Duration
+
 	featureGates = k8sflag.NewMapStringBool(ptr.To(map[string]bool{}))
 )
 
 func parseFlags(fs *flag.FlagSet) {
 	// Web server settings.
 	server.RegisterFlags(fs, &serverConfig)
+	fs.BoolVar(&enableDebugAPI, "enable-debug-api", false, "Expose the last generated configuration and the resource selection of the Prometheus and PrometheusAgent objects under the /debug/ path of the web server. The secrets are redacted but the information may still be sensitive.")
 
 	// Kubernetes client-go settings.
 	fs.StringVar(&impersonateUser, "as", "", "Username to impersonate. User could be a regular user or a service account in a namespace.")
 	fs.StringVar(&apiServer, "apiserver", "", "API Server addr, e.g. ' - NOT RECOMMENDED FOR PRODUCTION - http://127.0.0.1:8080'. Omit parameter to run in on-cluster mode and utilize the service account token.")
+	fs.BoolVar(&dryRun, "dry-run", false, "Run the operator in dry-run mode: all create, update, patch and delete requests to the Kubernetes API are executed with server-side dry-run and the changes which would have been applied are logged instead of being persisted.")
 	fs.StringVar(&tlsClientConfig.CertFile, "cert-file", "", " - NOT RECOMMENDED FOR PRODUCTION - Path to public TLS certificate file.")
 	fs.StringVar(&tlsClientConfig.KeyFile, "key-file", "", "- NOT RECOMMENDED FOR PRODUCTION - Path to private TLS certificate file.")
 	fs.StringVar(&tlsClientConfig.CAFile, "ca-file", "", "- NOT RECOMMENDED FOR PRODUCTION - Path to TLS CA file.")
@@ -150,6 +202,12 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&kubeletEndpoints, "kubelet-endpoints", true, "Create Endpoints objects for kubelet targets.")
 	fs.DurationVar(&kubeletSyncPeriod, "kubelet-sync-period", 3*time.Minute, "How often the operator reconciles the kubelet Endpoints and EndpointSlice objects (e.g., 10s, 2m, 1h30m).")
 
+	fs.StringVar(&secretProviderFileDir, "secret-provider-file-dir", "", "Directory from which the \"file\" secret provider reads secrets (e.g. a volume mounted by the Secrets Store CSI driver). Secret key selectors reference the provider with \"file:<name>\" and the value is read from the \"<dir>/<namespace>/<name>/<key>\" file, where <namespace> is the namespace of the resource. If empty, the provider is disabled.")
+	fs.StringVar(&secretProviderVaultAddress, "secret-provider-vault-address", "", "Address of the Vault-compatible HTTP endpoint (e.g. a Vault agent running alongside the operator) from which the \"vault\" secret provider reads secrets. Secret key selectors reference the provider with \"vault:<path>\". If empty, the provider is disabled.")
+	fs.StringVar(&secretProviderVaultPathPrefix, "secret-provider-vault-path-prefix", "secret/data", "Path prefix of the secrets read by the \"vault\" secret provider. The \"vault:<path>\" reference of a resource is read from the \"<prefix>/<namespace>/<path>\" path in the Vault API, where <namespace> is the namespace of the resource.")
+	fs.StringVar(&secretProviderVaultTokenFile, "secret-provider-vault-token-file", "", "File containing the token used to authenticate the requests of the \"vault\" secret provider. If empty, the requests aren't authenticated.")
+	fs.DurationVar(&secretProviderRefreshInterval, "secret-provider-refresh-interval", time.Minute, "How often the operator refreshes the secrets read from the secret providers (e.g., 30s, 2m).")
+
 	// The Prometheus config reloader image is released along with the
 	// Prometheus Operator image, tagged with the same semver version. Default to
 	// the Prometheus Operator version if no Prometheus config reloader image is
@@ -171,7 +229,7 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.Var(cfg.Namespaces.PrometheusAllowList, "prometheus-instance-namespaces", "Namespaces where Prometheus and PrometheusAgent custom resources and corresponding Secrets, Configmaps and StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for Prometheus custom resources.")
 	fs.Var(cfg.Namespaces.AlertmanagerAllowList, "alertmanager-instance-namespaces", "Namespaces where Alertmanager custom resources and corresponding StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for Alertmanager custom resources.")
 	fs.Var(cfg.Namespaces.AlertmanagerConfigAllowList, "alertmanager-config-namespaces", "Namespaces where AlertmanagerConfig custom resources and corresponding Secrets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for AlertmanagerConfig custom resources.")
-	fs.Var(cfg.Namespaces.ThanosRulerAllowList, "thanos-ruler-instance-namespaces", "Namespaces where ThanosRuler custom resources and corresponding StatefulSets are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for ThanosRuler custom resources.")
+	fs.Var(cfg.Namespaces.ThanosRulerAllowList, "thanos-ruler-instance-namespaces", "Namespaces where ThanosRuler, ThanosQuerier, ThanosCompactor and ThanosStore custom resources and corresponding StatefulSets/Deployments are watched/created. If set this takes precedence over --namespaces or --deny-namespaces for ThanosRuler, ThanosQuerier, ThanosCompactor and ThanosStore custom resources.")
 	fs.BoolVar(&cfg.WatchObjectRefsInAllNamespaces, "watch-referenced-objects-in-all-namespaces", false, "When true the operator watches for configmaps and secrets in both workload and configuration resource namespaces.\nWhen false (default), the operator will only watch for secrets and configmaps in:\n* Workload namespaces for Prometheus and PrometheusAgent resources.\n* Configuration namespaces for Alertmanager resources.")
 
 	fs.Var(&cfg.Annotations, "annotations", "Annotations to be add to all resources created by the operator")
@@ -238,6 +296,52 @@ func checkStatusSubresourcePermissions(
 	return ok
 }
 
+// checkTargetDiscoveryPermissions returns true if the operator can watch the
+// Services, EndpointSlices and Pods to compute the target discovery preview
+// of the ServiceMonitor and PodMonitor objects.
+func checkTargetDiscoveryPermissions(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+) bool {
+	allowed, errs, err := k8sutil.IsAllowed(
+		ctx,
+		kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+		cfg.Namespaces.AllowList.Slice(),
+		k8sutil.ResourceAttribute{
+			Group:    v1.GroupName,
+			Version:  v1.SchemeGroupVersion.Version,
+			Resource: string(v1.ResourceServices),
+			Verbs:    []string{"list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    discoveryv1.GroupName,
+			Version:  discoveryv1.SchemeGroupVersion.Version,
+			Resource: "endpointslices",
+			Verbs:    []string{"list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    v1.GroupName,
+			Version:  v1.SchemeGroupVersion.Version,
+			Resource: string(v1.ResourcePods),
+			Verbs:    []string{"list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check permissions for the target discovery preview", "err", err)
+		return false
+	}
+
+	if !allowed {
+		for _, reason := range errs {
+			logger.Error(fmt.Sprintf("missing permission required by the %s feature gate", operator.TargetDiscoveryStatusFeature), "reason", reason)
+		}
+		return false
+	}
+
+	return true
+}
+
 func run(fs *flag.FlagSet) int {
 	parseFlags(fs)
 
@@ -292,6 +396,17 @@ func run(fs *flag.FlagSet) int {
 		return 1
 	}
 
+	if dryRun {
+		logger.Warn("Dry-run mode enabled, changes to Kubernetes objects won't be persisted")
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return k8sutil.NewDryRunRoundTripper(
+				logger,
+				rt,
+				k8sutil.WithDryRunCompressedSecretKey(prompkg.ConfigFilename, prompkg.RedactConfiguration),
+			)
+		})
+	}
+
 	kclient, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		logger.Error("failed to create Kubernetes client", "err", err)
@@ -315,12 +430,36 @@ func run(fs *flag.FlagSet) int {
 	}
 	logger.Info("connection established", "kubernetes_version", cfg.KubernetesVersion.String())
 
+	secretProviders, err := newSecretProviders(logger)
+	if err != nil {
+		logger.Error("failed to configure the secret providers", "err", err)
+		cancel()
+		return 1
+	}
+
 	var (
 		alertmanagerControllerOptions = []alertmanagercontroller.ControllerOption{}
 		promAgentControllerOptions    = []prometheusagentcontroller.ControllerOption{}
 		promControllerOptions         = []prometheuscontroller.ControllerOption{}
 		thanosControllerOptions       = []thanoscontroller.ControllerOption{}
+		thanosCompactorOptions        = []thanoscompactorcontroller.ControllerOption{}
+		thanosStoreOptions            = []thanosstorecontroller.ControllerOption{}
+
+		// A nil debug store discards all the information.
+		debugStore *operator.DebugStore
 	)
+	if enableDebugAPI {
+		debugStore = operator.NewDebugStore()
+	}
+	promControllerOptions = append(promControllerOptions, prometheuscontroller.WithDebugStore(debugStore))
+	promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithDebugStore(debugStore))
+
+	if secretProviders != nil {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithSecretProviders(secretProviders))
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithSecretProviders(secretProviders))
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithSecretProviders(secretProviders))
+	}
+
 	if disableUnmanagedPrometheusConfiguration {
 		logger.Info("Disabling support for unmanaged Prometheus configurations")
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithoutUnmanagedConfiguration())
@@ -350,6 +489,8 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithStorageClassValidation())
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithStorageClassValidation())
 		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithStorageClassValidation())
+		thanosCompactorOptions = append(thanosCompactorOptions, thanoscompactorcontroller.WithStorageClassValidation())
+		thanosStoreOptions = append(thanosStoreOptions, thanosstorecontroller.WithStorageClassValidation())
 	}
 
 	canEmitEvents, reasons, err := k8sutil.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), nil,
@@ -396,6 +537,131 @@ func run(fs *flag.FlagSet) int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithScrapeConfig())
 	}
 
+	monitoringQuotaSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.MonitoringQuotaName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.MonitoringQuotaName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check MonitoringQuota support", "err", err)
+		cancel()
+		return 1
+	}
+	if monitoringQuotaSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithMonitoringQuota())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithMonitoringQuota())
+	}
+
+	scrapeClassDefinitionSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ScrapeClassDefinitionName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ScrapeClassDefinitionName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ScrapeClassDefinition support", "err", err)
+		cancel()
+		return 1
+	}
+	if scrapeClassDefinitionSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithScrapeClassDefinition())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithScrapeClassDefinition())
+	}
+
+	referenceGrantSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ReferenceGrantName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ReferenceGrantName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ReferenceGrant support", "err", err)
+		cancel()
+		return 1
+	}
+	if referenceGrantSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithReferenceGrant())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithReferenceGrant())
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithReferenceGrant())
+	}
+
+	alertmanagerSilenceSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.AlertmanagerSilenceName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.AlertmanagerSilenceName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.AlertmanagerSilenceName),
+			Verbs:    []string{"update"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check AlertmanagerSilence support", "err", err)
+		cancel()
+		return 1
+	}
+	if alertmanagerSilenceSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithAlertmanagerSilence())
+	}
+
+	clusterAlertmanagerConfigSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		[]string{v1.NamespaceAll},
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ClusterAlertmanagerConfigName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ClusterAlertmanagerConfigName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ClusterAlertmanagerConfig support", "err", err)
+		cancel()
+		return 1
+	}
+	if clusterAlertmanagerConfigSupported {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithClusterAlertmanagerConfig())
+	}
+
 	// EndpointSlice v1 became available with Kubernetes v1.21.0.
 	endpointSliceSupported := cfg.KubernetesVersion.GTE(semver.MustParse("1.21.0"))
 	logger.Info("Kubernetes API capabilities", "endpointslices", endpointSliceSupported)
@@ -449,6 +715,19 @@ func run(fs *flag.FlagSet) int {
 			}
 
 			promControllerOptions = append(promControllerOptions, prometheuscontroller.WithConfigResourceStatus())
+
+			if cfg.Gates.Enabled(operator.TargetDiscoveryStatusFeature) {
+				// The preview requires cluster-wide (or allowed namespaces)
+				// caches of Services, EndpointSlices and Pods.
+				if !checkTargetDiscoveryPermissions(ctx, logger, kclient) {
+					cancel()
+					return 1
+				}
+
+				promControllerOptions = append(promControllerOptions, prometheuscontroller.WithTargetDiscoveryStatus())
+			}
+		} else if cfg.Gates.Enabled(operator.TargetDiscoveryStatusFeature) {
+			logger.Warn(fmt.Sprintf("the %s feature gate has no effect unless the %s feature gate is enabled", operator.TargetDiscoveryStatusFeature, operator.StatusForConfigurationResourcesFeature))
 		}
 
 		po, err = prometheuscontroller.New(ctx, restConfig, cfg, logger, r, promControllerOptions...)
@@ -568,7 +847,18 @@ func run(fs *flag.FlagSet) int {
 	var ao *alertmanagercontroller.Operator
 	if alertmanagerSupported {
 		if cfg.Gates.Enabled(operator.StatusForConfigurationResourcesFeature) {
-			// TODO: check permissions when implementing the AlertmanagerConfig status subresource.
+			if !checkStatusSubresourcePermissions(
+				ctx,
+				logger,
+				kclient,
+				[]schema.GroupVersionResource{
+					monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName),
+				},
+			) {
+				cancel()
+				return 1
+			}
+
 			alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithConfigResourceStatus())
 		}
 
@@ -632,6 +922,132 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
+	thanosQuerierSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosQuerierName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosQuerierName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosQuerierName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "deployments",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosQuerier support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tqo *thanosqueriercontroller.Operator
+	if thanosQuerierSupported {
+		tqo, err = thanosqueriercontroller.New(ctx, restConfig, cfg, logger, r)
+		if err != nil {
+			logger.Error("instantiating thanos-querier controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosCompactorSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosCompactorName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosCompactorName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosCompactorName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "statefulsets",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosCompactor support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tco *thanoscompactorcontroller.Operator
+	if thanosCompactorSupported {
+		tco, err = thanoscompactorcontroller.New(restConfig, cfg, logger, r, thanosCompactorOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos-compactor controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
+	thanosStoreSupported, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.ThanosRulerAllowList.Slice(),
+		monitoringv1alpha1.SchemeGroupVersion,
+		monitoringv1alpha1.ThanosStoreName,
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: monitoringv1alpha1.ThanosStoreName,
+			Verbs:    []string{"get", "list", "watch"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    monitoring.GroupName,
+			Version:  monitoringv1alpha1.Version,
+			Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.ThanosStoreName),
+			Verbs:    []string{"update"},
+		},
+		k8sutil.ResourceAttribute{
+			Group:    appsv1.SchemeGroupVersion.Group,
+			Version:  appsv1.SchemeGroupVersion.Version,
+			Resource: "statefulsets",
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+	)
+	if err != nil {
+		logger.Error("failed to check ThanosStore support", "err", err)
+		cancel()
+		return 1
+	}
+
+	var tso *thanosstorecontroller.Operator
+	if thanosStoreSupported {
+		tso, err = thanosstorecontroller.New(restConfig, cfg, logger, r, thanosStoreOptions...)
+		if err != nil {
+			logger.Error("instantiating thanos-store controller failed", "err", err)
+			cancel()
+			return 1
+		}
+	}
+
 	var kec *kubelet.Controller
 	if kubeletObject != "" {
 		opts := []kubelet.ControllerOption{
@@ -693,7 +1109,7 @@ func run(fs *flag.FlagSet) int {
 		}
 	}
 
-	if po == nil && pao == nil && ao == nil && to == nil && kec == nil {
+	if po == nil && pao == nil && ao == nil && to == nil && tqo == nil && tco == nil && tso == nil && kec == nil {
 		logger.Error("no controller can be started, check the RBAC permissions of the service account")
 		cancel()
 		return 1
@@ -712,6 +1128,9 @@ func run(fs *flag.FlagSet) int {
 	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+	if debugStore != nil {
+		debugStore.Register(mux, prometheuscontroller.DebugResource, prometheusagentcontroller.DebugResource)
+	}
 	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -739,9 +1158,21 @@ func run(fs *flag.FlagSet) int {
 	if to != nil {
 		wg.Go(func() error { return to.Run(ctx) })
 	}
+	if tqo != nil {
+		wg.Go(func() error { return tqo.Run(ctx) })
+	}
+	if tco != nil {
+		wg.Go(func() error { return tco.Run(ctx) })
+	}
+	if tso != nil {
+		wg.Go(func() error { return tso.Run(ctx) })
+	}
 	if kec != nil {
 		wg.Go(func() error { return kec.Run(ctx) })
 	}
+	if secretProviders != nil {
+		wg.Go(func() error { return secretProviders.Run(ctx) })
+	}
 
 	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
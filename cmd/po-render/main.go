@@ -0,0 +1,245 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// po-render generates the configuration files that the operator would produce
+// from a set of manifests, without requiring a Kubernetes cluster. It can also
+// simulate the relabeling of targets by the generated scrape jobs and test
+// which Alertmanager routes match an alert.
+package main
+
+import (
+	"context"
+	"fmt"
+	stdlog "log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	kinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	logging "github.com/prometheus-operator/prometheus-operator/internal/log"
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+	promserver "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	app := kingpin.New("po-render", "Render the configuration generated by the Prometheus operator from manifest files.")
+
+	logConfig := logging.Config{Writer: os.Stderr}
+	app.Flag(
+		"log-format",
+		fmt.Sprintf("log format to use. Possible values: %s", strings.Join(logging.AvailableLogFormats, ", "))).
+		Default(logging.FormatLogFmt).StringVar(&logConfig.Format)
+
+	app.Flag(
+		"log-level",
+		fmt.Sprintf("log level to use. Possible values: %s", strings.Join(logging.AvailableLogLevels, ", "))).
+		Default(logging.LevelWarn).StringVar(&logConfig.Level)
+
+	manifestPaths := app.Flag("manifests", "file or directory containing the manifests (can be repeated). Directories are read recursively.").Short('f').Required().Strings()
+	defaultNamespace := app.Flag("namespace", "namespace assigned to objects without namespace").Default("default").String()
+	output := app.Flag("output", "file to write the configuration to (default: standard output)").Short('o').String()
+
+	promCmd := app.Command("prometheus", "Render the Prometheus configuration.")
+	promKey := promCmd.Flag("prometheus", "Prometheus object to render (<namespace>/<name> or <name>). Required if the manifests contain more than one Prometheus object.").String()
+	endpointSlice := promCmd.Flag("endpointslice", "assume that the Kubernetes API supports the EndpointSlice resource").Default("true").Bool()
+
+	relabelCmd := app.Command("relabel", "Simulate the relabeling of targets by the scrape jobs of a ServiceMonitor, PodMonitor, Probe or ScrapeConfig object.")
+	relabelPromKey := relabelCmd.Flag("prometheus", "Prometheus object selecting the resource (<namespace>/<name> or <name>). Required if the manifests contain more than one Prometheus object.").String()
+	relabelEndpointSlice := relabelCmd.Flag("endpointslice", "assume that the Kubernetes API supports the EndpointSlice resource").Default("true").Bool()
+	relabelResource := relabelCmd.Flag("resource", "resource generating the scrape jobs (<kind>/<namespace>/<name>), e.g. ServiceMonitor/default/example-app.").Required().String()
+	relabelTarget := relabelCmd.Flag("target", "Pod or EndpointSlice object from the manifests used to build the discovered targets (<kind>/<namespace>/<name>).").String()
+	relabelLabels := relabelCmd.Flag("label", "label of the discovered target (<name>=<value>, can be repeated). Ignored if --target is set.").StringMap()
+
+	amCmd := app.Command("alertmanager", "Render the Alertmanager configuration.")
+	amKey := amCmd.Flag("alertmanager", "Alertmanager object to render (<namespace>/<name> or <name>). Required if the manifests contain more than one Alertmanager object.").String()
+
+	routesTestCmd := app.Command("routes-test", "Show the routes of the Alertmanager configuration (including the AlertmanagerConfig routes) matching a set of labels.")
+	routesTestAMKey := routesTestCmd.Flag("alertmanager", "Alertmanager object to test (<namespace>/<name> or <name>). Required if the manifests contain more than one Alertmanager object.").String()
+	routesTestLabels := routesTestCmd.Flag("label", "label of the alert (<name>=<value>, can be repeated).").Required().StringMap()
+
+	versionutil.RegisterIntoKingpinFlags(app)
+
+	cmd, err := app.Parse(os.Args[1:])
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-render")
+		os.Exit(0)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	logger, err := logging.NewLoggerSlog(logConfig)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+
+	m, err := loadManifests(*manifestPaths, *defaultNamespace)
+	if err != nil {
+		logger.Error("failed to load manifests", "err", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(m.coreObjects()...)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced) {
+		logger.Error("failed to sync the namespace cache")
+		os.Exit(1)
+	}
+
+	var b []byte
+	switch cmd {
+	case promCmd.FullCommand():
+		_, b, err = renderPrometheus(ctx, logger, kclient, nsInf, m, *promKey, *endpointSlice)
+		if err != nil {
+			logger.Error("failed to render the Prometheus configuration", "err", err)
+			os.Exit(1)
+		}
+
+	case relabelCmd.FullCommand():
+		p, config, err := renderPrometheus(ctx, logger, kclient, nsInf, m, *relabelPromKey, *relabelEndpointSlice)
+		if err != nil {
+			logger.Error("failed to render the Prometheus configuration", "err", err)
+			os.Exit(1)
+		}
+
+		b, err = simulateRelabeling(p, config, m, *relabelResource, *relabelTarget, *relabelLabels)
+		if err != nil {
+			logger.Error("failed to simulate the relabeling", "err", err)
+			os.Exit(1)
+		}
+
+	case amCmd.FullCommand():
+		_, b, err = renderAlertmanager(ctx, logger, kclient, nsInf, m, *amKey)
+		if err != nil {
+			logger.Error("failed to render the Alertmanager configuration", "err", err)
+			os.Exit(1)
+		}
+
+	case routesTestCmd.FullCommand():
+		am, config, err := renderAlertmanager(ctx, logger, kclient, nsInf, m, *routesTestAMKey)
+		if err != nil {
+			logger.Error("failed to render the Alertmanager configuration", "err", err)
+			os.Exit(1)
+		}
+
+		b, err = testRoutes(am, config, m, *routesTestLabels)
+		if err != nil {
+			logger.Error("failed to test the routes", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if err := writeOutput(*output, b); err != nil {
+		logger.Error("failed to write the configuration", "err", err)
+		os.Exit(1)
+	}
+}
+
+// renderPrometheus returns the Prometheus object matching the key and its
+// configuration.
+func renderPrometheus(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	nsInf cache.SharedIndexInformer,
+	m *manifests,
+	key string,
+	endpointSlice bool,
+) (*monitoringv1.Prometheus, []byte, error) {
+	p, err := findObject("Prometheus", m.prometheuses, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var opts []prompkg.ConfigGeneratorOption
+	if endpointSlice {
+		opts = append(opts, prompkg.WithEndpointSliceSupport())
+	}
+
+	b, err := promserver.RenderConfiguration(
+		ctx,
+		logger.With("prometheus", p.Namespace+"/"+p.Name),
+		kclient,
+		nsInf,
+		p,
+		promserver.ConfigResources{
+			ServiceMonitors:        m.serviceMonitors,
+			PodMonitors:            m.podMonitors,
+			Probes:                 m.probes,
+			ScrapeConfigs:          m.scrapeConfigs,
+			ScrapeClassDefinitions: m.scrapeClassDefinitions,
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p, b, nil
+}
+
+// renderAlertmanager returns the Alertmanager object matching the key and its
+// configuration.
+func renderAlertmanager(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	nsInf cache.SharedIndexInformer,
+	m *manifests,
+	key string,
+) (*monitoringv1.Alertmanager, []byte, error) {
+	am, err := findObject("Alertmanager", m.alertmanagers, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := alertmanager.RenderConfiguration(
+		ctx,
+		logger.With("alertmanager", am.Namespace+"/"+am.Name),
+		kclient,
+		monitoringfake.NewSimpleClientset(m.monitoringObjects()...),
+		nsInf,
+		am,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return am, b, nil
+}
+
+func writeOutput(path string, b []byte) error {
+	if path == "" || path == "-" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+
+	return os.WriteFile(path, b, 0o644)
+}
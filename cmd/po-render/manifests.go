@@ -0,0 +1,384 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8sYAML "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	monitoringscheme "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/scheme"
+)
+
+var decoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(monitoringscheme.AddToScheme(scheme))
+
+	decoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// manifests holds the objects decoded from the manifest files.
+type manifests struct {
+	namespaces []*v1.Namespace
+	secrets    []*v1.Secret
+	configMaps []*v1.ConfigMap
+
+	// Services, EndpointSlices and Pods are only used to simulate the
+	// relabeling of targets.
+	services       []*v1.Service
+	endpointSlices []*discoveryv1.EndpointSlice
+	pods           []*v1.Pod
+
+	prometheuses           []*monitoringv1.Prometheus
+	serviceMonitors        []*monitoringv1.ServiceMonitor
+	podMonitors            []*monitoringv1.PodMonitor
+	probes                 []*monitoringv1.Probe
+	scrapeConfigs          []*monitoringv1alpha1.ScrapeConfig
+	scrapeClassDefinitions []*monitoringv1alpha1.ScrapeClassDefinition
+
+	alertmanagers              []*monitoringv1.Alertmanager
+	alertmanagerConfigs        []*monitoringv1alpha1.AlertmanagerConfig
+	clusterAlertmanagerConfigs []*monitoringv1alpha1.ClusterAlertmanagerConfig
+}
+
+// loadManifests reads all the YAML and JSON files from the given paths.
+// Directories are walked recursively. Objects without namespace are assigned
+// to the default namespace.
+func loadManifests(paths []string, defaultNamespace string) (*manifests, error) {
+	m := &manifests{}
+
+	for _, p := range paths {
+		err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+
+			if err := m.loadFile(path, defaultNamespace); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.addMissingNamespaces()
+
+	return m, nil
+}
+
+func (m *manifests) loadFile(path string, defaultNamespace string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := k8sYAML.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to decode object: %w", err)
+		}
+
+		if err := m.add(obj, defaultNamespace); err != nil {
+			return fmt.Errorf("%s: %w", gvk.Kind, err)
+		}
+	}
+}
+
+func (m *manifests) add(obj runtime.Object, defaultNamespace string) error {
+	if o, ok := obj.(metav1.Object); ok && o.GetNamespace() == "" {
+		switch obj.(type) {
+		case *v1.Namespace, *monitoringv1alpha1.ClusterAlertmanagerConfig:
+			// Cluster-scoped objects.
+		default:
+			o.SetNamespace(defaultNamespace)
+		}
+	}
+
+	switch o := obj.(type) {
+	case *v1.List:
+		for _, item := range o.Items {
+			itemObj, _, err := decoder.Decode(item.Raw, nil, nil)
+			if err != nil {
+				return fmt.Errorf("failed to decode list item: %w", err)
+			}
+
+			if err := m.add(itemObj, defaultNamespace); err != nil {
+				return err
+			}
+		}
+	case *v1.Namespace:
+		m.namespaces = append(m.namespaces, o)
+	case *v1.Secret:
+		// Mimic the API server which merges stringData into data.
+		for k, v := range o.StringData {
+			if o.Data == nil {
+				o.Data = map[string][]byte{}
+			}
+			o.Data[k] = []byte(v)
+		}
+		o.StringData = nil
+		m.secrets = append(m.secrets, o)
+	case *v1.ConfigMap:
+		m.configMaps = append(m.configMaps, o)
+	case *v1.Service:
+		m.services = append(m.services, o)
+	case *discoveryv1.EndpointSlice:
+		m.endpointSlices = append(m.endpointSlices, o)
+	case *v1.Pod:
+		m.pods = append(m.pods, o)
+	case *monitoringv1.Prometheus:
+		applyPrometheusDefaults(o)
+		m.prometheuses = append(m.prometheuses, o)
+	case *monitoringv1.ServiceMonitor:
+		m.serviceMonitors = append(m.serviceMonitors, o)
+	case *monitoringv1.PodMonitor:
+		m.podMonitors = append(m.podMonitors, o)
+	case *monitoringv1.Probe:
+		if o.Spec.ProberSpec.Path == "" {
+			o.Spec.ProberSpec.Path = "/probe"
+		}
+		m.probes = append(m.probes, o)
+	case *monitoringv1alpha1.ScrapeConfig:
+		m.scrapeConfigs = append(m.scrapeConfigs, o)
+	case *monitoringv1alpha1.ScrapeClassDefinition:
+		m.scrapeClassDefinitions = append(m.scrapeClassDefinitions, o)
+	case *monitoringv1.Alertmanager:
+		m.alertmanagers = append(m.alertmanagers, o)
+	case *monitoringv1alpha1.AlertmanagerConfig:
+		m.alertmanagerConfigs = append(m.alertmanagerConfigs, o)
+	case *monitoringv1beta1.AlertmanagerConfig:
+		// The operator works with the v1alpha1 version (storage version).
+		amc := &monitoringv1alpha1.AlertmanagerConfig{}
+		if err := o.ConvertTo(amc); err != nil {
+			return fmt.Errorf("failed to convert to v1alpha1: %w", err)
+		}
+		m.alertmanagerConfigs = append(m.alertmanagerConfigs, amc)
+	case *monitoringv1alpha1.ClusterAlertmanagerConfig:
+		m.clusterAlertmanagerConfigs = append(m.clusterAlertmanagerConfigs, o)
+	}
+
+	// Other kinds are ignored.
+	return nil
+}
+
+// applyPrometheusDefaults sets the default values which would be applied by
+// the API server from the CRD schema.
+func applyPrometheusDefaults(p *monitoringv1.Prometheus) {
+	if p.Spec.ScrapeInterval == "" {
+		p.Spec.ScrapeInterval = "30s"
+	}
+
+	if p.Spec.EvaluationInterval == "" {
+		p.Spec.EvaluationInterval = "30s"
+	}
+
+	if p.Spec.PortName == "" {
+		p.Spec.PortName = "web"
+	}
+}
+
+// addMissingNamespaces creates Namespace objects (without labels) for all the
+// namespaces referenced by objects but not declared explicitly.
+func (m *manifests) addMissingNamespaces() {
+	declared := make(map[string]struct{}, len(m.namespaces))
+	for _, ns := range m.namespaces {
+		declared[ns.Name] = struct{}{}
+	}
+
+	var missing []string
+	for _, o := range m.objects() {
+		ns := o.(metav1.Object).GetNamespace()
+		if _, found := declared[ns]; found || ns == "" {
+			continue
+		}
+
+		declared[ns] = struct{}{}
+		missing = append(missing, ns)
+	}
+
+	slices.Sort(missing)
+	for _, ns := range missing {
+		m.namespaces = append(m.namespaces, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})
+	}
+}
+
+// objects returns all the namespaced objects.
+func (m *manifests) objects() []runtime.Object {
+	var objs []runtime.Object
+
+	for _, o := range m.secrets {
+		objs = append(objs, o)
+	}
+	for _, o := range m.configMaps {
+		objs = append(objs, o)
+	}
+	for _, o := range m.services {
+		objs = append(objs, o)
+	}
+	for _, o := range m.endpointSlices {
+		objs = append(objs, o)
+	}
+	for _, o := range m.pods {
+		objs = append(objs, o)
+	}
+	for _, o := range m.prometheuses {
+		objs = append(objs, o)
+	}
+	for _, o := range m.serviceMonitors {
+		objs = append(objs, o)
+	}
+	for _, o := range m.podMonitors {
+		objs = append(objs, o)
+	}
+	for _, o := range m.probes {
+		objs = append(objs, o)
+	}
+	for _, o := range m.scrapeConfigs {
+		objs = append(objs, o)
+	}
+	for _, o := range m.scrapeClassDefinitions {
+		objs = append(objs, o)
+	}
+	for _, o := range m.alertmanagers {
+		objs = append(objs, o)
+	}
+	for _, o := range m.alertmanagerConfigs {
+		objs = append(objs, o)
+	}
+
+	return objs
+}
+
+// coreObjects returns the objects which are served by the core API.
+func (m *manifests) coreObjects() []runtime.Object {
+	var objs []runtime.Object
+
+	for _, o := range m.namespaces {
+		objs = append(objs, o)
+	}
+	for _, o := range m.secrets {
+		objs = append(objs, o)
+	}
+	for _, o := range m.configMaps {
+		objs = append(objs, o)
+	}
+
+	return objs
+}
+
+// monitoringObjects returns the objects which are served by the
+// monitoring.coreos.com API.
+func (m *manifests) monitoringObjects() []runtime.Object {
+	var objs []runtime.Object
+
+	for _, o := range m.alertmanagerConfigs {
+		objs = append(objs, o)
+	}
+	for _, o := range m.clusterAlertmanagerConfigs {
+		objs = append(objs, o)
+	}
+
+	return objs
+}
+
+// findObject returns the object matching the "<namespace>/<name>" or "<name>"
+// key. If the key is empty, the list must contain exactly one object.
+func findObject[T metav1.Object](kind string, objects []T, key string) (T, error) {
+	var zero T
+
+	if key == "" {
+		if len(objects) != 1 {
+			return zero, fmt.Errorf("expected exactly 1 %s object but found %d, use the --%s flag to select one", kind, len(objects), strings.ToLower(kind))
+		}
+
+		return objects[0], nil
+	}
+
+	ns, name, found := strings.Cut(key, "/")
+	if !found {
+		ns, name = "", key
+	}
+
+	var matches []T
+	for _, o := range objects {
+		if o.GetName() != name {
+			continue
+		}
+
+		if ns != "" && o.GetNamespace() != ns {
+			continue
+		}
+
+		matches = append(matches, o)
+	}
+
+	switch len(matches) {
+	case 0:
+		return zero, fmt.Errorf("%s %q not found", kind, key)
+	case 1:
+		return matches[0], nil
+	}
+
+	return zero, fmt.Errorf("%s %q is ambiguous, use the <namespace>/<name> format", kind, key)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// simulateRelabeling returns the YAML-encoded relabeling results of the
+// targets for all the scrape jobs generated from the resource.
+//
+// The targets are either built from the Pod or EndpointSlice object
+// referenced by target or from the given labels.
+func simulateRelabeling(
+	p *monitoringv1.Prometheus,
+	config []byte,
+	m *manifests,
+	resource string,
+	target string,
+	lbls map[string]string,
+) ([]byte, error) {
+	kind, ns, name, err := parseObjectRef(resource)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource: %w", err)
+	}
+
+	sim, err := prompkg.NewRelabelingSimulator(p, config)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := sim.Jobs(kind, ns, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("no scrape job found for %s (check that the resource is selected by the Prometheus object and valid)", resource)
+	}
+
+	results := []prompkg.RelabelingResult{}
+	for _, job := range jobs {
+		targets, err := discoveredTargets(m, sim.Role(job), target, lbls)
+		if err != nil {
+			return nil, fmt.Errorf("job %q: %w", job, err)
+		}
+
+		for _, lset := range targets {
+			res, err := sim.Simulate(job, lset)
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, res)
+		}
+	}
+
+	return yaml.Marshal(results)
+}
+
+// discoveredTargets returns the targets that the service discovery with the
+// given role would produce.
+func discoveredTargets(m *manifests, role string, target string, lbls map[string]string) ([]labels.Labels, error) {
+	if target == "" {
+		if len(lbls) == 0 {
+			return nil, errors.New("either --target or --label must be defined")
+		}
+
+		return []labels.Labels{labels.FromMap(lbls)}, nil
+	}
+
+	kind, ns, name, err := parseObjectRef(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target: %w", err)
+	}
+
+	switch kind {
+	case "Pod":
+		if role != "pod" {
+			return nil, fmt.Errorf("a Pod target requires a scrape job with the %q role but got %q", "pod", role)
+		}
+
+		pod, err := findObject(kind, m.pods, ns+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+
+		return prompkg.PodDiscoveredTargets(pod), nil
+
+	case "EndpointSlice":
+		if role != "endpoints" && role != "endpointslice" {
+			return nil, fmt.Errorf("an EndpointSlice target requires a scrape job with the %q or %q role but got %q", "endpoints", "endpointslice", role)
+		}
+
+		eps, err := findObject(kind, m.endpointSlices, ns+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+
+		var svc *v1.Service
+		if svcName := eps.Labels[discoveryv1.LabelServiceName]; svcName != "" {
+			// The Service is optional.
+			svc, _ = findObject("Service", m.services, ns+"/"+svcName)
+		}
+
+		pods := map[string]*v1.Pod{}
+		for _, pod := range m.pods {
+			if pod.Namespace == ns {
+				pods[pod.Name] = pod
+			}
+		}
+
+		return prompkg.EndpointSliceDiscoveredTargets(role, eps, svc, pods), nil
+	}
+
+	return nil, fmt.Errorf("unsupported target kind %q", kind)
+}
+
+// parseObjectRef parses a reference of the form <kind>/<namespace>/<name>.
+func parseObjectRef(ref string) (string, string, string, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("expected <kind>/<namespace>/<name> but got %q", ref)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
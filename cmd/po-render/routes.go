@@ -0,0 +1,46 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// testRoutes returns the YAML-encoded list of routes from the Alertmanager
+// configuration which match the labels.
+func testRoutes(am *monitoringv1.Alertmanager, config []byte, m *manifests, lbls map[string]string) ([]byte, error) {
+	owners := alertmanager.RouteOwners{
+		Namespace:                  am.Namespace,
+		AlertmanagerConfigs:        make([]types.NamespacedName, 0, len(m.alertmanagerConfigs)),
+		ClusterAlertmanagerConfigs: make([]string, 0, len(m.clusterAlertmanagerConfigs)),
+	}
+	for _, amc := range m.alertmanagerConfigs {
+		owners.AlertmanagerConfigs = append(owners.AlertmanagerConfigs, types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name})
+	}
+	for _, camc := range m.clusterAlertmanagerConfigs {
+		owners.ClusterAlertmanagerConfigs = append(owners.ClusterAlertmanagerConfigs, camc.Name)
+	}
+
+	routes, err := alertmanager.MatchRoutes(config, owners, lbls)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(routes)
+}
@@ -18,6 +18,7 @@ package log
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -42,6 +43,9 @@ const (
 type Config struct {
 	Level  string
 	Format string
+	// Writer is the destination of the log entries (default: standard
+	// output). It is only supported by the slog logger.
+	Writer io.Writer
 }
 
 func RegisterFlags(fs *flag.FlagSet, c *Config) {
@@ -16,6 +16,7 @@ package log
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
 	"math"
 	"os"
@@ -31,7 +32,12 @@ func NewLoggerSlog(c Config) (*slog.Logger, error) {
 		return nil, err
 	}
 
-	handler, err := getHandlerFromFormat(c.Format, slog.HandlerOptions{
+	w := c.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+
+	handler, err := getHandlerFromFormat(c.Format, w, slog.HandlerOptions{
 		Level:       lvlOption,
 		AddSource:   true,
 		ReplaceAttr: replaceSlogAttributes,
@@ -72,14 +78,14 @@ func replaceSlogAttributes(_ []string, a slog.Attr) slog.Attr {
 }
 
 // getHandlerFromFormat returns a slog.Handler based on the provided format and slog options.
-func getHandlerFromFormat(format string, opts slog.HandlerOptions) (slog.Handler, error) {
+func getHandlerFromFormat(format string, w io.Writer, opts slog.HandlerOptions) (slog.Handler, error) {
 	var handler slog.Handler
 	switch strings.ToLower(format) {
 	case FormatLogFmt:
-		handler = slog.NewTextHandler(os.Stdout, &opts)
+		handler = slog.NewTextHandler(w, &opts)
 		return handler, nil
 	case FormatJSON:
-		handler = slog.NewJSONHandler(os.Stdout, &opts)
+		handler = slog.NewJSONHandler(w, &opts)
 		return handler, nil
 	default:
 		return nil, fmt.Errorf("log format %s unknown, %v are possible values", format, AvailableLogFormats)
@@ -48,7 +48,7 @@ func TestReplaceAttributes(t *testing.T) {
 }
 
 func TestParseFmt(t *testing.T) {
-	handler, err := getHandlerFromFormat(FormatJSON, slog.HandlerOptions{
+	handler, err := getHandlerFromFormat(FormatJSON, os.Stdout, slog.HandlerOptions{
 		Level:     slog.LevelDebug,
 		AddSource: true,
 	})
@@ -62,7 +62,7 @@ func TestParseFmt(t *testing.T) {
 
 	require.Equal(t, wantHandler, handler)
 
-	handler, err = getHandlerFromFormat(FormatLogFmt, slog.HandlerOptions{
+	handler, err = getHandlerFromFormat(FormatLogFmt, os.Stdout, slog.HandlerOptions{
 		Level:     slog.LevelDebug,
 		AddSource: true,
 	})
@@ -49,7 +49,15 @@ function(params) {
   '0probeCustomResourceDefinition': import 'probes-crd.json',
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
+  '0thanosquerierCustomResourceDefinition': import 'thanosqueriers-crd.json',
+  '0thanoscompactorCustomResourceDefinition': import 'thanoscompactors-crd.json',
+  '0thanosstoreCustomResourceDefinition': import 'thanosstores-crd.json',
   '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0monitoringquotaCustomResourceDefinition': import 'monitoringquotas-crd.json',
+  '0scrapeclassdefinitionCustomResourceDefinition': import 'scrapeclassdefinitions-crd.json',
+  '0referencegrantCustomResourceDefinition': import 'referencegrants-crd.json',
+  '0alertmanagersilenceCustomResourceDefinition': import 'alertmanagersilences-crd.json',
+  '0clusteralertmanagerconfigCustomResourceDefinition': import 'clusteralertmanagerconfigs-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -85,6 +93,10 @@ function(params) {
                  'alertmanagers/finalizers',
                  'alertmanagers/status',
                  'alertmanagerconfigs',
+                 'alertmanagerconfigs/status',
+                 'alertmanagersilences',
+                 'alertmanagersilences/status',
+                 'clusteralertmanagerconfigs',
                  'prometheuses',
                  'prometheuses/finalizers',
                  'prometheuses/status',
@@ -94,8 +106,21 @@ function(params) {
                  'thanosrulers',
                  'thanosrulers/finalizers',
                  'thanosrulers/status',
+                 'thanosqueriers',
+                 'thanosqueriers/finalizers',
+                 'thanosqueriers/status',
+                 'thanoscompactors',
+                 'thanoscompactors/finalizers',
+                 'thanoscompactors/status',
+                 'thanosstores',
+                 'thanosstores/finalizers',
+                 'thanosstores/status',
                  'scrapeconfigs',
                  'scrapeconfigs/status',
+                 'monitoringquotas',
+                 'scrapeclassdefinitions',
+                 'scrapeclassdefinitions/status',
+                 'referencegrants',
                  'servicemonitors',
                  'servicemonitors/status',
                  'podmonitors',
@@ -109,7 +134,7 @@ function(params) {
              },
              {
                apiGroups: ['apps'],
-               resources: ['statefulsets'],
+               resources: ['statefulsets', 'deployments'],
                verbs: ['*'],
              },
              {
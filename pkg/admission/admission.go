@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
@@ -35,6 +36,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
@@ -55,6 +57,10 @@ const (
 	prometheusRuleValidatePath     = "/admission-prometheusrules/validate"
 	prometheusRuleMutatePath       = "/admission-prometheusrules/mutate"
 	alertmanagerConfigValidatePath = "/admission-alertmanagerconfigs/validate"
+	serviceMonitorValidatePath     = "/admission-servicemonitors/validate"
+	podMonitorValidatePath         = "/admission-podmonitors/validate"
+	probeValidatePath              = "/admission-probes/validate"
+	scrapeConfigValidatePath       = "/admission-scrapeconfigs/validate"
 	convertPath                    = "/convert"
 )
 
@@ -74,22 +80,50 @@ var (
 // Admission control for:
 // 1. PrometheusRules (validation, mutation) - ensuring created resources can be loaded by Prometheus
 // 2. monitoringv1alpha1.AlertmanagerConfig (validation) - ensuring.
+// 3. ServiceMonitors, PodMonitors, Probes and ScrapeConfigs (validation) - ensuring created resources aren't rejected by the operator.
 type Admission struct {
 	logger           *slog.Logger
 	wh               http.Handler
 	validationScheme model.ValidationScheme
+
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+	listers *configResourceListers
 }
 
-func New(logger *slog.Logger, validationScheme model.ValidationScheme) *Admission {
+// Option configures optional features of the admission webhook.
+type Option func(*Admission)
+
+// WithConfigResourcesValidation enables the validation of ServiceMonitor,
+// PodMonitor, Probe and ScrapeConfig objects.
+//
+// The clients are used to watch the Namespace, Prometheus, PrometheusAgent and
+// ScrapeClassDefinition objects as well as to retrieve the Secrets and
+// ConfigMaps referenced by the resource. The informers are started by
+// Admission.Start.
+func WithConfigResourcesValidation(kclient kubernetes.Interface, mclient monitoringclient.Interface) Option {
+	return func(a *Admission) {
+		a.kclient = kclient
+		a.mclient = mclient
+	}
+}
+
+func New(logger *slog.Logger, validationScheme model.ValidationScheme, opts ...Option) *Admission {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(monitoringv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1beta1.AddToScheme(scheme))
 
-	return &Admission{
+	a := &Admission{
 		logger:           logger,
 		wh:               conversion.NewWebhookHandler(scheme),
 		validationScheme: validationScheme,
 	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
 }
 
 func (a *Admission) Register(mux *http.ServeMux) {
@@ -97,6 +131,15 @@ func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc(prometheusRuleMutatePath, a.servePrometheusRulesMutate)
 	mux.HandleFunc(alertmanagerConfigValidatePath, a.serveAlertmanagerConfigValidate)
 	mux.HandleFunc(convertPath, a.serveConvert)
+
+	if a.kclient == nil || a.mclient == nil {
+		return
+	}
+
+	mux.HandleFunc(serviceMonitorValidatePath, a.serveServiceMonitorsValidate)
+	mux.HandleFunc(podMonitorValidatePath, a.servePodMonitorsValidate)
+	mux.HandleFunc(probeValidatePath, a.serveProbesValidate)
+	mux.HandleFunc(scrapeConfigValidatePath, a.serveScrapeConfigsValidate)
 }
 
 type admitFunc func(ar v1.AdmissionReview) *v1.AdmissionResponse
@@ -113,6 +156,30 @@ func (a *Admission) serveAlertmanagerConfigValidate(w http.ResponseWriter, r *ht
 	a.serveAdmission(w, r, a.validateAlertmanagerConfig)
 }
 
+func (a *Admission) serveServiceMonitorsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return a.validateConfigResource(r.Context(), ar, serviceMonitorGVR, &monitoringv1.ServiceMonitor{})
+	})
+}
+
+func (a *Admission) servePodMonitorsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return a.validateConfigResource(r.Context(), ar, podMonitorGVR, &monitoringv1.PodMonitor{})
+	})
+}
+
+func (a *Admission) serveProbesValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return a.validateConfigResource(r.Context(), ar, probeGVR, &monitoringv1.Probe{})
+	})
+}
+
+func (a *Admission) serveScrapeConfigsValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return a.validateConfigResource(r.Context(), ar, scrapeConfigGVR, &monitoringv1alpha1.ScrapeConfig{})
+	})
+}
+
 func (a *Admission) serveConvert(w http.ResponseWriter, r *http.Request) {
 	a.wh.ServeHTTP(w, r)
 }
@@ -250,6 +317,16 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure("Rules are not valid", prometheusRuleResource, errors)
 	}
 
+	errors = promoperator.RunRuleTests(promRule.Spec)
+	if len(errors) != 0 {
+		const m = "Failed rule tests"
+		for _, err := range errors {
+			a.logger.Info(m, "err", err)
+		}
+
+		return toAdmissionResponseFailure("Rule tests failed", prometheusRuleResource, errors)
+	}
+
 	return &v1.AdmissionResponse{Allowed: true}
 }
 
@@ -111,6 +111,32 @@ func TestAdmitBadRule(t *testing.T) {
 	}
 }
 
+func TestAdmitRuleWithTests(t *testing.T) {
+	ts := server(api().servePrometheusRulesValidate)
+	defer ts.Close()
+
+	resp := sendAdmissionReview(t, ts, golden.Get(t, "goodRulesWithTests.golden"))
+
+	if !resp.Response.Allowed {
+		t.Errorf("Expected admission to be allowed but it was not: %v", resp.Response.Result)
+	}
+}
+
+func TestAdmitRuleWithFailingTests(t *testing.T) {
+	ts := server(api().servePrometheusRulesValidate)
+	defer ts.Close()
+
+	resp := sendAdmissionReview(t, ts, golden.Get(t, "badRulesWithFailingTests.golden"))
+
+	if resp.Response.Allowed {
+		t.Errorf("Expected admission to not be allowed but it was")
+		return
+	}
+
+	require.Len(t, resp.Response.Result.Details.Causes, 1)
+	require.Contains(t, resp.Response.Result.Details.Causes[0].Message, `test "alert fires": alertname "Test", time 5m: expected alerts`)
+}
+
 func TestAdmitBadRuleWithBooleanInAnnotations(t *testing.T) {
 	ts := server(api().servePrometheusRulesValidate)
 	defer ts.Close()
@@ -0,0 +1,372 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringinformers "github.com/prometheus-operator/prometheus-operator/pkg/client/informers/externalversions"
+	monitoringlistersv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/listers/monitoring/v1"
+	monitoringlistersv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/client/listers/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+const (
+	errUnmarshalConfigResource = "Cannot unmarshal configuration resource"
+
+	// defaultScrapeInterval is the default value of the scrapeInterval field
+	// in the Prometheus and PrometheusAgent CRDs.
+	defaultScrapeInterval = monitoringv1.Duration("30s")
+)
+
+var (
+	serviceMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ServiceMonitorName,
+	}
+	podMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.PodMonitorName,
+	}
+	probeGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ProbeName,
+	}
+	scrapeConfigGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1alpha1.Version,
+		Resource: monitoringv1alpha1.ScrapeConfigName,
+	}
+)
+
+// configResourceListers caches the objects needed to validate the
+// configuration resources so that the admission requests don't list objects
+// from the Kubernetes API.
+type configResourceListers struct {
+	namespaces   corelisters.NamespaceLister
+	prometheuses monitoringlistersv1.PrometheusLister
+	agents       monitoringlistersv1alpha1.PrometheusAgentLister
+	// scds is nil when the ScrapeClassDefinition CRD isn't installed.
+	scds monitoringlistersv1alpha1.ScrapeClassDefinitionLister
+}
+
+// Start starts the informers required by the validation of ServiceMonitor,
+// PodMonitor, Probe and ScrapeConfig objects and waits for their caches to be
+// synced. It is a no-op when the validation isn't enabled.
+func (a *Admission) Start(ctx context.Context) error {
+	if a.kclient == nil || a.mclient == nil {
+		return nil
+	}
+
+	kinfs := kubeinformers.NewSharedInformerFactory(a.kclient, 0)
+	minfs := monitoringinformers.NewSharedInformerFactory(a.mclient, 0)
+
+	l := &configResourceListers{
+		namespaces:   kinfs.Core().V1().Namespaces().Lister(),
+		prometheuses: minfs.Monitoring().V1().Prometheuses().Lister(),
+		agents:       minfs.Monitoring().V1alpha1().PrometheusAgents().Lister(),
+	}
+
+	// The ScrapeClassDefinition CRD is optional.
+	ok, err := k8sutil.IsAPIGroupVersionResourceSupported(a.kclient.Discovery(), monitoringv1alpha1.SchemeGroupVersion, monitoringv1alpha1.ScrapeClassDefinitionName)
+	if err != nil {
+		a.logger.Warn("Cannot check if the ScrapeClassDefinition CRD is installed", "err", err)
+	}
+	if ok {
+		l.scds = minfs.Monitoring().V1alpha1().ScrapeClassDefinitions().Lister()
+	}
+
+	kinfs.Start(ctx.Done())
+	minfs.Start(ctx.Done())
+
+	for typ, ok := range kinfs.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("failed to sync cache for %v informer", typ)
+		}
+	}
+
+	for typ, ok := range minfs.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("failed to sync cache for %v informer", typ)
+		}
+	}
+
+	a.listers = l
+	return nil
+}
+
+// configResource is implemented by the ServiceMonitor, PodMonitor, Probe and
+// ScrapeConfig types.
+type configResource interface {
+	metav1.Object
+	runtime.Object
+}
+
+// validateConfigResource verifies that the configuration resource passes the
+// checks done by the operator when it selects the resource.
+//
+// The resource is checked against every Prometheus and PrometheusAgent object
+// selecting it. If no object selects the resource, it is checked against a
+// Prometheus object with default values which knows about the scrape classes
+// defined by all Prometheus and PrometheusAgent objects.
+func (a *Admission) validateConfigResource(ctx context.Context, ar v1.AdmissionReview, gvr metav1.GroupVersionResource, obj configResource) *v1.AdmissionResponse {
+	a.logger.Debug("Validating " + gvr.Resource)
+
+	if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expected resource to be %v, but received %v", gvr, ar.Request.Resource)
+		a.logger.Warn("", "err", err)
+		return toAdmissionResponseFailure("Unexpected resource kind", gvr.Resource, []error{err})
+	}
+
+	if err := json.Unmarshal(ar.Request.Object.Raw, obj); err != nil {
+		a.logger.Info(errUnmarshalConfigResource, "err", err)
+		return toAdmissionResponseFailure(errUnmarshalConfigResource, gvr.Resource, []error{err})
+	}
+
+	// The namespace may be omitted from the object when it's created.
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(ar.Request.Namespace)
+	}
+
+	if a.listers == nil {
+		err := fmt.Errorf("the informers aren't started")
+		a.logger.Warn("Cannot validate "+gvr.Resource, "err", err)
+		return toAdmissionResponseFailure("Webhook not ready", gvr.Resource, []error{err})
+	}
+
+	prometheuses, err := a.listPrometheuses()
+	if err != nil {
+		a.logger.Warn("Cannot list Prometheus objects", "err", err)
+		return toAdmissionResponseFailure("Cannot list Prometheus objects", gvr.Resource, []error{err})
+	}
+
+	scds := a.listScrapeClassDefinitions()
+
+	ns, err := a.listers.namespaces.Get(obj.GetNamespace())
+	if err != nil {
+		a.logger.Warn("Cannot get namespace", "err", err)
+		return toAdmissionResponseFailure("Cannot get namespace", gvr.Resource, []error{err})
+	}
+
+	var (
+		errs     []error
+		selected bool
+	)
+	for _, p := range prometheuses {
+		ok, err := selectsConfigResource(p, gvr.Resource, obj, ns)
+		if err != nil {
+			a.logger.Debug("Cannot evaluate selectors", "prometheus", objectKey(p.GetObjectMeta()), "err", err)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+		selected = true
+
+		if err := a.checkConfigResource(ctx, p, obj, scds); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", p.GroupVersionKind().Kind, objectKey(p.GetObjectMeta()), err))
+		}
+	}
+
+	if !selected {
+		if err := a.checkConfigResource(ctx, defaultPrometheus(obj.GetNamespace(), prometheuses, scds), obj, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		msg := "invalid " + gvr.Resource
+		a.logger.Debug(msg, "content", string(ar.Request.Object.Raw))
+		for _, err := range errs {
+			a.logger.Info(msg, "err", err)
+		}
+
+		return toAdmissionResponseFailure(fmt.Sprintf("%s is invalid", obj.GetObjectKind().GroupVersionKind().Kind), gvr.Resource, errs)
+	}
+
+	return &v1.AdmissionResponse{Allowed: true}
+}
+
+func (a *Admission) checkConfigResource(ctx context.Context, p monitoringv1.PrometheusInterface, obj configResource, scds []*monitoringv1alpha1.ScrapeClassDefinition) error {
+	// The namespace informer, the metrics and the event recorder are only
+	// required when selecting resources.
+	rs, err := prompkg.NewResourceSelector(
+		a.logger,
+		p,
+		assets.NewStoreBuilder(a.kclient.CoreV1(), a.kclient.CoreV1()),
+		nil,
+		nil,
+		nil,
+		prompkg.WithScrapeClassDefinitions(scds),
+	)
+	if err != nil {
+		return err
+	}
+
+	return rs.CheckObject(ctx, obj)
+}
+
+// listPrometheuses returns all the Prometheus and PrometheusAgent objects with
+// their type information.
+func (a *Admission) listPrometheuses() ([]monitoringv1.PrometheusInterface, error) {
+	var res []monitoringv1.PrometheusInterface
+
+	proms, err := a.listers.prometheuses.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range proms {
+		// The objects from the cache must not be modified.
+		p = p.DeepCopy()
+		p.SetGroupVersionKind(monitoringv1.SchemeGroupVersion.WithKind(monitoringv1.PrometheusesKind))
+		res = append(res, p)
+	}
+
+	agents, err := a.listers.agents.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range agents {
+		p = p.DeepCopy()
+		p.SetGroupVersionKind(monitoringv1alpha1.SchemeGroupVersion.WithKind(monitoringv1alpha1.PrometheusAgentsKind))
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// listScrapeClassDefinitions returns all the ScrapeClassDefinition objects.
+// Because the CRD is optional, it returns no object if the objects can't be
+// listed.
+func (a *Admission) listScrapeClassDefinitions() []*monitoringv1alpha1.ScrapeClassDefinition {
+	if a.listers.scds == nil {
+		return nil
+	}
+
+	scds, err := a.listers.scds.List(labels.Everything())
+	if err != nil {
+		a.logger.Debug("Cannot list ScrapeClassDefinition objects", "err", err)
+		return nil
+	}
+
+	return scds
+}
+
+// selectsConfigResource returns true if the Prometheus object selects the
+// configuration resource.
+func selectsConfigResource(p monitoringv1.PrometheusInterface, resource string, obj metav1.Object, ns metav1.Object) (bool, error) {
+	cpf := p.GetCommonPrometheusFields()
+
+	var selector, nsSelector *metav1.LabelSelector
+	switch resource {
+	case monitoringv1.ServiceMonitorName:
+		selector, nsSelector = cpf.ServiceMonitorSelector, cpf.ServiceMonitorNamespaceSelector
+	case monitoringv1.PodMonitorName:
+		selector, nsSelector = cpf.PodMonitorSelector, cpf.PodMonitorNamespaceSelector
+	case monitoringv1.ProbeName:
+		selector, nsSelector = cpf.ProbeSelector, cpf.ProbeNamespaceSelector
+	case monitoringv1alpha1.ScrapeConfigName:
+		selector, nsSelector = cpf.ScrapeConfigSelector, cpf.ScrapeConfigNamespaceSelector
+	}
+
+	if selector == nil {
+		return false, nil
+	}
+
+	// A nil namespace selector means that only the namespace of the
+	// Prometheus object is considered.
+	if nsSelector == nil {
+		if ns.GetName() != p.GetObjectMeta().GetNamespace() {
+			return false, nil
+		}
+	} else {
+		s, err := metav1.LabelSelectorAsSelector(nsSelector)
+		if err != nil {
+			return false, err
+		}
+
+		if !s.Matches(labels.Set(ns.GetLabels())) {
+			return false, nil
+		}
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Matches(labels.Set(obj.GetLabels())), nil
+}
+
+// defaultPrometheus returns a Prometheus object with default values which
+// defines the scrape classes of all the given objects.
+func defaultPrometheus(namespace string, prometheuses []monitoringv1.PrometheusInterface, scds []*monitoringv1alpha1.ScrapeClassDefinition) *monitoringv1.Prometheus {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ScrapeInterval: defaultScrapeInterval,
+			},
+		},
+	}
+
+	scrapeClasses := map[string]struct{}{}
+	for _, prom := range prometheuses {
+		for _, sc := range prom.GetCommonPrometheusFields().ScrapeClasses {
+			if _, found := scrapeClasses[sc.Name]; found {
+				continue
+			}
+
+			scrapeClasses[sc.Name] = struct{}{}
+			p.Spec.ScrapeClasses = append(p.Spec.ScrapeClasses, sc)
+		}
+	}
+
+	for _, scd := range scds {
+		if _, found := scrapeClasses[scd.Name]; found {
+			continue
+		}
+
+		scrapeClasses[scd.Name] = struct{}{}
+		p.Spec.ScrapeClasses = append(p.Spec.ScrapeClasses, scd.ToScrapeClass())
+	}
+
+	return p
+}
+
+func objectKey(o metav1.Object) string {
+	return o.GetNamespace() + "/" + o.GetName()
+}
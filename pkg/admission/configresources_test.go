@@ -0,0 +1,257 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestConfigResourcesValidation(t *testing.T) {
+	a := New(
+		slog.New(slog.DiscardHandler),
+		model.LegacyValidation,
+		WithConfigResourcesValidation(
+			fake.NewClientset(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "monitoring"},
+					Data: map[string][]byte{
+						"user":     []byte("foo"),
+						"password": []byte("bar"),
+					},
+				},
+			),
+			monitoringfake.NewSimpleClientset(
+				&monitoringv1.Prometheus{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+					Spec: monitoringv1.PrometheusSpec{
+						CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+							ScrapeInterval: "30s",
+							ScrapeClasses: []monitoringv1.ScrapeClass{
+								{Name: "custom"},
+							},
+							ServiceMonitorSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"team": "a"},
+							},
+							PodMonitorSelector:   &metav1.LabelSelector{},
+							ProbeSelector:        &metav1.LabelSelector{},
+							ScrapeConfigSelector: &metav1.LabelSelector{},
+						},
+					},
+				},
+			),
+		),
+	)
+
+	require.NoError(t, a.Start(t.Context()))
+
+	mux := http.NewServeMux()
+	a.Register(mux)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	basicAuth := func(secret string) *monitoringv1.BasicAuth {
+		return &monitoringv1.BasicAuth{
+			Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: "user"},
+			Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret}, Key: "password"},
+		}
+	}
+
+	serviceMonitor := func(ns string, lbls map[string]string, scrapeClass *string, ep monitoringv1.Endpoint) *monitoringv1.ServiceMonitor {
+		return &monitoringv1.ServiceMonitor{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1", Kind: monitoringv1.ServiceMonitorsKind},
+			ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: ns, Labels: lbls},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				ScrapeClassName: scrapeClass,
+				Endpoints:       []monitoringv1.Endpoint{ep},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		path    string
+		gvr     metav1.GroupVersionResource
+		obj     runtime.Object
+		allowed bool
+	}{
+		{
+			name:    "valid ServiceMonitor",
+			path:    serviceMonitorValidatePath,
+			gvr:     serviceMonitorGVR,
+			obj:     serviceMonitor("monitoring", map[string]string{"team": "a"}, ptr.To("custom"), monitoringv1.Endpoint{Port: "web", BasicAuth: basicAuth("creds")}),
+			allowed: true,
+		},
+		{
+			name: "ServiceMonitor with scrape timeout greater than the default scrape interval",
+			path: serviceMonitorValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj:  serviceMonitor("monitoring", map[string]string{"team": "a"}, nil, monitoringv1.Endpoint{Port: "web", ScrapeTimeout: "60s"}),
+		},
+		{
+			name: "ServiceMonitor with invalid relabel regex",
+			path: serviceMonitorValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj: serviceMonitor("monitoring", map[string]string{"team": "a"}, nil, monitoringv1.Endpoint{
+				Port: "web",
+				RelabelConfigs: []monitoringv1.RelabelConfig{
+					{Action: "drop", Regex: "[a-"},
+				},
+			}),
+		},
+		{
+			name: "ServiceMonitor with missing secret",
+			path: serviceMonitorValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj:  serviceMonitor("monitoring", map[string]string{"team": "a"}, nil, monitoringv1.Endpoint{Port: "web", BasicAuth: basicAuth("missing")}),
+		},
+		{
+			name: "ServiceMonitor with unknown scrape class",
+			path: serviceMonitorValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj:  serviceMonitor("monitoring", map[string]string{"team": "a"}, ptr.To("unknown"), monitoringv1.Endpoint{Port: "web"}),
+		},
+		{
+			name:    "unselected ServiceMonitor with known scrape class",
+			path:    serviceMonitorValidatePath,
+			gvr:     serviceMonitorGVR,
+			obj:     serviceMonitor("other", nil, ptr.To("custom"), monitoringv1.Endpoint{Port: "web"}),
+			allowed: true,
+		},
+		{
+			name: "unselected ServiceMonitor with invalid scrape interval",
+			path: serviceMonitorValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj:  serviceMonitor("other", nil, nil, monitoringv1.Endpoint{Port: "web", Interval: "10s", ScrapeTimeout: "20s"}),
+		},
+		{
+			name: "PodMonitor with invalid relabel config",
+			path: podMonitorValidatePath,
+			gvr:  podMonitorGVR,
+			obj: &monitoringv1.PodMonitor{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1", Kind: monitoringv1.PodMonitorsKind},
+				ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "monitoring"},
+				Spec: monitoringv1.PodMonitorSpec{
+					PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+						{
+							RelabelConfigs: []monitoringv1.RelabelConfig{
+								{Action: "hashmod", TargetLabel: "__tmp"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Probe with invalid prober URL",
+			path: probeValidatePath,
+			gvr:  probeGVR,
+			obj: &monitoringv1.Probe{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1", Kind: monitoringv1.ProbesKind},
+				ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "monitoring"},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{URL: "http://blackbox:9115"},
+				},
+			},
+		},
+		{
+			name:    "valid ScrapeConfig",
+			path:    scrapeConfigValidatePath,
+			gvr:     scrapeConfigGVR,
+			allowed: true,
+			obj: &monitoringv1alpha1.ScrapeConfig{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1alpha1", Kind: monitoringv1alpha1.ScrapeConfigsKind},
+				ObjectMeta: metav1.ObjectMeta{Name: "sc", Namespace: "monitoring"},
+				Spec: monitoringv1alpha1.ScrapeConfigSpec{
+					ScrapeInterval: ptr.To(monitoringv1.Duration("30s")),
+					ScrapeTimeout:  ptr.To(monitoringv1.Duration("10s")),
+				},
+			},
+		},
+		{
+			name: "ScrapeConfig with scrape timeout greater than scrape interval",
+			path: scrapeConfigValidatePath,
+			gvr:  scrapeConfigGVR,
+			obj: &monitoringv1alpha1.ScrapeConfig{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1alpha1", Kind: monitoringv1alpha1.ScrapeConfigsKind},
+				ObjectMeta: metav1.ObjectMeta{Name: "sc", Namespace: "monitoring"},
+				Spec: monitoringv1alpha1.ScrapeConfigSpec{
+					ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+					ScrapeTimeout:  ptr.To(monitoringv1.Duration("20s")),
+				},
+			},
+		},
+		{
+			name: "unexpected resource",
+			path: scrapeConfigValidatePath,
+			gvr:  serviceMonitorGVR,
+			obj:  serviceMonitor("monitoring", nil, nil, monitoringv1.Endpoint{Port: "web"}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(v1.AdmissionReview{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+				Request: &v1.AdmissionRequest{
+					UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+					Resource:  tc.gvr,
+					Namespace: tc.obj.(metav1.Object).GetNamespace(),
+					Operation: v1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			require.NoError(t, err)
+
+			resp, err := http.Post(ts.URL+tc.path, "application/json", bytes.NewReader(b))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			rev := &v1.AdmissionReview{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(rev))
+			require.Equal(t, tc.allowed, rev.Response.Allowed, "%v", rev.Response.Result)
+		})
+	}
+}
+
+func TestConfigResourcesValidationDisabled(t *testing.T) {
+	mux := http.NewServeMux()
+	api().Register(mux)
+
+	for _, p := range []string{serviceMonitorValidatePath, podMonitorValidatePath, probeValidatePath, scrapeConfigValidatePath} {
+		_, pattern := mux.Handler(httptest.NewRequest(http.MethodPost, p, nil))
+		require.Empty(t, pattern, p)
+	}
+}
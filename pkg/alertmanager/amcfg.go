@@ -15,14 +15,17 @@
 package alertmanager
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/blang/semver/v4"
@@ -232,6 +235,15 @@ type ConfigBuilder struct {
 	amVersion semver.Version
 	store     *assets.StoreBuilder
 	enforcer  enforcer
+	namespace string
+
+	// clusterRoutes is the number of top-level routes contributed by the
+	// ClusterAlertmanagerConfig objects.
+	clusterRoutes int
+
+	// templateFiles holds the notification templates contributed by the
+	// AlertmanagerConfig objects, indexed by file name.
+	templateFiles map[string][]byte
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, am *monitoringv1.Alertmanager) *ConfigBuilder {
@@ -240,6 +252,9 @@ func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *asse
 		amVersion: amVersion,
 		store:     store,
 		enforcer:  getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace),
+		namespace: am.Namespace,
+
+		templateFiles: map[string][]byte{},
 	}
 	return cg
 }
@@ -347,65 +362,156 @@ func (cb *ConfigBuilder) InitializeFromRawConfiguration(b []byte) error {
 }
 
 // AddAlertmanagerConfigs adds AlertmanagerConfig objects to the current configuration.
+// The top-level routes are sorted by decreasing priority and then by
+// `<namespace>/<name>`.
 func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) error {
+	// The kind is needed to verify the cross-namespace references.
+	ctx = assets.WithReferrer(ctx, monitoringv1alpha1.AlertmanagerConfigKind)
+
 	subRoutes := make([]*route, 0, len(amConfigs))
-	for _, amConfigIdentifier := range sortutil.SortedKeys(amConfigs) {
-		crKey := types.NamespacedName{
-			Name:      amConfigs[amConfigIdentifier].Name,
-			Namespace: amConfigs[amConfigIdentifier].Namespace,
-		}
-
-		// Add inhibitRules to baseConfig.InhibitRules.
-		for _, inhibitRule := range amConfigs[amConfigIdentifier].Spec.InhibitRules {
-			cb.cfg.InhibitRules = append(cb.cfg.InhibitRules,
-				cb.enforcer.processInhibitRule(
-					crKey,
-					cb.convertInhibitRule(
-						&inhibitRule,
-					),
-				),
-			)
+	for _, amConfigIdentifier := range sortByPriority(amConfigs, func(amc *monitoringv1alpha1.AlertmanagerConfig) *int32 { return amc.Spec.Priority }) {
+		r, err := cb.addAlertmanagerConfig(ctx, amConfigs[amConfigIdentifier], cb.enforcer)
+		if err != nil {
+			return err
 		}
 
-		// Skip early if there's no route definition.
-		if amConfigs[amConfigIdentifier].Spec.Route == nil {
-			continue
+		if r != nil {
+			subRoutes = append(subRoutes, r)
+		}
+	}
+
+	// For alerts to be processed by the AlertmanagerConfig routes, they need
+	// to appear before the routes defined in the main configuration (but
+	// after the routes of the ClusterAlertmanagerConfig objects).
+	// Because all first-level AlertmanagerConfig routes have "continue: true",
+	// alerts will fall through.
+	cb.cfg.Route.Routes = slices.Concat(
+		cb.cfg.Route.Routes[:cb.clusterRoutes],
+		subRoutes,
+		cb.cfg.Route.Routes[cb.clusterRoutes:],
+	)
+
+	return cb.cfg.sanitize(cb.amVersion, cb.logger)
+}
+
+// AddClusterAlertmanagerConfigs adds ClusterAlertmanagerConfig objects to the
+// current configuration.
+//
+// The routes and inhibition rules aren't restricted to a namespace and the
+// routes are inserted before the routes of the AlertmanagerConfig objects.
+// The Secrets and ConfigMaps are read from the namespace of the Alertmanager.
+func (cb *ConfigBuilder) AddClusterAlertmanagerConfigs(ctx context.Context, clusterAmConfigs map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig) error {
+	ctx = assets.WithReferrer(ctx, monitoringv1alpha1.ClusterAlertmanagerConfigKind)
+
+	// The top-level routes always continue to the next routes so that
+	// ClusterAlertmanagerConfig objects don't prevent the routes of the other
+	// objects from being evaluated.
+	e := &continueToNextRoute{e: &noopEnforcer{}}
+
+	subRoutes := make([]*route, 0, len(clusterAmConfigs))
+	for _, name := range sortByPriority(clusterAmConfigs, func(camc *monitoringv1alpha1.ClusterAlertmanagerConfig) *int32 { return camc.Spec.Priority }) {
+		r, err := cb.addAlertmanagerConfig(ctx, alertmanagerConfigFromCluster(clusterAmConfigs[name], cb.namespace), e)
+		if err != nil {
+			return err
+		}
+
+		if r != nil {
+			subRoutes = append(subRoutes, r)
 		}
+	}
+
+	cb.cfg.Route.Routes = append(subRoutes, cb.cfg.Route.Routes...)
+	cb.clusterRoutes += len(subRoutes)
 
-		subRoutes = append(subRoutes,
-			cb.enforcer.processRoute(
+	return cb.cfg.sanitize(cb.amVersion, cb.logger)
+}
+
+// sortByPriority returns the keys of the map sorted by decreasing priority
+// and then by key. A nil priority is equivalent to 0.
+func sortByPriority[T any](m map[string]T, priority func(T) *int32) []string {
+	keys := sortutil.SortedKeys(m)
+	slices.SortStableFunc(keys, func(a, b string) int {
+		return cmp.Compare(ptr.Deref(priority(m[b]), 0), ptr.Deref(priority(m[a]), 0))
+	})
+
+	return keys
+}
+
+// alertmanagerConfigFromCluster returns the AlertmanagerConfig object
+// equivalent to the ClusterAlertmanagerConfig object in the given namespace.
+func alertmanagerConfigFromCluster(camc *monitoringv1alpha1.ClusterAlertmanagerConfig, namespace string) *monitoringv1alpha1.AlertmanagerConfig {
+	amc := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: *camc.ObjectMeta.DeepCopy(),
+		Spec:       camc.Spec,
+	}
+	amc.Namespace = namespace
+
+	return amc
+}
+
+// addAlertmanagerConfig adds the inhibition rules, receivers, time intervals
+// and notification templates of the AlertmanagerConfig object to the current
+// configuration. It returns the top-level route of the object (nil if not
+// defined) processed by the enforcer.
+func (cb *ConfigBuilder) addAlertmanagerConfig(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, e enforcer) (*route, error) {
+	crKey := types.NamespacedName{
+		Name:      amc.Name,
+		Namespace: amc.Namespace,
+	}
+
+	// Add inhibitRules to baseConfig.InhibitRules.
+	for _, inhibitRule := range amc.Spec.InhibitRules {
+		cb.cfg.InhibitRules = append(cb.cfg.InhibitRules,
+			e.processInhibitRule(
 				crKey,
-				cb.convertRoute(
-					amConfigs[amConfigIdentifier].Spec.Route,
-					crKey,
+				cb.convertInhibitRule(
+					&inhibitRule,
 				),
 			),
 		)
+	}
 
-		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
-			receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
-			if err != nil {
-				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
-			}
-			cb.cfg.Receivers = append(cb.cfg.Receivers, receivers)
-		}
+	// Skip early if there's no route definition.
+	if amc.Spec.Route == nil {
+		return nil, nil
+	}
 
-		for _, muteTimeInterval := range amConfigs[amConfigIdentifier].Spec.MuteTimeIntervals {
-			mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
-			if err != nil {
-				return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
-			}
-			cb.cfg.MuteTimeIntervals = append(cb.cfg.MuteTimeIntervals, mti)
+	r := e.processRoute(
+		crKey,
+		cb.convertRoute(
+			amc.Spec.Route,
+			crKey,
+		),
+	)
+
+	templates, err := loadNotificationTemplates(ctx, amc, cb.store)
+	if err != nil {
+		return nil, fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+	}
+
+	for _, name := range sortutil.SortedKeys(templates.files) {
+		cb.templateFiles[name] = templates.files[name]
+		cb.cfg.Templates = append(cb.cfg.Templates, path.Join(alertmanagerConfigDir, name))
+	}
+
+	for _, receiver := range amc.Spec.Receivers {
+		receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
+		if err != nil {
+			return nil, fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
 		}
+		templates.namespaceReceiverReferences(receivers)
+		cb.cfg.Receivers = append(cb.cfg.Receivers, receivers)
 	}
 
-	// For alerts to be processed by the AlertmanagerConfig routes, they need
-	// to appear before the routes defined in the main configuration.
-	// Because all first-level AlertmanagerConfig routes have "continue: true",
-	// alerts will fall through.
-	cb.cfg.Route.Routes = append(subRoutes, cb.cfg.Route.Routes...)
+	for _, muteTimeInterval := range amc.Spec.MuteTimeIntervals {
+		mti, err := convertMuteTimeInterval(&muteTimeInterval, crKey)
+		if err != nil {
+			return nil, fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
+		cb.cfg.MuteTimeIntervals = append(cb.cfg.MuteTimeIntervals, mti)
+	}
 
-	return cb.cfg.sanitize(cb.amVersion, cb.logger)
+	return r, nil
 }
 
 func (cb *ConfigBuilder) getValidURLFromSecret(ctx context.Context, namespace string, selector v1.SecretKeySelector) (string, error) {
@@ -770,6 +876,42 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		}
 	}
 
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cb.convertJiraConfig(ctx, in.JiraConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("JiraConfig[%d]: %w", i, err)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
+	var incidentioConfigs []*incidentioConfig
+	if l := len(in.IncidentioConfigs); l > 0 {
+		incidentioConfigs = make([]*incidentioConfig, l)
+		for i := range in.IncidentioConfigs {
+			receiver, err := cb.convertIncidentioConfig(ctx, in.IncidentioConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("IncidentioConfig[%d]: %w", i, err)
+			}
+			incidentioConfigs[i] = receiver
+		}
+	}
+
+	var mattermostConfigs []*mattermostConfig
+	if l := len(in.MattermostConfigs); l > 0 {
+		mattermostConfigs = make([]*mattermostConfig, l)
+		for i := range in.MattermostConfigs {
+			receiver, err := cb.convertMattermostConfig(ctx, in.MattermostConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("MattermostConfig[%d]: %w", i, err)
+			}
+			mattermostConfigs[i] = receiver
+		}
+	}
+
 	return &receiver{
 		Name:              makeNamespacedString(in.Name, crKey),
 		OpsgenieConfigs:   opsgenieConfigs,
@@ -787,6 +929,9 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		MSTeamsConfigs:    msTeamsConfigs,
 		MSTeamsV2Configs:  msTeamsV2Configs,
 		RocketChatConfigs: rocketchatConfigs,
+		JiraConfigs:       jiraConfigs,
+		IncidentioConfigs: incidentioConfigs,
+		MattermostConfigs: mattermostConfigs,
 	}, nil
 }
 
@@ -816,6 +961,157 @@ func (cb *ConfigBuilder) convertRocketChatConfig(ctx context.Context, in monitor
 	return out, nil
 }
 
+func (cb *ConfigBuilder) convertJiraConfig(ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName) (*jiraConfig, error) {
+	out := &jiraConfig{
+		SendResolved: in.SendResolved,
+		Project:      in.Project,
+		IssueType:    in.IssueType,
+		Labels:       in.Labels,
+	}
+
+	if in.APIURL != nil {
+		out.APIURL = string(*in.APIURL)
+	} else if cb.cfg.Global == nil || cb.cfg.Global.JiraAPIURL == nil {
+		return nil, errors.New("API URL for Jira is a mandatory field, it is neither specified at global config nor at receiver level")
+	}
+
+	if in.Summary != nil {
+		out.Summary = *in.Summary
+	}
+
+	if in.Description != nil {
+		out.Description = *in.Description
+	}
+
+	if in.Priority != nil {
+		out.Priority = *in.Priority
+	}
+
+	if in.ReopenTransition != nil {
+		out.ReopenTransition = *in.ReopenTransition
+	}
+
+	if in.ResolveTransition != nil {
+		out.ResolveTransition = *in.ResolveTransition
+	}
+
+	if in.WontFixResolution != nil {
+		out.WontFixResolution = *in.WontFixResolution
+	}
+
+	if in.ReopenDuration != nil {
+		reopenDuration, err := model.ParseDuration(string(*in.ReopenDuration))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse reopen duration: %w", err)
+		}
+		out.ReopenDuration = reopenDuration
+	}
+
+	if len(in.Fields) > 0 {
+		out.Fields = make(map[string]any, len(in.Fields))
+		for k, v := range in.Fields {
+			var value any
+			if err := json.Unmarshal(v.Raw, &value); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal field %q: %w", k, err)
+			}
+			out.Fields[k] = value
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertIncidentioConfig(ctx context.Context, in monitoringv1alpha1.IncidentioConfig, crKey types.NamespacedName) (*incidentioConfig, error) {
+	out := &incidentioConfig{
+		SendResolved: in.SendResolved,
+		MaxAlerts:    in.MaxAlerts,
+	}
+
+	if in.URLSecret != nil {
+		url, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, *in.URLSecret)
+		if err != nil {
+			return nil, err
+		}
+		out.URL = url
+	} else if in.URL != nil {
+		url, err := validation.ValidateURL(string(*in.URL))
+		if err != nil {
+			return nil, err
+		}
+		out.URL = url.String()
+	}
+
+	if in.AlertSourceToken != nil {
+		token, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AlertSourceToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incident.io alert source token: %w", err)
+		}
+		out.AlertSourceToken = token
+	}
+
+	if in.Timeout != nil {
+		timeout, err := model.ParseDuration(string(*in.Timeout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse timeout: %w", err)
+		}
+		out.Timeout = &timeout
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertMattermostConfig(ctx context.Context, in monitoringv1alpha1.MattermostConfig, crKey types.NamespacedName) (*mattermostConfig, error) {
+	out := &mattermostConfig{
+		SendResolved: in.SendResolved,
+	}
+
+	webhookURL, err := cb.getValidURLFromSecret(ctx, crKey.Namespace, in.WebhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Mattermost webhook URL: %w", err)
+	}
+	out.WebhookURL = webhookURL
+
+	if in.Channel != nil {
+		out.Channel = *in.Channel
+	}
+
+	if in.Username != nil {
+		out.Username = *in.Username
+	}
+
+	if in.Text != nil {
+		out.Text = *in.Text
+	}
+
+	if in.IconURL != nil {
+		out.IconURL = string(*in.IconURL)
+	}
+
+	if in.IconEmoji != nil {
+		out.IconEmoji = *in.IconEmoji
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
 func (cb *ConfigBuilder) convertWebhookConfig(ctx context.Context, in monitoringv1alpha1.WebhookConfig, crKey types.NamespacedName) (*webhookConfig, error) {
 	out := &webhookConfig{
 		VSendResolved: in.SendResolved,
@@ -2337,6 +2633,30 @@ func (r *receiver) sanitize(amVersion semver.Version, logger *slog.Logger) error
 			return err
 		}
 	}
+
+	if len(r.IncidentioConfigs) > 0 && amVersion.LT(semver.MustParse("0.29.0")) {
+		msg := "'incidentio_configs' supported in Alertmanager >= 0.29.0 only - dropping field from provided config"
+		withLogger.Warn(msg, "current_version", amVersion.String())
+		r.IncidentioConfigs = nil
+	}
+
+	for _, conf := range r.IncidentioConfigs {
+		if err := conf.sanitize(amVersion, withLogger); err != nil {
+			return err
+		}
+	}
+
+	if len(r.MattermostConfigs) > 0 && amVersion.LT(semver.MustParse("0.30.0")) {
+		msg := "'mattermost_configs' supported in Alertmanager >= 0.30.0 only - dropping field from provided config"
+		withLogger.Warn(msg, "current_version", amVersion.String())
+		r.MattermostConfigs = nil
+	}
+
+	for _, conf := range r.MattermostConfigs {
+		if err := conf.sanitize(amVersion, withLogger); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -2733,6 +3053,34 @@ func (rc *rocketChatConfig) sanitize(amVersion semver.Version, logger *slog.Logg
 	return rc.HTTPConfig.sanitize(amVersion, logger)
 }
 
+func (ic *incidentioConfig) sanitize(amVersion semver.Version, logger *slog.Logger) error {
+	if ic.URL != "" && ic.URLFile != "" {
+		return errors.New("at most one of url & url_file must be configured")
+	}
+
+	if ic.URL == "" && ic.URLFile == "" {
+		return errors.New("one of url or url_file must be configured")
+	}
+
+	if ic.AlertSourceToken != "" && ic.AlertSourceTokenFile != "" {
+		return errors.New("at most one of alert_source_token & alert_source_token_file must be configured")
+	}
+
+	if (ic.AlertSourceToken != "" || ic.AlertSourceTokenFile != "") && ic.HTTPConfig != nil && ic.HTTPConfig.Authorization != nil {
+		return errors.New("at most one of alert_source_token, alert_source_token_file & http_config.authorization must be configured")
+	}
+
+	return ic.HTTPConfig.sanitize(amVersion, logger)
+}
+
+func (mc *mattermostConfig) sanitize(amVersion semver.Version, logger *slog.Logger) error {
+	if mc.WebhookURL != "" && mc.WebhookURLFile != "" {
+		return errors.New("at most one of webhook_url & webhook_url_file must be configured")
+	}
+
+	return mc.HTTPConfig.sanitize(amVersion, logger)
+}
+
 func (ir *inhibitRule) sanitize(amVersion semver.Version, logger *slog.Logger) error {
 	matchersV2Allowed := amVersion.GTE(semver.MustParse("0.22.0"))
 
@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"net/http"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -34,12 +36,17 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	authv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/clustertlsconfig"
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation"
 	validationv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation/v1alpha1"
@@ -61,6 +68,11 @@ const (
 	applicationNameLabelValue = "alertmanager"
 
 	selectingAlertmanagerConfigResourcesAction = "SelectingAlertmanagerConfigResources"
+
+	// PriorityConflict is the reason for AlertmanagerConfig resources which
+	// define the same priority as other resources selected by the
+	// Alertmanager.
+	PriorityConflict = "PriorityConflict"
 )
 
 // Config defines the operator's parameters for the Alertmanager controller.
@@ -81,6 +93,7 @@ type Operator struct {
 	kclient    kubernetes.Interface
 	mdClient   metadata.Interface
 	mclient    monitoringclient.Interface
+	dclient    dynamic.Interface
 	ssarClient authv1.SelfSubjectAccessReviewInterface
 
 	controllerID string
@@ -96,6 +109,10 @@ type Operator struct {
 	cmapInfs    *informers.ForResource
 	secrInfs    *informers.ForResource
 	ssetInfs    *informers.ForResource
+	grantInfs   *informers.ForResource
+	silenceInfs *informers.ForResource
+
+	clusterAlrtCfgInfs *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
@@ -109,6 +126,17 @@ type Operator struct {
 	config Config
 
 	configResourcesStatusEnabled bool
+	referenceGrantSupported      bool
+	silenceSupported             bool
+	clusterConfigSupported       bool
+
+	finalizerSyncer *operator.FinalizerSyncer
+
+	secretProviders *assets.SecretProviders
+
+	silenceHTTPClient *http.Client
+	silenceTimersMtx  sync.Mutex
+	silenceTimers     map[string]*time.Timer
 }
 
 type ControllerOption func(*Operator)
@@ -129,6 +157,38 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithReferenceGrant tells that the controller allows cross-namespace
+// references granted by ReferenceGrant objects.
+func WithReferenceGrant() ControllerOption {
+	return func(o *Operator) {
+		o.referenceGrantSupported = true
+	}
+}
+
+// WithAlertmanagerSilence tells that the controller can synchronize the
+// silences of the Alertmanager pods with AlertmanagerSilence objects.
+func WithAlertmanagerSilence() ControllerOption {
+	return func(o *Operator) {
+		o.silenceSupported = true
+	}
+}
+
+// WithClusterAlertmanagerConfig tells that the controller can merge
+// ClusterAlertmanagerConfig objects into the Alertmanager configuration.
+func WithClusterAlertmanagerConfig() ControllerOption {
+	return func(o *Operator) {
+		o.clusterConfigSupported = true
+	}
+}
+
+// WithSecretProviders tells that the controller can resolve credentials from
+// the given secret providers.
+func WithSecretProviders(sp *assets.SecretProviders) ControllerOption {
+	return func(o *Operator) {
+		o.secretProviders = sp
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -148,6 +208,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	// All the metrics exposed by the controller get the controller="alertmanager" label.
 	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "alertmanager"}, r)
 
@@ -155,6 +220,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		kclient:    client,
 		mdClient:   mdClient,
 		mclient:    mclient,
+		dclient:    dclient,
 		ssarClient: client.AuthorizationV1().SelfSubjectAccessReviews(),
 
 		logger:   logger,
@@ -166,6 +232,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 		controllerID: c.ControllerID,
 
+		silenceHTTPClient: &http.Client{Timeout: silenceAPITimeout},
+		silenceTimers:     map[string]*time.Timer{},
+
+		finalizerSyncer: operator.NewNoopFinalizerSyncer(),
+
 		config: Config{
 			LocalHost:                    c.LocalHost,
 			ClusterDomain:                c.ClusterDomain,
@@ -179,6 +250,10 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		opt(o)
 	}
 
+	if o.configResourcesStatusEnabled {
+		o.finalizerSyncer = operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName))
+	}
+
 	if err := o.bootstrap(ctx, c); err != nil {
 		return nil, err
 	}
@@ -236,6 +311,54 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating alertmanagerconfig informers: %w", err)
 	}
 
+	if c.referenceGrantSupported {
+		c.grantInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ReferenceGrantName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating referencegrants informers: %w", err)
+		}
+	}
+
+	if c.silenceSupported {
+		c.silenceInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerSilenceName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating alertmanagersilences informers: %w", err)
+		}
+	}
+
+	if c.clusterConfigSupported {
+		c.clusterAlrtCfgInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				map[string]struct{}{v1.NamespaceAll: {}},
+				map[string]struct{}{},
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ClusterAlertmanagerConfigName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating clusteralertmanagerconfigs informers: %w", err)
+		}
+	}
+
 	allowList := config.Namespaces.AlertmanagerConfigAllowList
 	if config.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -353,7 +476,14 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"Secret", c.secrInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"StatefulSet", c.ssetInfs},
+		{"ReferenceGrant", c.grantInfs},
+		{"AlertmanagerSilence", c.silenceInfs},
+		{"ClusterAlertmanagerConfig", c.clusterAlrtCfgInfs},
 	} {
+		if infs.informersForResource == nil {
+			continue
+		}
+
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -406,7 +536,7 @@ func (c *Operator) addHandlers() {
 		c.accessor,
 		c.metrics,
 		operator.SecretGVK().Kind,
-		c.enqueueForNamespace,
+		c.enqueueForReferencedNamespace,
 		operator.WithFilter(operator.ResourceVersionChanged),
 		operator.WithFilter(hasRefFunc),
 	))
@@ -416,21 +546,111 @@ func (c *Operator) addHandlers() {
 		c.accessor,
 		c.metrics,
 		operator.ConfigMapGVK().Kind,
-		c.enqueueForNamespace,
+		c.enqueueForReferencedNamespace,
 		operator.WithFilter(operator.ResourceVersionChanged),
 		operator.WithFilter(hasRefFunc),
 	))
 
+	if c.grantInfs != nil {
+		c.grantInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ReferenceGrantsKind,
+			c.enqueueForCrossNamespaceReferences,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
+	if c.silenceInfs != nil {
+		c.silenceInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.AlertmanagerSilenceKind,
+			c.enqueueForNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	if c.clusterAlrtCfgInfs != nil {
+		c.clusterAlrtCfgInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ClusterAlertmanagerConfigKind,
+			c.enqueueForClusterAlertmanagerConfig,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	if c.secretProviders != nil {
+		c.secretProviders.OnChange(func(s *v1.Secret) {
+			c.enqueueForReference(s)
+		})
+		c.secretProviders.AddReferenceChecker(func(s *v1.Secret) bool {
+			return c.reconciliations.IsReferenced(s)
+		})
+	}
+
 	// The controller needs to watch the namespaces in which the
-	// alertmanagerconfigs live because a label change on a namespace may
-	// trigger a configuration change.
+	// alertmanagerconfigs and alertmanagersilences live because a label
+	// change on a namespace may trigger a configuration change.
 	// It doesn't need to watch on addition/deletion though because it's
-	// already covered by the event handlers on alertmanagerconfigs.
+	// already covered by the event handlers on alertmanagerconfigs and
+	// alertmanagersilences.
 	_, _ = c.nsAlrtCfgInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: c.handleNamespaceUpdate,
 	})
 }
 
+// enqueueForReferencedNamespace enqueues the Alertmanager objects which may
+// reference Secrets or ConfigMaps from the given namespace.
+func (c *Operator) enqueueForReferencedNamespace(nsName string) {
+	c.enqueueForNamespace(nsName)
+	c.enqueueForCrossNamespaceReferences(nsName)
+}
+
+// enqueueForCrossNamespaceReferences enqueues the Alertmanager objects which
+// reference Secrets or ConfigMaps from the given namespace via a
+// ReferenceGrant object.
+func (c *Operator) enqueueForCrossNamespaceReferences(nsName string) {
+	if c.grantInfs == nil {
+		return
+	}
+
+	c.enqueueForReference(&monitoringv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: nsName},
+	})
+}
+
+// enqueueForReference enqueues the Alertmanager objects which have a reference to
+// the given object.
+func (c *Operator) enqueueForReference(ref runtime.Object) {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if c.reconciliations.HasRefTo(fmt.Sprintf("%s/%s", am.Namespace, am.Name), ref) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
 // enqueueForNamespace enqueues all Alertmanager object keys that belong to the
 // given namespace or select objects in the given namespace.
 func (c *Operator) enqueueForNamespace(nsName string) {
@@ -471,6 +691,42 @@ func (c *Operator) enqueueForNamespace(nsName string) {
 			c.rr.EnqueueForReconciliation(am)
 			return
 		}
+
+		// Check for Alertmanager instances selecting AlertmanagerSilences in
+		// the namespace.
+		if am.Spec.AlertmanagerSilenceNamespaceSelector == nil {
+			return
+		}
+
+		silenceNSSelector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerSilenceNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert AlertmanagerSilenceNamespaceSelector of %q to selector", am.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if silenceNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(am)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Alertmanager instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// enqueueForClusterAlertmanagerConfig enqueues all Alertmanager object keys
+// that select ClusterAlertmanagerConfig objects.
+func (c *Operator) enqueueForClusterAlertmanagerConfig(_ string) {
+	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+		if am.Spec.ClusterAlertmanagerConfigSelector != nil {
+			c.rr.EnqueueForReconciliation(am)
+		}
 	})
 	if err != nil {
 		c.logger.Error(
@@ -490,6 +746,15 @@ func (c *Operator) Run(ctx context.Context) error {
 	go c.secrInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	if c.grantInfs != nil {
+		go c.grantInfs.Start(ctx.Done())
+	}
+	if c.silenceInfs != nil {
+		go c.silenceInfs.Start(ctx.Done())
+	}
+	if c.clusterAlrtCfgInfs != nil {
+		go c.clusterAlrtCfgInfs.Start(ctx.Done())
+	}
 	go c.nsAlrtCfgInf.Run(ctx.Done())
 	if c.nsAlrtInf != c.nsAlrtCfgInf {
 		go c.nsAlrtInf.Run(ctx.Done())
@@ -548,23 +813,34 @@ func (c *Operator) handleNamespaceUpdate(oldo, curo any) {
 	c.logger.Debug("Namespace updated", "namespace", cur.GetName())
 	c.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
 
-	// Check for Alertmanager instances selecting AlertmanagerConfigs in the namespace.
+	// Check for Alertmanager instances selecting AlertmanagerConfigs or
+	// AlertmanagerSilences in the namespace.
 	err := c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
 		a := obj.(*monitoringv1.Alertmanager)
 
-		sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, a.Spec.AlertmanagerConfigNamespaceSelector)
-		if err != nil {
-			c.logger.Error(
-				"failed to detect label selection change",
-				"err", err,
-				"name", a.Name,
-				"namespace", a.Namespace,
-			)
-			return
-		}
+		for _, selector := range []*metav1.LabelSelector{
+			a.Spec.AlertmanagerConfigNamespaceSelector,
+			a.Spec.AlertmanagerSilenceNamespaceSelector,
+		} {
+			if selector == nil {
+				continue
+			}
 
-		if sync {
-			c.rr.EnqueueForReconciliation(a)
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, selector)
+			if err != nil {
+				c.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", a.Name,
+					"namespace", a.Namespace,
+				)
+				return
+			}
+
+			if sync {
+				c.rr.EnqueueForReconciliation(a)
+				return
+			}
 		}
 	})
 	if err != nil {
@@ -578,6 +854,11 @@ func (c *Operator) handleNamespaceUpdate(oldo, curo any) {
 // Sync implements the operator.Syncer interface.
 func (c *Operator) Sync(ctx context.Context, key string) error {
 	err := c.sync(ctx, key)
+	if err == nil {
+		// The silences are synchronized once the statefulset is reconciled
+		// because they depend on the availability of the Alertmanager pods.
+		err = c.syncSilences(ctx, key)
+	}
 	c.reconciliations.SetStatus(key, err)
 
 	return err
@@ -596,6 +877,21 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	statusCleanup := func() error {
+		return c.configResStatusCleanup(ctx, am)
+	}
+
+	finalizerAdded, err := c.finalizerSyncer.Sync(ctx, am, c.rr.DeletionInProgress(am), statusCleanup)
+	if err != nil {
+		return err
+	}
+
+	if finalizerAdded {
+		// Since the object has been updated, let's trigger another sync.
+		c.rr.EnqueueForReconciliation(am)
+		return nil
+	}
+
 	// Check if the Alertmanager instance is marked for deletion.
 	if c.rr.DeletionInProgress(am) {
 		return nil
@@ -615,6 +911,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+	if c.grantInfs != nil {
+		assetStore.WithReferenceGrants(c.grantInfs.ListAllByNamespace)
+	}
+	if c.secretProviders != nil {
+		assetStore.WithSecretProviders(c.secretProviders)
+	}
 
 	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
 		return fmt.Errorf("provision alertmanager configuration: %w", err)
@@ -879,39 +1181,92 @@ func (c *Operator) loadConfigurationFromSecret(ctx context.Context, am *monitori
 }
 
 func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder) error {
+	var clusterListFn listAllFn
+	if c.clusterAlrtCfgInfs != nil {
+		clusterListFn = c.clusterAlrtCfgInfs.ListAll
+	}
+
+	conf, additionalData, amConfigs, err := c.generateConfiguration(ctx, am, store, c.alrtCfgInfs.ListAllByNamespace, clusterListFn)
+	if err != nil {
+		return err
+	}
+
+	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, conf, additionalData)
+	if err != nil {
+		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
+	}
+
+	return c.updateConfigResourcesStatus(ctx, am, amConfigs)
+}
+
+// updateConfigResourcesStatus updates the status of the selected
+// AlertmanagerConfig objects and removes the Alertmanager's binding from the
+// objects which aren't selected anymore.
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, am *monitoringv1.Alertmanager, amConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	configResourceSyncer := operator.NewConfigResourceSyncer(am, c.dclient, c.accessor)
+
+	for key, configResource := range amConfigs {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update AlertmanagerConfig %s status: %w", key, err)
+		}
+	}
+
+	if err := operator.CleanupBindings(ctx, c.alrtCfgInfs.ListAll, amConfigs, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for alertmanagerConfigs: %w", err)
+	}
+
+	return nil
+}
+
+// configResStatusCleanup removes the Alertmanager's binding from all the
+// AlertmanagerConfig objects.
+func (c *Operator) configResStatusCleanup(ctx context.Context, am *monitoringv1.Alertmanager) error {
+	return c.updateConfigResourcesStatus(ctx, am, nil)
+}
+
+// generateConfiguration returns the Alertmanager configuration, the
+// additional data of the generated configuration secret (from the
+// user-provided configuration secret and the notification templates of the
+// AlertmanagerConfig objects) and the selected AlertmanagerConfig objects.
+// AlertmanagerConfig objects are listed using listFn.
+func (c *Operator) generateConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder, listFn listAllByNamespaceFn, clusterListFn listAllFn) ([]byte, map[string][]byte, operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], error) {
 	amVersion := operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion)
 	version, err := semver.ParseTolerant(amVersion)
 	if err != nil {
-		return fmt.Errorf("failed to parse alertmanager version: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to parse alertmanager version: %w", err)
 	}
 
 	if version.LT(semver.MustParse("0.15.0")) || version.Major > 0 {
-		return fmt.Errorf("unsupported Alertmanager version %q", amVersion)
+		return nil, nil, nil, fmt.Errorf("unsupported Alertmanager version %q", amVersion)
 	}
 
 	namespacedLogger := c.logger.With("alertmanager", am.Name, "namespace", am.Namespace)
 	// If no AlertmanagerConfig selectors and AlertmanagerConfiguration are
 	// configured, the user wants to manage configuration themselves.
-	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
-		namespacedLogger.Debug("AlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
+	if am.Spec.AlertmanagerConfigSelector == nil && am.Spec.ClusterAlertmanagerConfigSelector == nil && am.Spec.AlertmanagerConfiguration == nil {
+		namespacedLogger.Debug("AlertmanagerConfigSelector, ClusterAlertmanagerConfigSelector and AlertmanagerConfiguration not specified, using the configuration from secret as-is",
 			"secret", defaultConfigSecretName(am))
 
 		amRawConfiguration, additionalData, err := c.loadConfigurationFromSecret(ctx, am)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
-		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData)
-		if err != nil {
-			return fmt.Errorf("create or update generated config secret failed: %w", err)
-		}
+		return amRawConfiguration, additionalData, nil, nil
+	}
 
-		return nil
+	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, version, store, listFn)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
 	}
 
-	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, version, store)
+	clusterAmConfigs, err := c.selectClusterAlertmanagerConfigs(ctx, am, version, store, clusterListFn, amConfigs)
 	if err != nil {
-		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to select ClusterAlertmanagerConfig objects: %w", err)
 	}
 
 	var (
@@ -924,12 +1279,12 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		globalAmConfig, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(am.Namespace).
 			Get(ctx, am.Spec.AlertmanagerConfiguration.Name, metav1.GetOptions{})
 		if err != nil {
-			return fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
 		}
 
 		err = cfgBuilder.initializeFromAlertmanagerConfig(ctx, am.Spec.AlertmanagerConfiguration.Global, globalAmConfig)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
 		}
 
 		for _, v := range am.Spec.AlertmanagerConfiguration.Templates {
@@ -949,30 +1304,38 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 
 		amRawConfiguration, additionalData, err = c.loadConfigurationFromSecret(ctx, am)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
 		err = cfgBuilder.InitializeFromRawConfiguration(amRawConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from secret: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to initialize from secret: %w", err)
 		}
 	}
 
-	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
-		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	if len(clusterAmConfigs) > 0 {
+		if err := cfgBuilder.AddClusterAlertmanagerConfigs(ctx, clusterAmConfigs); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+		}
+	}
+
+	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs.ValidResources()); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
 	}
 
 	generatedConfig, err := cfgBuilder.MarshalJSON()
 	if err != nil {
-		return fmt.Errorf("failed to marshal configuration: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to marshal configuration: %w", err)
 	}
 
-	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
-	if err != nil {
-		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
+	if len(cfgBuilder.templateFiles) > 0 {
+		data := make(map[string][]byte, len(additionalData)+len(cfgBuilder.templateFiles))
+		maps.Copy(data, additionalData)
+		maps.Copy(data, cfgBuilder.templateFiles)
+		additionalData = data
 	}
 
-	return nil
+	return generatedConfig, additionalData, amConfigs, nil
 }
 
 func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
@@ -1005,7 +1368,14 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	return nil
 }
 
-func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+// listAllByNamespaceFn lists the objects matching the label selector in the
+// given namespace.
+type listAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
+
+// listAllFn lists the cluster-scoped objects matching the label selector.
+type listAllFn func(selector labels.Selector, appendFn cache.AppendFunc) error
+
+func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder, listFn listAllByNamespaceFn) (operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], error) {
 	namespaces := []string{}
 
 	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
@@ -1038,7 +1408,7 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	}
 
 	for _, ns := range namespaces {
-		err := c.alrtCfgInfs.ListAllByNamespace(ns, amConfigSelector, func(obj any) {
+		err := listFn(ns, amConfigSelector, func(obj any) {
 			k, ok := c.accessor.MetaNamespaceKey(obj)
 			if !ok {
 				return
@@ -1058,7 +1428,7 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 	}
 
 	var rejected int
-	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
+	res := make(operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], len(amConfigs))
 
 	eventRecorder := c.newEventRecorder(am)
 	for namespaceAndName, amc := range amConfigs {
@@ -1072,29 +1442,188 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 				"alertmanager", am.Name,
 			)
 			eventRecorder.Eventf(amc, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerConfigResourcesAction, "AlertmanagerConfig %s was rejected due to invalid configuration: %v", amc.GetName(), err)
+			res[namespaceAndName] = operator.NewTypedConfigurationResource(amc, err, operator.InvalidConfiguration, amc.GetGeneration())
 			continue
 		}
 
-		res[namespaceAndName] = amc
+		res[namespaceAndName] = operator.NewTypedConfigurationResource(amc, nil, "", amc.GetGeneration())
 	}
 
+	for k, warning := range priorityConflicts(res.ValidResources()) {
+		c.logger.Debug("alertmanagerconfig priority conflict", "alertmanagerconfig", k, "warning", warning, "namespace", am.Namespace, "alertmanager", am.Name)
+		res[k] = operator.NewTypedConfigurationResourceWithWarnings(amConfigs[k], PriorityConflict, []string{warning}, amConfigs[k].GetGeneration())
+	}
+
+	validRes := res.ValidResources()
 	amcKeys := []string{}
-	for k := range res {
+	for k := range validRes {
 		amcKeys = append(amcKeys, k)
 	}
 	c.logger.Debug("selected AlertmanagerConfigs", "alertmanagerconfigs", strings.Join(amcKeys, ","), "namespace", am.Namespace, "prometheus", am.Name)
 
 	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
-		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(res))
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(validRes))
 		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, rejected)
 	}
 
 	return res, nil
 }
 
+// priorityConflicts returns the AlertmanagerConfig objects which define the
+// same priority as other objects with the description of the conflict.
+//
+// Like sortByPriority(), an unset priority is equivalent to 0 but only the
+// objects which explicitly define the priority are reported. The description
+// only names the conflicting objects from the same namespace, the objects
+// from other namespaces are counted.
+func priorityConflicts(amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig) map[string]string {
+	byPriority := map[int32][]string{}
+	for _, k := range sortutil.SortedKeys(amConfigs) {
+		p := ptr.Deref(amConfigs[k].Spec.Priority, 0)
+		byPriority[p] = append(byPriority[p], k)
+	}
+
+	conflicts := map[string]string{}
+	for p, keys := range byPriority {
+		if len(keys) < 2 {
+			continue
+		}
+
+		for _, k := range keys {
+			if amConfigs[k].Spec.Priority == nil {
+				continue
+			}
+
+			var (
+				sameNamespace []string
+				otherCount    int
+			)
+			for _, o := range keys {
+				switch {
+				case o == k:
+				case amConfigs[o].Namespace == amConfigs[k].Namespace:
+					sameNamespace = append(sameNamespace, o)
+				default:
+					otherCount++
+				}
+			}
+
+			var others []string
+			if len(sameNamespace) > 0 {
+				others = append(others, strings.Join(sameNamespace, ", "))
+			}
+			if otherCount > 0 {
+				others = append(others, fmt.Sprintf("%d object(s) in other namespaces", otherCount))
+			}
+
+			conflicts[k] = fmt.Sprintf("priority %d is also used by %s, the routes are ordered by namespace and name", p, strings.Join(others, " and "))
+		}
+	}
+
+	return conflicts
+}
+
+// selectClusterAlertmanagerConfigs returns the valid ClusterAlertmanagerConfig
+// objects selected by the Alertmanager, indexed by name.
+//
+// A ClusterAlertmanagerConfig object is rejected if an AlertmanagerConfig
+// object with the same name is merged from the Alertmanager's namespace since
+// the names of the receivers and time intervals would conflict.
+func (c *Operator) selectClusterAlertmanagerConfigs(
+	ctx context.Context,
+	am *monitoringv1.Alertmanager,
+	amVersion semver.Version,
+	store *assets.StoreBuilder,
+	listFn listAllFn,
+	amConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig],
+) (map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig, error) {
+	if am.Spec.ClusterAlertmanagerConfigSelector == nil {
+		return nil, nil
+	}
+
+	if listFn == nil {
+		c.logger.Warn(
+			"ClusterAlertmanagerConfig objects aren't supported, ignoring clusterAlertmanagerConfigSelector",
+			"namespace", am.Namespace,
+			"alertmanager", am.Name,
+		)
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.ClusterAlertmanagerConfigSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterAmConfigs := make(map[string]*monitoringv1alpha1.ClusterAlertmanagerConfig)
+	err = listFn(selector, func(obj any) {
+		camc := obj.(*monitoringv1alpha1.ClusterAlertmanagerConfig)
+		clusterAmConfigs[camc.Name] = camc
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster alertmanager configs: %w", err)
+	}
+
+	var (
+		rejected      int
+		validAmConfig = amConfigs.ValidResources()
+		eventRecorder = c.newEventRecorder(am)
+	)
+	for name, camc := range clusterAmConfigs {
+		err := c.checkClusterAlertmanagerConfigResource(ctx, am, camc, amVersion, store, validAmConfig)
+		if err == nil {
+			continue
+		}
+
+		rejected++
+		c.logger.Warn(
+			"skipping clusteralertmanagerconfig",
+			"error", err.Error(),
+			"clusteralertmanagerconfig", name,
+			"namespace", am.Namespace,
+			"alertmanager", am.Name,
+		)
+		eventRecorder.Eventf(camc, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerConfigResourcesAction, "ClusterAlertmanagerConfig %s was rejected due to invalid configuration: %v", name, err)
+		delete(clusterAmConfigs, name)
+	}
+
+	c.logger.Debug("selected ClusterAlertmanagerConfigs", "clusteralertmanagerconfigs", strings.Join(sortutil.SortedKeys(clusterAmConfigs), ","), "namespace", am.Namespace, "alertmanager", am.Name)
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, len(clusterAmConfigs))
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.ClusterAlertmanagerConfigKind, rejected)
+	}
+
+	return clusterAmConfigs, nil
+}
+
+// checkClusterAlertmanagerConfigResource verifies that a
+// ClusterAlertmanagerConfig object is valid for the given Alertmanager.
+func (c *Operator) checkClusterAlertmanagerConfigResource(
+	ctx context.Context,
+	am *monitoringv1.Alertmanager,
+	camc *monitoringv1alpha1.ClusterAlertmanagerConfig,
+	amVersion semver.Version,
+	store *assets.StoreBuilder,
+	amConfigs map[string]*monitoringv1alpha1.AlertmanagerConfig,
+) error {
+	if am.Spec.AlertmanagerConfiguration != nil && am.Spec.AlertmanagerConfiguration.Name == camc.Name {
+		return fmt.Errorf("name conflicts with the global AlertmanagerConfig %s/%s", am.Namespace, camc.Name)
+	}
+
+	k := types.NamespacedName{Namespace: am.Namespace, Name: camc.Name}
+	if _, found := amConfigs[k.String()]; found {
+		return fmt.Errorf("name conflicts with the AlertmanagerConfig %s", k.String())
+	}
+
+	return checkAlertmanagerConfigResource(ctx, alertmanagerConfigFromCluster(camc, am.Namespace), amVersion, store)
+}
+
 // checkAlertmanagerConfigResource verifies that an AlertmanagerConfig object is valid
 // for the given Alertmanager version and has no missing references to other objects.
 func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, amVersion semver.Version, store *assets.StoreBuilder) error {
+	ctx = assets.WithReferrer(ctx, monitoringv1alpha1.AlertmanagerConfigKind)
+
 	// Perform semantic validation irrespective of the Alertmanager version.
 	if err := validationv1alpha1.ValidateAlertmanagerConfig(amc); err != nil {
 		return err
@@ -1111,6 +1640,10 @@ func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha
 		return err
 	}
 
+	if _, err := loadNotificationTemplates(ctx, amc, store); err != nil {
+		return err
+	}
+
 	return checkInhibitRules(amc, amVersion)
 }
 
@@ -1248,6 +1781,21 @@ func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerCon
 		if err != nil {
 			return err
 		}
+
+		err = checkJiraConfigs(ctx, receiver.JiraConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkIncidentioConfigs(ctx, receiver.IncidentioConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkMattermostConfigs(ctx, receiver.MattermostConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1409,6 +1957,139 @@ func checkRocketChatConfigs(
 	return nil
 }
 
+func checkJiraConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.JiraConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`jiraConfigs' is available in Alertmanager >= 0.28.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+
+		if config.APIURL != nil {
+			if _, err := validation.ValidateURL(strings.TrimSpace(string(*config.APIURL))); err != nil {
+				return fmt.Errorf("failed to validate Jira API URL: %w", err)
+			}
+		}
+
+		if config.ReopenDuration != nil {
+			if _, err := model.ParseDuration(string(*config.ReopenDuration)); err != nil {
+				return fmt.Errorf("failed to parse Jira reopen duration: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkIncidentioConfigs doesn't fail when the Alertmanager version doesn't
+// support incident.io: the configurations are dropped when the configuration
+// is sanitized.
+func checkIncidentioConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.IncidentioConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+
+		switch {
+		case config.URLSecret != nil:
+			url, err := store.GetSecretKey(ctx, namespace, *config.URLSecret)
+			if err != nil {
+				return err
+			}
+			if err := validation.ValidateSecretURL(strings.TrimSpace(url)); err != nil {
+				return fmt.Errorf("failed to validate incident.io URL: %w", err)
+			}
+		case config.URL != nil:
+			if _, err := validation.ValidateURL(strings.TrimSpace(string(*config.URL))); err != nil {
+				return fmt.Errorf("failed to validate incident.io URL: %w", err)
+			}
+		default:
+			return errors.New("one of 'url' or 'urlSecret' must be configured")
+		}
+
+		if config.AlertSourceToken != nil {
+			if config.HTTPConfig != nil && config.HTTPConfig.Authorization != nil {
+				return errors.New("'alertSourceToken' and 'httpConfig.authorization' are mutually exclusive")
+			}
+
+			if _, err := store.GetSecretKey(ctx, namespace, *config.AlertSourceToken); err != nil {
+				return fmt.Errorf("failed to retrieve incident.io alert source token: %w", err)
+			}
+		}
+
+		if config.Timeout != nil {
+			if _, err := model.ParseDuration(string(*config.Timeout)); err != nil {
+				return fmt.Errorf("failed to parse incident.io timeout: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkMattermostConfigs doesn't fail when the Alertmanager version doesn't
+// support Mattermost: the configurations are dropped when the configuration
+// is sanitized.
+func checkMattermostConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.MattermostConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	for _, config := range configs {
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+
+		url, err := store.GetSecretKey(ctx, namespace, config.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve Mattermost webhook URL: %w", err)
+		}
+		if err := validation.ValidateSecretURL(strings.TrimSpace(url)); err != nil {
+			return fmt.Errorf("failed to validate Mattermost webhook URL: %w", err)
+		}
+
+		if config.IconURL != nil {
+			if _, err := validation.ValidateURL(strings.TrimSpace(string(*config.IconURL))); err != nil {
+				return fmt.Errorf("failed to validate Mattermost icon URL: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func checkSlackConfigs(
 	ctx context.Context,
 	configs []monitoringv1alpha1.SlackConfig,
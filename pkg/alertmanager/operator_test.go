@@ -1192,6 +1192,264 @@ func TestCheckAlertmanagerConfig(t *testing.T) {
 			},
 			ok: true,
 		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-valid",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								Project:   "MON",
+								IssueType: "Bug",
+								APIURL:    ptr.To(monitoringv1alpha1.URL("https://jira.example.com/rest/api/2/")),
+							},
+						},
+					}},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-with-invalid-api-url",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								Project:   "MON",
+								IssueType: "Bug",
+								APIURL:    ptr.To(monitoringv1alpha1.URL("://jira.example.com")),
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "jira-with-missing-basic-auth-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						JiraConfigs: []monitoringv1alpha1.JiraConfig{
+							{
+								Project:   "MON",
+								IssueType: "Bug",
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									BasicAuth: &monitoringv1.BasicAuth{
+										Username: v1.SecretKeySelector{
+											LocalObjectReference: v1.LocalObjectReference{Name: "not-existing"},
+											Key:                  "user",
+										},
+										Password: v1.SecretKeySelector{
+											LocalObjectReference: v1.LocalObjectReference{Name: "not-existing"},
+											Key:                  "password",
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-valid",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								URLSecret: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								AlertSourceToken: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-without-url",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								MaxAlerts: 10,
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-with-invalid-url-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								URLSecret: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "invalid-url",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "incidentio-with-alert-source-token-and-authorization",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						IncidentioConfigs: []monitoringv1alpha1.IncidentioConfig{
+							{
+								URL: ptr.To(monitoringv1alpha1.URL("https://api.incident.io/v2/alert_events/http/01234")),
+								AlertSourceToken: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								HTTPConfig: &monitoringv1alpha1.HTTPConfig{
+									Authorization: &monitoringv1.SafeAuthorization{
+										Credentials: &v1.SecretKeySelector{
+											LocalObjectReference: v1.LocalObjectReference{
+												Name: "secret",
+											},
+											Key: "key1",
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mattermost-valid",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "key1",
+								},
+								Channel: ptr.To("alerts"),
+							},
+						},
+					}},
+				},
+			},
+			ok: true,
+		},
+		{
+			amConfig: &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mattermost-with-missing-webhook-url-secret",
+					Namespace: "ns1",
+				},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Route: &monitoringv1alpha1.Route{
+						Receiver: "recv1",
+					},
+					Receivers: []monitoringv1alpha1.Receiver{{
+						Name: "recv1",
+						MattermostConfigs: []monitoringv1alpha1.MattermostConfig{
+							{
+								WebhookURL: v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "not-existing",
+									},
+									Key: "key1",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ok: false,
+		},
 	} {
 		t.Run(tc.amConfig.Name, func(t *testing.T) {
 			store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
@@ -1500,3 +1758,39 @@ func (*alwaysAllowed) Create(_ context.Context, _ *authv1.SelfSubjectAccessRevie
 		},
 	}, nil
 }
+
+func TestPriorityConflicts(t *testing.T) {
+	amc := func(ns, name string, priority *int32) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       monitoringv1alpha1.AlertmanagerConfigSpec{Priority: priority},
+		}
+	}
+
+	got := priorityConflicts(map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"a/amc":   amc("a", "amc", ptr.To(int32(10))),
+		"a/other": amc("a", "other", ptr.To(int32(10))),
+		"b/amc":   amc("b", "amc", ptr.To(int32(10))),
+		"c/amc":   amc("c", "amc", ptr.To(int32(5))),
+		// An unset priority is equivalent to 0 but the objects without
+		// priority aren't reported.
+		"d/amc": amc("d", "amc", ptr.To(int32(0))),
+		"e/amc": amc("e", "amc", nil),
+		"f/amc": amc("f", "amc", nil),
+	})
+
+	require.Equal(t, map[string]string{
+		"a/amc":   "priority 10 is also used by a/other and 1 object(s) in other namespaces, the routes are ordered by namespace and name",
+		"a/other": "priority 10 is also used by a/amc and 1 object(s) in other namespaces, the routes are ordered by namespace and name",
+		"b/amc":   "priority 10 is also used by 2 object(s) in other namespaces, the routes are ordered by namespace and name",
+		"d/amc":   "priority 0 is also used by 2 object(s) in other namespaces, the routes are ordered by namespace and name",
+	}, got)
+
+	// Objects without priority don't conflict with each other.
+	got = priorityConflicts(map[string]*monitoringv1alpha1.AlertmanagerConfig{
+		"a/amc": amc("a", "amc", nil),
+		"b/amc": amc("b", "amc", nil),
+		"c/amc": amc("c", "amc", ptr.To(int32(1))),
+	})
+	require.Empty(t, got)
+}
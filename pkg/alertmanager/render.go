@@ -0,0 +1,106 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// RenderConfiguration returns the Alertmanager configuration that the
+// controller would generate for the given Alertmanager object.
+//
+// AlertmanagerConfig and ClusterAlertmanagerConfig objects are listed from
+// mclient, Secrets and ConfigMaps are read from kclient and the namespaces
+// from nsInf. The objects are selected, validated and merged exactly like the
+// controller does it (including the namespace enforcement of routes and
+// inhibition rules) which means that the function works with in-memory
+// clients and doesn't require a Kubernetes cluster. It never creates nor
+// updates any object.
+func RenderConfiguration(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	mclient monitoringclient.Interface,
+	nsInf cache.SharedIndexInformer,
+	am *monitoringv1.Alertmanager,
+) ([]byte, error) {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	c := &Operator{
+		kclient:          kclient,
+		mclient:          mclient,
+		logger:           logger,
+		accessor:         operator.NewAccessor(logger),
+		nsAlrtCfgInf:     nsInf,
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		newEventRecorder: operator.NewNoopEventRecorder,
+	}
+
+	listFn := func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error {
+		// The selector is matched locally because labels.Nothing() has no
+		// string representation.
+		l, err := mclient.MonitoringV1alpha1().AlertmanagerConfigs(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		for i := range l.Items {
+			if selector.Matches(labels.Set(l.Items[i].Labels)) {
+				appendFn(&l.Items[i])
+			}
+		}
+
+		return nil
+	}
+
+	clusterListFn := func(selector labels.Selector, appendFn cache.AppendFunc) error {
+		l, err := mclient.MonitoringV1alpha1().ClusterAlertmanagerConfigs().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		for i := range l.Items {
+			if selector.Matches(labels.Set(l.Items[i].Labels)) {
+				appendFn(&l.Items[i])
+			}
+		}
+
+		return nil
+	}
+
+	conf, _, _, err := c.generateConfiguration(
+		ctx,
+		am,
+		assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()),
+		listFn,
+		clusterListFn,
+	)
+
+	return conf, err
+}
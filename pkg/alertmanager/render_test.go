@@ -0,0 +1,317 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestRenderConfiguration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test", Namespace: "monitoring"},
+			Data: map[string][]byte{
+				"alertmanager.yaml": []byte("route:\n  receiver: default\nreceivers:\n- name: default\n"),
+			},
+		},
+	)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced))
+
+	mclient := monitoringfake.NewSimpleClientset(
+		&monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "team-a"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "hook",
+				},
+				Receivers: []monitoringv1alpha1.Receiver{
+					{
+						Name: "hook",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{URL: ptr.To("http://example.com")},
+						},
+					},
+				},
+			},
+		},
+		&monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "team-a"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "missing",
+				},
+			},
+		},
+	)
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector:          &metav1.LabelSelector{},
+			AlertmanagerConfigNamespaceSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	b, err := RenderConfiguration(ctx, nil, kclient, mclient, nsInf, am)
+	require.NoError(t, err)
+
+	cfg := string(b)
+	require.Contains(t, cfg, "receiver: team-a/valid/hook")
+	require.Contains(t, cfg, `namespace="team-a"`)
+	require.NotContains(t, cfg, "team-a/invalid/missing")
+
+	// Without selector, the configuration from the secret is returned as-is.
+	am.Spec.AlertmanagerConfigSelector = nil
+	b, err = RenderConfiguration(ctx, nil, kclient, mclient, nsInf, am)
+	require.NoError(t, err)
+	require.Equal(t, "route:\n  receiver: default\nreceivers:\n- name: default\n", string(b))
+}
+
+func TestRenderConfigurationWithClusterAlertmanagerConfigs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test", Namespace: "monitoring"},
+			Data: map[string][]byte{
+				"alertmanager.yaml": []byte("route:\n  receiver: default\nreceivers:\n- name: default\n"),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pager", Namespace: "monitoring"},
+			Data: map[string][]byte{
+				"url": []byte("http://pager.example.com"),
+			},
+		},
+	)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced))
+
+	hook := func(name string) []monitoringv1alpha1.Receiver {
+		return []monitoringv1alpha1.Receiver{
+			{
+				Name: name,
+				WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+					{URL: ptr.To("http://example.com")},
+				},
+			},
+		}
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		&monitoringv1alpha1.ClusterAlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Labels: map[string]string{"scope": "platform"}},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "pager",
+					Matchers: []monitoringv1alpha1.Matcher{
+						{Name: "severity", Value: "critical", MatchType: monitoringv1alpha1.MatchEqual},
+					},
+				},
+				Receivers: []monitoringv1alpha1.Receiver{
+					{
+						Name: "pager",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{
+								// The secret is read from the Alertmanager's namespace.
+								URLSecret: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{Name: "pager"},
+									Key:                  "url",
+								},
+							},
+						},
+					},
+				},
+				InhibitRules: []monitoringv1alpha1.InhibitRule{
+					{
+						SourceMatch: []monitoringv1alpha1.Matcher{
+							{Name: "alertname", Value: "ClusterDown", MatchType: monitoringv1alpha1.MatchEqual},
+						},
+					},
+				},
+			},
+		},
+		&monitoringv1alpha1.ClusterAlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "conflict", Labels: map[string]string{"scope": "platform"}},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: "cluster-hook"},
+				Receivers: hook("cluster-hook"),
+			},
+		},
+		&monitoringv1alpha1.ClusterAlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "unselected"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: "unselected-hook"},
+				Receivers: hook("unselected-hook"),
+			},
+		},
+		&monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "conflict", Namespace: "monitoring"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: "hook"},
+				Receivers: hook("hook"),
+			},
+		},
+		&monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route:     &monitoringv1alpha1.Route{Receiver: "hook"},
+				Receivers: hook("hook"),
+			},
+		},
+	)
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector:          &metav1.LabelSelector{},
+			AlertmanagerConfigNamespaceSelector: &metav1.LabelSelector{},
+			ClusterAlertmanagerConfigSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"scope": "platform"},
+			},
+		},
+	}
+
+	b, err := RenderConfiguration(ctx, nil, kclient, mclient, nsInf, am)
+	require.NoError(t, err)
+
+	cfg, err := alertmanagerConfigFromBytes(b)
+	require.NoError(t, err)
+
+	// The routes of the ClusterAlertmanagerConfig objects come first and
+	// aren't restricted to a namespace.
+	require.Len(t, cfg.Route.Routes, 3)
+	require.Equal(t, "monitoring/platform/pager", cfg.Route.Routes[0].Receiver)
+	require.Equal(t, []string{`severity="critical"`}, cfg.Route.Routes[0].Matchers)
+	require.True(t, cfg.Route.Routes[0].Continue)
+	require.Equal(t, "monitoring/conflict/hook", cfg.Route.Routes[1].Receiver)
+	require.Equal(t, "team-a/app/hook", cfg.Route.Routes[2].Receiver)
+	require.Contains(t, cfg.Route.Routes[2].Matchers, `namespace="team-a"`)
+
+	require.Len(t, cfg.InhibitRules, 1)
+	require.Equal(t, []string{`alertname="ClusterDown"`}, cfg.InhibitRules[0].SourceMatchers)
+	require.Empty(t, cfg.InhibitRules[0].TargetMatchers)
+
+	s := string(b)
+	require.Contains(t, s, "http://pager.example.com")
+	require.NotContains(t, s, "cluster-hook")
+	require.NotContains(t, s, "unselected-hook")
+
+	// The ClusterAlertmanagerConfig selector alone is enough to generate the
+	// configuration.
+	am.Spec.AlertmanagerConfigSelector = nil
+	b, err = RenderConfiguration(ctx, nil, kclient, mclient, nsInf, am)
+	require.NoError(t, err)
+
+	cfg, err = alertmanagerConfigFromBytes(b)
+	require.NoError(t, err)
+	require.Len(t, cfg.Route.Routes, 2)
+	require.Equal(t, "monitoring/conflict/cluster-hook", cfg.Route.Routes[0].Receiver)
+	require.Equal(t, "monitoring/platform/pager", cfg.Route.Routes[1].Receiver)
+}
+
+func TestRenderConfigurationWithPriority(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test", Namespace: "monitoring"},
+			Data: map[string][]byte{
+				"alertmanager.yaml": []byte("route:\n  receiver: default\nreceivers:\n- name: default\n"),
+			},
+		},
+	)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced))
+
+	amc := func(name string, priority *int32) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring"},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Priority: priority,
+				Route:    &monitoringv1alpha1.Route{Receiver: "hook"},
+				Receivers: []monitoringv1alpha1.Receiver{
+					{
+						Name: "hook",
+						WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+							{URL: ptr.To("http://example.com")},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	mclient := monitoringfake.NewSimpleClientset(
+		amc("a", nil),
+		amc("b", ptr.To(int32(-1))),
+		amc("c", ptr.To(int32(10))),
+		amc("d", ptr.To(int32(0))),
+	)
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	b, err := RenderConfiguration(ctx, nil, kclient, mclient, nsInf, am)
+	require.NoError(t, err)
+
+	cfg, err := alertmanagerConfigFromBytes(b)
+	require.NoError(t, err)
+
+	var receivers []string
+	for _, r := range cfg.Route.Routes {
+		receivers = append(receivers, r.Receiver)
+	}
+	require.Equal(t, []string{
+		"monitoring/c/hook",
+		"monitoring/a/hook",
+		"monitoring/d/hook",
+		"monitoring/b/hook",
+	}, receivers)
+}
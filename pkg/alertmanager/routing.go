@@ -0,0 +1,291 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// groupByAll is the special value of group_by which aggregates alerts by all
+// labels.
+const groupByAll = "..."
+
+// RouteMatch is a route of the Alertmanager routing tree which matches a set
+// of labels.
+type RouteMatch struct {
+	// Receiver is the name of the receiver notified by the route.
+	Receiver string `json:"receiver"`
+	// GroupBy is the list of labels used to aggregate the alerts.
+	GroupBy []string `json:"groupBy,omitempty"`
+	// GroupKey is the key of the aggregation group which the alert belongs
+	// to. It is equal to the key used by Alertmanager.
+	GroupKey string `json:"groupKey"`
+	// RouteID identifies the route in the routing tree. It is equal to the
+	// identifier used by Alertmanager.
+	RouteID string `json:"routeID"`
+	// AlertmanagerConfig is the AlertmanagerConfig object (<namespace>/<name>)
+	// which defined the route. It is empty for the routes of the main
+	// configuration.
+	AlertmanagerConfig string `json:"alertmanagerConfig,omitempty"`
+	// ClusterAlertmanagerConfig is the name of the ClusterAlertmanagerConfig
+	// object which defined the route.
+	ClusterAlertmanagerConfig string `json:"clusterAlertmanagerConfig,omitempty"`
+}
+
+// RouteOwners lists the objects which may have contributed routes to the
+// Alertmanager configuration.
+type RouteOwners struct {
+	// Namespace is the namespace of the Alertmanager object.
+	Namespace string
+	// AlertmanagerConfigs is the list of AlertmanagerConfig objects.
+	AlertmanagerConfigs []types.NamespacedName
+	// ClusterAlertmanagerConfigs is the list of ClusterAlertmanagerConfig
+	// names.
+	ClusterAlertmanagerConfigs []string
+}
+
+// routingNode is a node of the routing tree with the options inherited from
+// its parents resolved.
+type routingNode struct {
+	parent *routingNode
+	index  int
+
+	receiver        string
+	groupBy         map[model.LabelName]struct{}
+	groupByAll      bool
+	matchers        amlabels.Matchers
+	cont            bool
+	amConfig        string
+	clusterAmConfig string
+
+	children []*routingNode
+}
+
+// MatchRoutes returns the routes of the Alertmanager configuration which
+// match the given labels, in the order in which Alertmanager would notify
+// them.
+//
+// The configuration is typically the output of RenderConfiguration: owners
+// lists the objects which may have contributed routes so that the matching
+// routes can be attributed to them. The algorithm is the same as
+// Alertmanager's dispatcher: a route matches if its matchers and the matchers
+// of its parents match, the evaluation stops at the first matching sibling
+// unless `continue` is true and a route matches only if none of its children
+// match.
+func MatchRoutes(config []byte, owners RouteOwners, lset map[string]string) ([]RouteMatch, error) {
+	cfg, err := alertmanagerConfigFromBytes(config)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := newRoutingNode(cfg.Route, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The operator prepends the top-level routes of the
+	// ClusterAlertmanagerConfig and AlertmanagerConfig objects to the routes
+	// of the main configuration. The receivers of an AlertmanagerConfig
+	// object are prefixed by "<namespace>/<name>/" and the receivers of a
+	// ClusterAlertmanagerConfig object by "<alertmanager namespace>/<name>/"
+	// (a ClusterAlertmanagerConfig object is rejected when an
+	// AlertmanagerConfig object with the same name exists in the
+	// Alertmanager's namespace).
+	for _, child := range root.children {
+		receiver := cfg.Route.Routes[child.index].Receiver
+
+		if i := slices.IndexFunc(owners.AlertmanagerConfigs, func(k types.NamespacedName) bool {
+			return strings.HasPrefix(receiver, k.String()+"/")
+		}); i >= 0 {
+			child.walk(func(n *routingNode) { n.amConfig = owners.AlertmanagerConfigs[i].String() })
+			continue
+		}
+
+		if i := slices.IndexFunc(owners.ClusterAlertmanagerConfigs, func(name string) bool {
+			return strings.HasPrefix(receiver, owners.Namespace+"/"+name+"/")
+		}); i >= 0 {
+			child.walk(func(n *routingNode) { n.clusterAmConfig = owners.ClusterAlertmanagerConfigs[i] })
+		}
+	}
+
+	ls := make(model.LabelSet, len(lset))
+	for k, v := range lset {
+		ls[model.LabelName(k)] = model.LabelValue(v)
+	}
+
+	var res []RouteMatch
+	for _, n := range root.match(ls) {
+		res = append(res, n.routeMatch(ls))
+	}
+
+	return res, nil
+}
+
+func newRoutingNode(r *route, parent *routingNode, index int) (*routingNode, error) {
+	if r == nil {
+		return nil, errors.New("missing route")
+	}
+
+	n := &routingNode{
+		parent:  parent,
+		index:   index,
+		groupBy: map[model.LabelName]struct{}{},
+		cont:    r.Continue,
+	}
+
+	if parent != nil {
+		n.receiver = parent.receiver
+		n.groupBy = parent.groupBy
+		n.groupByAll = parent.groupByAll
+	}
+
+	if r.Receiver != "" {
+		n.receiver = r.Receiver
+	}
+
+	if r.GroupByStr != nil {
+		n.groupBy = map[model.LabelName]struct{}{}
+		n.groupByAll = false
+		for _, ln := range r.GroupByStr {
+			if ln == groupByAll {
+				n.groupByAll = true
+				continue
+			}
+			n.groupBy[model.LabelName(ln)] = struct{}{}
+		}
+	}
+
+	for ln, lv := range r.Match {
+		m, err := amlabels.NewMatcher(amlabels.MatchEqual, ln, lv)
+		if err != nil {
+			return nil, err
+		}
+		n.matchers = append(n.matchers, m)
+	}
+
+	for ln, lv := range r.MatchRE {
+		// Alertmanager anchors the regular expressions of match_re.
+		m, err := amlabels.NewMatcher(amlabels.MatchRegexp, ln, "^(?:"+lv+")$")
+		if err != nil {
+			return nil, err
+		}
+		n.matchers = append(n.matchers, m)
+	}
+
+	for _, s := range r.Matchers {
+		m, err := amlabels.ParseMatcher(s)
+		if err != nil {
+			return nil, err
+		}
+		n.matchers = append(n.matchers, m)
+	}
+
+	sort.Sort(n.matchers)
+
+	for i, child := range r.Routes {
+		c, err := newRoutingNode(child, n, i)
+		if err != nil {
+			return nil, err
+		}
+		n.children = append(n.children, c)
+	}
+
+	return n, nil
+}
+
+func (n *routingNode) walk(fn func(*routingNode)) {
+	fn(n)
+	for _, c := range n.children {
+		c.walk(fn)
+	}
+}
+
+func (n *routingNode) match(lset model.LabelSet) []*routingNode {
+	if !n.matchers.Matches(lset) {
+		return nil
+	}
+
+	var all []*routingNode
+	for _, c := range n.children {
+		matches := c.match(lset)
+		all = append(all, matches...)
+
+		if matches != nil && !c.cont {
+			break
+		}
+	}
+
+	// If no child matches, the current node is a match.
+	if len(all) == 0 {
+		all = append(all, n)
+	}
+
+	return all
+}
+
+// key returns the same value as the Key() method of Alertmanager's routes.
+func (n *routingNode) key() string {
+	if n.parent == nil {
+		return n.matchers.String()
+	}
+
+	return n.parent.key() + "/" + n.matchers.String()
+}
+
+// id returns the same value as the ID() method of Alertmanager's routes.
+func (n *routingNode) id() string {
+	if n.parent == nil {
+		return n.matchers.String()
+	}
+
+	return n.parent.id() + "/" + n.matchers.String() + "/" + strconv.Itoa(n.index)
+}
+
+func (n *routingNode) routeMatch(lset model.LabelSet) RouteMatch {
+	groupLabels := model.LabelSet{}
+	for ln, lv := range lset {
+		if _, found := n.groupBy[ln]; found || n.groupByAll {
+			groupLabels[ln] = lv
+		}
+	}
+
+	var groupBy []string
+	if n.groupByAll {
+		groupBy = []string{groupByAll}
+	} else {
+		for ln := range n.groupBy {
+			groupBy = append(groupBy, string(ln))
+		}
+		sort.Strings(groupBy)
+	}
+
+	return RouteMatch{
+		Receiver:                  n.receiver,
+		GroupBy:                   groupBy,
+		GroupKey:                  fmt.Sprintf("%s:%s", n.key(), groupLabels),
+		RouteID:                   n.id(),
+		AlertmanagerConfig:        n.amConfig,
+		ClusterAlertmanagerConfig: n.clusterAmConfig,
+	}
+}
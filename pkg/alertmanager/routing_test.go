@@ -0,0 +1,240 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+)
+
+func TestMatchRoutes(t *testing.T) {
+	const config = `route:
+  receiver: default
+  group_by: [alertname]
+  routes:
+  - matchers: ['severity="critical"']
+    receiver: pager
+    group_by: ['...']
+  - match:
+      team: a
+    receiver: team-a
+    continue: true
+  - match_re:
+      team: a|b
+    receiver: team-ab
+    routes:
+    - matchers: ['severity!="info"']
+receivers:
+- name: default
+- name: pager
+- name: team-a
+- name: team-ab
+`
+
+	for _, tc := range []struct {
+		name string
+		lset map[string]string
+		exp  []RouteMatch
+	}{
+		{
+			name: "root route",
+			lset: map[string]string{"alertname": "Foo"},
+			exp: []RouteMatch{
+				{
+					Receiver: "default",
+					GroupBy:  []string{"alertname"},
+					GroupKey: `{}:{alertname="Foo"}`,
+					RouteID:  "{}",
+				},
+			},
+		},
+		{
+			name: "first matching route",
+			lset: map[string]string{"alertname": "Foo", "severity": "critical", "team": "a"},
+			exp: []RouteMatch{
+				{
+					Receiver: "pager",
+					GroupBy:  []string{"..."},
+					GroupKey: `{}/{severity="critical"}:{alertname="Foo", severity="critical", team="a"}`,
+					RouteID:  `{}/{severity="critical"}/0`,
+				},
+			},
+		},
+		{
+			name: "continue",
+			lset: map[string]string{"alertname": "Foo", "severity": "warning", "team": "a"},
+			exp: []RouteMatch{
+				{
+					Receiver: "team-a",
+					GroupBy:  []string{"alertname"},
+					GroupKey: `{}/{team="a"}:{alertname="Foo"}`,
+					RouteID:  `{}/{team="a"}/1`,
+				},
+				{
+					Receiver: "team-ab",
+					GroupBy:  []string{"alertname"},
+					GroupKey: `{}/{team=~"^(?:a|b)$"}/{severity!="info"}:{alertname="Foo"}`,
+					RouteID:  `{}/{team=~"^(?:a|b)$"}/2/{severity!="info"}/0`,
+				},
+			},
+		},
+		{
+			name: "no matching child route",
+			lset: map[string]string{"alertname": "Foo", "severity": "info", "team": "b"},
+			exp: []RouteMatch{
+				{
+					Receiver: "team-ab",
+					GroupBy:  []string{"alertname"},
+					GroupKey: `{}/{team=~"^(?:a|b)$"}:{alertname="Foo"}`,
+					RouteID:  `{}/{team=~"^(?:a|b)$"}/2`,
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := MatchRoutes([]byte(config), RouteOwners{}, tc.lset)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, got)
+		})
+	}
+
+	_, err := MatchRoutes([]byte("route: {}"), RouteOwners{}, nil)
+	require.Error(t, err)
+}
+
+func TestMatchRoutesWithAlertmanagerConfigs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test", Namespace: "monitoring"},
+			Data: map[string][]byte{
+				"alertmanager.yaml": []byte("route:\n  receiver: default\n  routes:\n  - matchers: ['severity=\"critical\"']\n    receiver: pager\nreceivers:\n- name: default\n- name: pager\n"),
+			},
+		},
+	)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced))
+
+	amConfig := &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "team-a"},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "hook",
+				GroupBy:  []string{"service"},
+				Matchers: []monitoringv1alpha1.Matcher{
+					{Name: "service", Value: "app", MatchType: monitoringv1alpha1.MatchEqual},
+				},
+			},
+			Receivers: []monitoringv1alpha1.Receiver{
+				{
+					Name: "hook",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{URL: ptr.To("http://example.com")},
+					},
+				},
+			},
+		},
+	}
+
+	clusterAmConfig := &monitoringv1alpha1.ClusterAlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "audit",
+				Matchers: []monitoringv1alpha1.Matcher{
+					{Name: "severity", Value: "critical", MatchType: monitoringv1alpha1.MatchEqual},
+				},
+			},
+			Receivers: []monitoringv1alpha1.Receiver{
+				{
+					Name: "audit",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{
+						{URL: ptr.To("http://example.com/audit")},
+					},
+				},
+			},
+		},
+	}
+
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigSelector:          &metav1.LabelSelector{},
+			AlertmanagerConfigNamespaceSelector: &metav1.LabelSelector{},
+			ClusterAlertmanagerConfigSelector:   &metav1.LabelSelector{},
+		},
+	}
+
+	b, err := RenderConfiguration(ctx, nil, kclient, monitoringfake.NewSimpleClientset(amConfig, clusterAmConfig), nsInf, am)
+	require.NoError(t, err)
+
+	owners := RouteOwners{
+		Namespace:                  "monitoring",
+		AlertmanagerConfigs:        []types.NamespacedName{{Namespace: "team-a", Name: "app"}},
+		ClusterAlertmanagerConfigs: []string{"platform"},
+	}
+
+	// The namespace matcher is enforced.
+	got, err := MatchRoutes(b, owners, map[string]string{"service": "app", "severity": "warning"})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "default", got[0].Receiver)
+	require.Empty(t, got[0].AlertmanagerConfig)
+	require.Empty(t, got[0].ClusterAlertmanagerConfig)
+
+	// The routes of the ClusterAlertmanagerConfig object come first and the
+	// top-level routes continue to the next routes.
+	got, err = MatchRoutes(b, owners, map[string]string{"namespace": "team-a", "service": "app", "severity": "critical"})
+	require.NoError(t, err)
+	require.Equal(t, []RouteMatch{
+		{
+			Receiver:                  "monitoring/platform/audit",
+			GroupKey:                  `{}/{severity="critical"}:{}`,
+			RouteID:                   `{}/{severity="critical"}/0`,
+			ClusterAlertmanagerConfig: "platform",
+		},
+		{
+			Receiver:           "team-a/app/hook",
+			GroupBy:            []string{"service"},
+			GroupKey:           `{}/{namespace="team-a",service="app"}:{service="app"}`,
+			RouteID:            `{}/{namespace="team-a",service="app"}/1`,
+			AlertmanagerConfig: "team-a/app",
+		},
+		{
+			Receiver: "pager",
+			GroupKey: `{}/{severity="critical"}:{}`,
+			RouteID:  `{}/{severity="critical"}/2`,
+		},
+	}, got)
+}
@@ -0,0 +1,712 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	rtclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	amclient "github.com/prometheus/alertmanager/api/v2/client"
+	"github.com/prometheus/alertmanager/api/v2/client/silence"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// silenceCreatedByPrefix is the prefix of the `createdBy` field for the
+	// silences managed by the operator. The field identifies the
+	// AlertmanagerSilence object from which the silence is created.
+	silenceCreatedByPrefix = "prometheus-operator/"
+
+	silenceAPITimeout = 10 * time.Second
+)
+
+// silenceCreatedBy returns the value of the `createdBy` field for the
+// silence created from the AlertmanagerSilence object identified by key
+// (`<namespace>/<name>`).
+func silenceCreatedBy(key string) string {
+	return silenceCreatedByPrefix + key
+}
+
+// makeSilence converts an AlertmanagerSilence object into a silence of the
+// Alertmanager API.
+//
+// The matchers are processed by the enforcer like the routes of the
+// AlertmanagerConfig objects.
+func makeSilence(s *monitoringv1alpha1.AlertmanagerSilence, amVersion semver.Version, e enforcer, now time.Time) (*models.Silence, error) {
+	if err := s.Spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	cb := &ConfigBuilder{amVersion: amVersion}
+	matchers, match, matchRE := cb.convertMatchersV2(s.Spec.Matchers)
+	r := e.processRoute(
+		types.NamespacedName{Namespace: s.Namespace, Name: s.Name},
+		&route{
+			Matchers: matchers,
+			Match:    match,
+			MatchRE:  matchRE,
+		},
+	)
+
+	ms := make(models.Matchers, 0, len(r.Matchers)+len(r.Match)+len(r.MatchRE))
+	for _, m := range r.Matchers {
+		lm, err := amlabels.ParseMatcher(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %q: %w", m, err)
+		}
+
+		ms = append(ms, newSilenceMatcher(
+			lm.Name,
+			lm.Value,
+			lm.Type == amlabels.MatchRegexp || lm.Type == amlabels.MatchNotRegexp,
+			lm.Type == amlabels.MatchEqual || lm.Type == amlabels.MatchRegexp,
+		))
+	}
+
+	for _, k := range sortutil.SortedKeys(r.Match) {
+		ms = append(ms, newSilenceMatcher(k, r.Match[k], false, true))
+	}
+
+	for _, k := range sortutil.SortedKeys(r.MatchRE) {
+		ms = append(ms, newSilenceMatcher(k, r.MatchRE[k], true, true))
+	}
+
+	if amVersion.LT(semver.MustParse("0.22.0")) {
+		for _, m := range ms {
+			if !*m.IsEqual {
+				return nil, fmt.Errorf("negative matcher %q requires Alertmanager >= 0.22.0", *m.Name)
+			}
+		}
+	}
+
+	sortSilenceMatchers(ms)
+
+	startsAt := now
+	if s.Spec.StartsAt != nil && s.Spec.StartsAt.After(now) {
+		startsAt = s.Spec.StartsAt.Time
+	}
+	endsAt := s.Spec.EndsAt.Time
+
+	return &models.Silence{
+		Comment:   &s.Spec.Comment,
+		CreatedBy: ptr.To(silenceCreatedBy(s.Namespace + "/" + s.Name)),
+		Matchers:  ms,
+		StartsAt:  ptr.To(strfmt.DateTime(startsAt)),
+		EndsAt:    ptr.To(strfmt.DateTime(endsAt)),
+	}, nil
+}
+
+func newSilenceMatcher(name, value string, isRegex, isEqual bool) *models.Matcher {
+	return &models.Matcher{
+		Name:    &name,
+		Value:   &value,
+		IsRegex: &isRegex,
+		IsEqual: &isEqual,
+	}
+}
+
+func sortSilenceMatchers(ms models.Matchers) {
+	slices.SortFunc(ms, func(a, b *models.Matcher) int {
+		return cmp.Or(
+			cmp.Compare(*a.Name, *b.Name),
+			cmp.Compare(*a.Value, *b.Value),
+			cmp.Compare(strconv.FormatBool(*a.IsRegex), strconv.FormatBool(*b.IsRegex)),
+			cmp.Compare(strconv.FormatBool(isEqualMatcher(a)), strconv.FormatBool(isEqualMatcher(b))),
+		)
+	})
+}
+
+// isEqualMatcher returns true if the matcher is a positive matcher.
+// Alertmanager < 0.22.0 doesn't return the isEqual field.
+func isEqualMatcher(m *models.Matcher) bool {
+	return m.IsEqual == nil || *m.IsEqual
+}
+
+// silenceUpToDate returns true if the silence of the Alertmanager instance
+// matches the desired silence.
+func silenceUpToDate(got *models.GettableSilence, want *models.Silence, now time.Time) bool {
+	if got.Comment == nil || *got.Comment != *want.Comment {
+		return false
+	}
+
+	if got.EndsAt == nil || !equalSilenceTime(time.Time(*got.EndsAt), time.Time(*want.EndsAt)) {
+		return false
+	}
+
+	if got.StartsAt == nil {
+		return false
+	}
+
+	// Alertmanager sets the start time to the creation time when it is in the
+	// past.
+	if wantStartsAt := time.Time(*want.StartsAt); wantStartsAt.After(now) {
+		if !equalSilenceTime(time.Time(*got.StartsAt), wantStartsAt) {
+			return false
+		}
+	} else if time.Time(*got.StartsAt).After(now) {
+		return false
+	}
+
+	if len(got.Matchers) != len(want.Matchers) {
+		return false
+	}
+
+	gotMatchers := slices.Clone(got.Matchers)
+	sortSilenceMatchers(gotMatchers)
+
+	return slices.EqualFunc(gotMatchers, want.Matchers, func(a, b *models.Matcher) bool {
+		return *a.Name == *b.Name &&
+			*a.Value == *b.Value &&
+			*a.IsRegex == *b.IsRegex &&
+			isEqualMatcher(a) == isEqualMatcher(b)
+	})
+}
+
+// equalSilenceTime compares timestamps with the precision of the Kubernetes
+// API.
+func equalSilenceTime(a, b time.Time) bool {
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+}
+
+func silenceState(s *models.GettableSilence) string {
+	if s.Status == nil || s.Status.State == nil {
+		return ""
+	}
+
+	return *s.Status.State
+}
+
+// syncInstanceSilences synchronizes the silences managed by the operator in
+// an Alertmanager instance with the desired silences (indexed by their
+// `createdBy` field).
+//
+// The silences of the instance which have no desired counterpart are
+// expired. It returns the silences of the instance indexed by their
+// `createdBy` field.
+func syncInstanceSilences(ctx context.Context, sc silence.ClientService, desired map[string]*models.Silence, now time.Time) (map[string]*models.GettableSilence, error) {
+	resp, err := sc.GetSilences(silence.NewGetSilencesParamsWithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silences: %w", err)
+	}
+
+	existing := map[string][]*models.GettableSilence{}
+	for _, s := range resp.Payload {
+		if s.ID == nil || s.CreatedBy == nil || !strings.HasPrefix(*s.CreatedBy, silenceCreatedByPrefix) {
+			continue
+		}
+
+		if silenceState(s) == models.SilenceStatusStateExpired {
+			continue
+		}
+
+		existing[*s.CreatedBy] = append(existing[*s.CreatedBy], s)
+	}
+
+	var errs []error
+	expire := func(silences ...*models.GettableSilence) {
+		for _, s := range silences {
+			if _, err := sc.DeleteSilence(silence.NewDeleteSilenceParamsWithContext(ctx).WithSilenceID(strfmt.UUID(*s.ID))); err != nil {
+				errs = append(errs, fmt.Errorf("failed to expire silence %s: %w", *s.ID, err))
+			}
+		}
+	}
+
+	res := make(map[string]*models.GettableSilence, len(desired))
+	for _, createdBy := range sortutil.SortedKeys(desired) {
+		want := desired[createdBy]
+
+		// Several silences may exist for the same object when they have been
+		// created concurrently on different instances of the cluster.
+		// Sorting them ensures that all instances keep the same silence.
+		got := existing[createdBy]
+		delete(existing, createdBy)
+		slices.SortFunc(got, func(a, b *models.GettableSilence) int {
+			return cmp.Compare(*a.ID, *b.ID)
+		})
+
+		if !time.Time(*want.EndsAt).After(now) {
+			expire(got...)
+			continue
+		}
+
+		if i := slices.IndexFunc(got, func(s *models.GettableSilence) bool {
+			return silenceUpToDate(s, want, now)
+		}); i >= 0 {
+			res[createdBy] = got[i]
+			expire(slices.Delete(got, i, i+1)...)
+			continue
+		}
+
+		// Update the existing silence if any. Alertmanager either modifies
+		// the silence in-place or expires it and creates a new one.
+		ps := &models.PostableSilence{Silence: *want}
+		if len(got) > 0 {
+			ps.ID = *got[0].ID
+			expire(got[1:]...)
+		}
+
+		ok, err := sc.PostSilences(silence.NewPostSilencesParamsWithContext(ctx).WithSilence(ps))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create silence for %q: %w", createdBy, err))
+			continue
+		}
+
+		state := models.SilenceStatusStateActive
+		if time.Time(*want.StartsAt).After(now) {
+			state = models.SilenceStatusStatePending
+		}
+
+		res[createdBy] = &models.GettableSilence{
+			ID:      &ok.Payload.SilenceID,
+			Status:  &models.SilenceStatus{State: &state},
+			Silence: *want,
+		}
+	}
+
+	// Expire the silences of AlertmanagerSilence objects which have been
+	// deleted or aren't selected anymore.
+	for _, createdBy := range sortutil.SortedKeys(existing) {
+		expire(existing[createdBy]...)
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// newSilenceClient returns a client for the silences API of the Alertmanager
+// pod.
+func newSilenceClient(am *monitoringv1.Alertmanager, host string, hc *http.Client) silence.ClientService {
+	basePath := "/api/v2"
+	if am.Spec.RoutePrefix != "" {
+		basePath = path.Join("/", am.Spec.RoutePrefix, basePath)
+	}
+
+	return amclient.New(
+		rtclient.NewWithClient(host, basePath, []string{"http"}, hc),
+		strfmt.Default,
+	).Silence
+}
+
+// checkSilenceAPI returns an error if the operator can't reach the
+// Alertmanager API of the pods.
+func checkSilenceAPI(am *monitoringv1.Alertmanager) error {
+	if am.Spec.ListenLocal {
+		return errors.New("the Alertmanager API isn't reachable when listenLocal is true")
+	}
+
+	if am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil {
+		return errors.New("the Alertmanager API isn't reachable when web TLS is enabled")
+	}
+
+	return nil
+}
+
+// selectAlertmanagerSilences returns the AlertmanagerSilence objects
+// selected by the Alertmanager. The keys of the returned map identify the
+// objects using the `<namespace>/<name>` format.
+func (c *Operator) selectAlertmanagerSilences(am *monitoringv1.Alertmanager) (map[string]*monitoringv1alpha1.AlertmanagerSilence, error) {
+	res := map[string]*monitoringv1alpha1.AlertmanagerSilence{}
+	if am.Spec.AlertmanagerSilenceSelector == nil {
+		return res, nil
+	}
+
+	namespaces := []string{}
+
+	// If 'AlertmanagerSilenceNamespaceSelector' is nil, only check own namespace.
+	if am.Spec.AlertmanagerSilenceNamespaceSelector == nil {
+		namespaces = append(namespaces, am.Namespace)
+	} else {
+		nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerSilenceNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		err = cache.ListAll(c.nsAlrtCfgInf.GetStore(), nsSelector, func(obj any) {
+			namespaces = append(namespaces, obj.(*v1.Namespace).Name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.AlertmanagerSilenceSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ns := range namespaces {
+		err := c.silenceInfs.ListAllByNamespace(ns, selector, func(obj any) {
+			k, ok := c.accessor.MetaNamespaceKey(obj)
+			if !ok {
+				return
+			}
+
+			res[k] = obj.(*monitoringv1alpha1.AlertmanagerSilence)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alertmanager silences in namespace %s: %w", ns, err)
+		}
+	}
+
+	return res, nil
+}
+
+// syncSilences synchronizes the silences of the Alertmanager pods with the
+// selected AlertmanagerSilence objects and updates the status of the
+// objects.
+func (c *Operator) syncSilences(ctx context.Context, key string) error {
+	if c.silenceInfs == nil {
+		return nil
+	}
+
+	am, err := operator.GetObjectFromKey[*monitoringv1.Alertmanager](c.alrtInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if am == nil {
+		c.scheduleSilencesSync(key, nil, time.Time{})
+
+		ns, name, _ := strings.Cut(key, "/")
+		return c.removeSilenceBindings(ctx, &monitoringv1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name}}, nil)
+	}
+
+	if c.rr.DeletionInProgress(am) || am.Spec.Paused {
+		return nil
+	}
+
+	selected, err := c.selectAlertmanagerSilences(am)
+	if err != nil {
+		return err
+	}
+
+	// Skip the API calls when the Alertmanager has never managed silences.
+	if len(selected) == 0 && am.Spec.AlertmanagerSilenceSelector == nil && !c.hasSilenceBindings(am) {
+		return nil
+	}
+
+	amVersion, err := semver.ParseTolerant(operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return fmt.Errorf("failed to parse alertmanager version: %w", err)
+	}
+
+	var (
+		now      = time.Now()
+		e        = getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace)
+		desired  = make(map[string]*models.Silence, len(selected))
+		rejected = map[string]error{}
+	)
+	for k, s := range selected {
+		ms, err := makeSilence(s, amVersion, e, now)
+		if err != nil {
+			c.logger.Warn(
+				"skipping alertmanagersilence",
+				"error", err.Error(),
+				"alertmanagersilence", k,
+				"namespace", am.Namespace,
+				"alertmanager", am.Name,
+			)
+			rejected[k] = err
+			continue
+		}
+
+		desired[*ms.CreatedBy] = ms
+	}
+
+	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerSilenceKind, len(desired))
+		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerSilenceKind, len(rejected))
+	}
+
+	var (
+		instances map[string]map[string]*models.GettableSilence
+		syncErr   = checkSilenceAPI(am)
+	)
+	if syncErr == nil {
+		instances, syncErr = c.syncPodsSilences(ctx, am, desired, now)
+	} else {
+		for k := range desired {
+			rejected[strings.TrimPrefix(k, silenceCreatedByPrefix)] = syncErr
+		}
+	}
+
+	var next time.Time
+	for k, s := range selected {
+		binding := makeSilenceBinding(am, s, rejected[k], instances, now)
+		if err := c.updateSilenceBinding(ctx, am, s, &binding); err != nil {
+			return fmt.Errorf("failed to update AlertmanagerSilence %s status: %w", k, err)
+		}
+
+		if rejected[k] != nil {
+			continue
+		}
+
+		// Track the next transition (pending -> active -> expired) to refresh
+		// the status.
+		for _, t := range []*metav1.Time{s.Spec.StartsAt, &s.Spec.EndsAt} {
+			if t != nil && t.After(now) && (next.IsZero() || t.Time.Before(next)) {
+				next = t.Time
+			}
+		}
+	}
+	c.scheduleSilencesSync(key, am, next)
+
+	if err := c.removeSilenceBindings(ctx, am, selected); err != nil {
+		return err
+	}
+
+	return syncErr
+}
+
+// syncPodsSilences synchronizes the silences of all the ready Alertmanager
+// pods. It returns the silences indexed by pod name and `createdBy` field.
+func (c *Operator) syncPodsSilences(ctx context.Context, am *monitoringv1.Alertmanager, desired map[string]*models.Silence, now time.Time) (map[string]map[string]*models.GettableSilence, error) {
+	pods, err := c.kclient.CoreV1().Pods(am.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(makeSelectorLabels(am.Name)).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var (
+		errs []error
+		res  = make(map[string]map[string]*models.GettableSilence, len(pods.Items))
+	)
+	for _, pod := range pods.Items {
+		if ready, _ := k8sutil.PodRunningAndReady(pod); !ready || pod.Status.PodIP == "" {
+			c.logger.Debug("skipping silences synchronization for pod not ready", "pod", pod.Name, "namespace", pod.Namespace)
+			continue
+		}
+
+		sc := newSilenceClient(am, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(alertmanagerWebPort)), c.silenceHTTPClient)
+		silences, err := syncInstanceSilences(ctx, sc, desired, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+		}
+
+		res[pod.Name] = silences
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// makeSilenceBinding returns the status of the AlertmanagerSilence object
+// for the Alertmanager.
+func makeSilenceBinding(am *monitoringv1.Alertmanager, s *monitoringv1alpha1.AlertmanagerSilence, err error, instances map[string]map[string]*models.GettableSilence, now time.Time) monitoringv1alpha1.AlertmanagerSilenceBinding {
+	binding := monitoringv1alpha1.AlertmanagerSilenceBinding{
+		Namespace: am.Namespace,
+		Name:      am.Name,
+		Conditions: []monitoringv1.ConfigResourceCondition{
+			{
+				Type:               monitoringv1.Accepted,
+				Status:             monitoringv1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(now),
+				ObservedGeneration: s.Generation,
+			},
+		},
+	}
+
+	if err != nil {
+		binding.Conditions[0].Status = monitoringv1.ConditionFalse
+		binding.Conditions[0].Reason = operator.InvalidConfiguration
+		binding.Conditions[0].Message = err.Error()
+		return binding
+	}
+
+	switch {
+	case !s.Spec.EndsAt.After(now):
+		binding.State = monitoringv1alpha1.ExpiredSilenceState
+	case s.Spec.StartsAt != nil && s.Spec.StartsAt.After(now):
+		binding.State = monitoringv1alpha1.PendingSilenceState
+	default:
+		binding.State = monitoringv1alpha1.ActiveSilenceState
+	}
+
+	binding.ExpiresAt = &metav1.Time{Time: s.Spec.EndsAt.Truncate(time.Second)}
+
+	createdBy := silenceCreatedBy(s.Namespace + "/" + s.Name)
+	for _, pod := range sortutil.SortedKeys(instances) {
+		gs, found := instances[pod][createdBy]
+		if !found {
+			continue
+		}
+
+		binding.Instances = append(binding.Instances, monitoringv1alpha1.AlertmanagerSilenceInstance{
+			Pod: pod,
+			ID:  *gs.ID,
+		})
+
+		if gs.EndsAt != nil {
+			binding.ExpiresAt = &metav1.Time{Time: time.Time(*gs.EndsAt).Truncate(time.Second)}
+		}
+	}
+
+	return binding
+}
+
+// setSilenceBinding returns the bindings with the binding of the Alertmanager
+// replaced by the given value. A nil value removes the binding.
+func setSilenceBinding(bindings []monitoringv1alpha1.AlertmanagerSilenceBinding, am *monitoringv1.Alertmanager, binding *monitoringv1alpha1.AlertmanagerSilenceBinding) []monitoringv1alpha1.AlertmanagerSilenceBinding {
+	i := slices.IndexFunc(bindings, func(b monitoringv1alpha1.AlertmanagerSilenceBinding) bool {
+		return b.Namespace == am.Namespace && b.Name == am.Name
+	})
+
+	bindings = slices.Clone(bindings)
+	switch {
+	case binding == nil && i < 0:
+	case binding == nil:
+		bindings = slices.Delete(bindings, i, i+1)
+	case i < 0:
+		bindings = append(bindings, *binding)
+	default:
+		b := *binding
+		b.Conditions = slices.Clone(b.Conditions)
+
+		// Keep the transition time when the condition's status hasn't changed.
+		for j, cond := range b.Conditions {
+			for _, prev := range bindings[i].Conditions {
+				if prev.Type == cond.Type && prev.Status == cond.Status {
+					b.Conditions[j].LastTransitionTime = prev.LastTransitionTime
+				}
+			}
+		}
+		bindings[i] = b
+	}
+
+	return bindings
+}
+
+// updateSilenceBinding updates the Alertmanager's binding in the status of
+// the AlertmanagerSilence object. A nil binding removes the Alertmanager's
+// binding.
+func (c *Operator) updateSilenceBinding(ctx context.Context, am *monitoringv1.Alertmanager, s *monitoringv1alpha1.AlertmanagerSilence, binding *monitoringv1alpha1.AlertmanagerSilenceBinding) error {
+	if equality.Semantic.DeepEqual(s.Status.Bindings, setSilenceBinding(s.Status.Bindings, am, binding)) {
+		return nil
+	}
+
+	client := c.mclient.MonitoringV1alpha1().AlertmanagerSilences(s.Namespace)
+
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cur, err := client.Get(ctx, s.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		bindings := setSilenceBinding(cur.Status.Bindings, am, binding)
+		if equality.Semantic.DeepEqual(cur.Status.Bindings, bindings) {
+			return nil
+		}
+
+		cur.Status.Bindings = bindings
+		_, err = client.UpdateStatus(ctx, cur, metav1.UpdateOptions{
+			FieldManager:    operator.PrometheusOperatorFieldManager,
+			FieldValidation: metav1.FieldValidationStrict,
+		})
+		return err
+	})
+}
+
+// hasSilenceBindings returns true if at least one AlertmanagerSilence object
+// has a binding for the Alertmanager.
+func (c *Operator) hasSilenceBindings(am *monitoringv1.Alertmanager) bool {
+	var found bool
+	_ = c.silenceInfs.ListAll(labels.Everything(), func(obj any) {
+		s := obj.(*monitoringv1alpha1.AlertmanagerSilence)
+		if slices.IndexFunc(s.Status.Bindings, func(b monitoringv1alpha1.AlertmanagerSilenceBinding) bool {
+			return b.Namespace == am.Namespace && b.Name == am.Name
+		}) >= 0 {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// removeSilenceBindings removes the Alertmanager's binding from the
+// AlertmanagerSilence objects which aren't selected.
+func (c *Operator) removeSilenceBindings(ctx context.Context, am *monitoringv1.Alertmanager, selected map[string]*monitoringv1alpha1.AlertmanagerSilence) error {
+	var errs []error
+	err := c.silenceInfs.ListAll(labels.Everything(), func(obj any) {
+		k, ok := c.accessor.MetaNamespaceKey(obj)
+		if !ok {
+			return
+		}
+
+		if _, found := selected[k]; found {
+			return
+		}
+
+		if err := c.updateSilenceBinding(ctx, am, obj.(*monitoringv1alpha1.AlertmanagerSilence), nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove binding from AlertmanagerSilence %s status: %w", k, err))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("listing all items from cache failed: %w", err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// scheduleSilencesSync enqueues the Alertmanager for reconciliation at the
+// given time to refresh the state of the silences. A zero time cancels the
+// scheduled reconciliation.
+func (c *Operator) scheduleSilencesSync(key string, am *monitoringv1.Alertmanager, at time.Time) {
+	c.silenceTimersMtx.Lock()
+	defer c.silenceTimersMtx.Unlock()
+
+	if t, found := c.silenceTimers[key]; found {
+		t.Stop()
+		delete(c.silenceTimers, key)
+	}
+
+	if at.IsZero() {
+		return
+	}
+
+	c.silenceTimers[key] = time.AfterFunc(time.Until(at)+time.Second, func() {
+		c.rr.EnqueueForReconciliation(am)
+	})
+}
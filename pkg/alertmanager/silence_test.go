@@ -0,0 +1,456 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// fakeAlertmanager implements the silences endpoints of the Alertmanager API.
+type fakeAlertmanager struct {
+	mtx      sync.Mutex
+	now      time.Time
+	silences map[string]*models.GettableSilence
+	lastID   int
+	posts    int
+	deletes  int
+}
+
+func newFakeAlertmanager(now time.Time, silences ...*models.GettableSilence) *fakeAlertmanager {
+	fa := &fakeAlertmanager{
+		now:      now,
+		silences: map[string]*models.GettableSilence{},
+	}
+
+	for _, s := range silences {
+		fa.silences[*s.ID] = s
+	}
+
+	return fa
+}
+
+func (fa *fakeAlertmanager) newID() string {
+	fa.lastID++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", fa.lastID)
+}
+
+func (fa *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fa.mtx.Lock()
+	defer fa.mtx.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		silences := make(models.GettableSilences, 0, len(fa.silences))
+		for _, s := range fa.silences {
+			silences = append(silences, s)
+		}
+		_ = json.NewEncoder(w).Encode(silences)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		var ps models.PostableSilence
+		if err := json.NewDecoder(r.Body).Decode(&ps); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fa.posts++
+
+		id := ps.ID
+		if id == "" {
+			id = fa.newID()
+		} else if _, found := fa.silences[id]; !found {
+			http.Error(w, "silence not found", http.StatusNotFound)
+			return
+		}
+
+		state := models.SilenceStatusStateActive
+		if time.Time(*ps.StartsAt).After(fa.now) {
+			state = models.SilenceStatusStatePending
+		}
+		fa.silences[id] = &models.GettableSilence{
+			ID:      &id,
+			Status:  &models.SilenceStatus{State: &state},
+			Silence: ps.Silence,
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"silenceID": id})
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		s, found := fa.silences[strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")]
+		if !found {
+			http.Error(w, "silence not found", http.StatusNotFound)
+			return
+		}
+		fa.deletes++
+
+		s.Status.State = ptr.To(models.SilenceStatusStateExpired)
+		s.EndsAt = ptr.To(strfmt.DateTime(fa.now))
+
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func (fa *fakeAlertmanager) active() map[string]*models.GettableSilence {
+	fa.mtx.Lock()
+	defer fa.mtx.Unlock()
+
+	res := map[string]*models.GettableSilence{}
+	for id, s := range fa.silences {
+		if *s.Status.State != models.SilenceStatusStateExpired {
+			res[id] = s
+		}
+	}
+
+	return res
+}
+
+func newTestAlertmanagerSilence(ns, name string, endsAt time.Time, matchers ...monitoringv1alpha1.Matcher) *monitoringv1alpha1.AlertmanagerSilence {
+	return &monitoringv1alpha1.AlertmanagerSilence{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  ns,
+			Name:       name,
+			Generation: 1,
+		},
+		Spec: monitoringv1alpha1.AlertmanagerSilenceSpec{
+			Matchers: matchers,
+			EndsAt:   metav1.NewTime(endsAt),
+			Comment:  "maintenance",
+		},
+	}
+}
+
+func newGettableSilence(id, createdBy, state string, startsAt, endsAt time.Time, ms ...*models.Matcher) *models.GettableSilence {
+	return &models.GettableSilence{
+		ID:     &id,
+		Status: &models.SilenceStatus{State: &state},
+		Silence: models.Silence{
+			Comment:   ptr.To("maintenance"),
+			CreatedBy: &createdBy,
+			Matchers:  ms,
+			StartsAt:  ptr.To(strfmt.DateTime(startsAt)),
+			EndsAt:    ptr.To(strfmt.DateTime(endsAt)),
+		},
+	}
+}
+
+func matchersToStrings(ms models.Matchers) []string {
+	var res []string
+	for _, m := range ms {
+		op := "="
+		switch {
+		case *m.IsRegex && isEqualMatcher(m):
+			op = "=~"
+		case *m.IsRegex:
+			op = "!~"
+		case !isEqualMatcher(m):
+			op = "!="
+		}
+		res = append(res, fmt.Sprintf("%s%s%q", *m.Name, op, *m.Value))
+	}
+
+	return res
+}
+
+func TestMakeSilence(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name      string
+		strategy  monitoringv1.AlertmanagerConfigMatcherStrategyType
+		version   string
+		namespace string
+		matchers  []monitoringv1alpha1.Matcher
+		startsAt  *metav1.Time
+		endsAt    time.Time
+
+		exp         []string
+		expStartsAt time.Time
+		err         bool
+	}{
+		{
+			name:      "namespace enforced",
+			version:   "0.29.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual},
+				{Name: "severity", Value: "info|warning", MatchType: monitoringv1alpha1.MatchRegexp},
+				{Name: "job", Value: "node", MatchType: monitoringv1alpha1.MatchNotEqual},
+			},
+			endsAt:      now.Add(time.Hour),
+			exp:         []string{`alertname="Watchdog"`, `job!="node"`, `namespace="team-a"`, `severity=~"info|warning"`},
+			expStartsAt: now,
+		},
+		{
+			name:      "no enforcement",
+			strategy:  monitoringv1.NoneConfigMatcherStrategyType,
+			version:   "0.29.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual},
+			},
+			startsAt:    ptr.To(metav1.NewTime(now.Add(time.Minute))),
+			endsAt:      now.Add(time.Hour),
+			exp:         []string{`alertname="Watchdog"`},
+			expStartsAt: now.Add(time.Minute),
+		},
+		{
+			name:      "no enforcement in the Alertmanager namespace",
+			strategy:  monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType,
+			version:   "0.29.0",
+			namespace: "monitoring",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual},
+			},
+			endsAt:      now.Add(time.Hour),
+			exp:         []string{`alertname="Watchdog"`},
+			expStartsAt: now,
+		},
+		{
+			name:      "deprecated regex field",
+			version:   "0.21.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watch.*", Regex: true},
+				{Name: "job", Value: "node"},
+			},
+			endsAt:      now.Add(time.Hour),
+			exp:         []string{`alertname=~"Watch.*"`, `job="node"`, `namespace="team-a"`},
+			expStartsAt: now,
+		},
+		{
+			name:      "negative matcher with old Alertmanager",
+			version:   "0.21.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "job", Value: "node", MatchType: monitoringv1alpha1.MatchNotEqual},
+			},
+			endsAt: now.Add(time.Hour),
+			err:    true,
+		},
+		{
+			name:      "startsAt after endsAt",
+			version:   "0.29.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual},
+			},
+			startsAt: ptr.To(metav1.NewTime(now.Add(2 * time.Hour))),
+			endsAt:   now.Add(time.Hour),
+			err:      true,
+		},
+		{
+			name:      "invalid match type",
+			version:   "0.29.0",
+			namespace: "team-a",
+			matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: "=="},
+			},
+			endsAt: now.Add(time.Hour),
+			err:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amVersion := semver.MustParse(tc.version)
+			e := getEnforcer(monitoringv1.AlertmanagerConfigMatcherStrategy{Type: tc.strategy}, amVersion, "monitoring")
+
+			s := newTestAlertmanagerSilence(tc.namespace, "silence", tc.endsAt, tc.matchers...)
+			s.Spec.StartsAt = tc.startsAt
+
+			got, err := makeSilence(s, amVersion, e, now)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, matchersToStrings(got.Matchers))
+			require.Equal(t, "prometheus-operator/"+tc.namespace+"/silence", *got.CreatedBy)
+			require.Equal(t, "maintenance", *got.Comment)
+			require.True(t, time.Time(*got.StartsAt).Equal(tc.expStartsAt))
+			require.True(t, time.Time(*got.EndsAt).Equal(tc.endsAt))
+		})
+	}
+}
+
+func TestSyncInstanceSilences(t *testing.T) {
+	var (
+		now    = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		endsAt = now.Add(time.Hour)
+		e      = getEnforcer(monitoringv1.AlertmanagerConfigMatcherStrategy{}, semver.MustParse("0.29.0"), "monitoring")
+		am     = &monitoringv1.Alertmanager{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: "main"},
+			Spec:       monitoringv1.AlertmanagerSpec{RoutePrefix: "/alertmanager"},
+		}
+		alertname = monitoringv1alpha1.Matcher{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual}
+	)
+
+	desired := map[string]*models.Silence{}
+	for _, s := range []*monitoringv1alpha1.AlertmanagerSilence{
+		newTestAlertmanagerSilence("team-a", "up-to-date", endsAt, alertname),
+		newTestAlertmanagerSilence("team-a", "outdated", endsAt, alertname),
+		newTestAlertmanagerSilence("team-a", "new", endsAt, alertname),
+		newTestAlertmanagerSilence("team-a", "expired", now.Add(-time.Minute), alertname),
+	} {
+		ms, err := makeSilence(s, semver.MustParse("0.29.0"), e, now)
+		require.NoError(t, err)
+		desired[*ms.CreatedBy] = ms
+	}
+
+	ms := desired["prometheus-operator/team-a/up-to-date"].Matchers
+	fa := newFakeAlertmanager(
+		now,
+		// Up-to-date silence with a duplicate.
+		newGettableSilence("00000000-0000-0000-0000-00000000000a", "prometheus-operator/team-a/up-to-date", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt, ms...),
+		newGettableSilence("00000000-0000-0000-0000-00000000000b", "prometheus-operator/team-a/up-to-date", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt, ms...),
+		// Silence with a different end time.
+		newGettableSilence("00000000-0000-0000-0000-00000000000c", "prometheus-operator/team-a/outdated", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt.Add(time.Hour), ms...),
+		// Silence of an expired AlertmanagerSilence.
+		newGettableSilence("00000000-0000-0000-0000-00000000000d", "prometheus-operator/team-a/expired", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt, ms...),
+		// Silence of a deleted AlertmanagerSilence.
+		newGettableSilence("00000000-0000-0000-0000-00000000000e", "prometheus-operator/team-a/deleted", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt, ms...),
+		// Silence not managed by the operator.
+		newGettableSilence("00000000-0000-0000-0000-00000000000f", "alice", models.SilenceStatusStateActive, now.Add(-time.Hour), endsAt, ms...),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/alertmanager/", http.StripPrefix("/alertmanager", fa))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	sc := newSilenceClient(am, u.Host, srv.Client())
+
+	got, err := syncInstanceSilences(context.Background(), sc, desired, now)
+	require.NoError(t, err)
+
+	require.Len(t, got, 3)
+	require.Equal(t, "00000000-0000-0000-0000-00000000000a", *got["prometheus-operator/team-a/up-to-date"].ID)
+	require.Equal(t, "00000000-0000-0000-0000-00000000000c", *got["prometheus-operator/team-a/outdated"].ID)
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", *got["prometheus-operator/team-a/new"].ID)
+	require.Equal(t, 2, fa.posts)
+	require.Equal(t, 3, fa.deletes)
+
+	active := fa.active()
+	require.Len(t, active, 4)
+	for _, id := range []string{
+		"00000000-0000-0000-0000-00000000000a",
+		"00000000-0000-0000-0000-00000000000c",
+		"00000000-0000-0000-0000-00000000000f",
+		"00000000-0000-0000-0000-000000000001",
+	} {
+		require.Contains(t, active, id)
+	}
+	require.True(t, time.Time(*active["00000000-0000-0000-0000-00000000000c"].EndsAt).Equal(endsAt))
+
+	// The second synchronization is a no-op.
+	got, err = syncInstanceSilences(context.Background(), sc, desired, now)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	require.Equal(t, 2, fa.posts)
+	require.Equal(t, 3, fa.deletes)
+
+	// All the managed silences are expired when no silence is desired.
+	got, err = syncInstanceSilences(context.Background(), sc, nil, now)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	require.Len(t, fa.active(), 1)
+	require.Contains(t, fa.active(), "00000000-0000-0000-0000-00000000000f")
+
+	// Errors are reported.
+	srv.Close()
+	_, err = syncInstanceSilences(context.Background(), sc, desired, now)
+	require.Error(t, err)
+}
+
+func TestMakeSilenceBinding(t *testing.T) {
+	var (
+		now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		am  = &monitoringv1.Alertmanager{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: "main"},
+		}
+		s         = newTestAlertmanagerSilence("team-a", "silence", now.Add(time.Hour))
+		createdBy = "prometheus-operator/team-a/silence"
+		instances = map[string]map[string]*models.GettableSilence{
+			"alertmanager-main-1": {
+				createdBy: newGettableSilence("id-1", createdBy, models.SilenceStatusStateActive, now, now.Add(time.Hour)),
+			},
+			"alertmanager-main-0": {
+				createdBy: newGettableSilence("id-0", createdBy, models.SilenceStatusStateActive, now, now.Add(time.Hour)),
+			},
+			"alertmanager-main-2": {},
+		}
+	)
+
+	b := makeSilenceBinding(am, s, nil, instances, now)
+	require.Equal(t, "main", b.Name)
+	require.Equal(t, "monitoring", b.Namespace)
+	require.Equal(t, monitoringv1alpha1.ActiveSilenceState, b.State)
+	require.True(t, b.ExpiresAt.Equal(&metav1.Time{Time: now.Add(time.Hour)}))
+	require.Equal(t, []monitoringv1alpha1.AlertmanagerSilenceInstance{
+		{Pod: "alertmanager-main-0", ID: "id-0"},
+		{Pod: "alertmanager-main-1", ID: "id-1"},
+	}, b.Instances)
+	require.Len(t, b.Conditions, 1)
+	require.Equal(t, monitoringv1.ConditionTrue, b.Conditions[0].Status)
+	require.Equal(t, int64(1), b.Conditions[0].ObservedGeneration)
+
+	s.Spec.StartsAt = ptr.To(metav1.NewTime(now.Add(time.Minute)))
+	b = makeSilenceBinding(am, s, nil, instances, now)
+	require.Equal(t, monitoringv1alpha1.PendingSilenceState, b.State)
+
+	b = makeSilenceBinding(am, s, nil, instances, now.Add(2*time.Hour))
+	require.Equal(t, monitoringv1alpha1.ExpiredSilenceState, b.State)
+
+	b = makeSilenceBinding(am, s, fmt.Errorf("invalid"), instances, now)
+	require.Empty(t, b.State)
+	require.Empty(t, b.Instances)
+	require.Equal(t, monitoringv1.ConditionFalse, b.Conditions[0].Status)
+	require.Equal(t, "invalid", b.Conditions[0].Message)
+
+	// The transition time is kept when the status of the condition is the same.
+	prev := makeSilenceBinding(am, s, nil, instances, now)
+	bindings := setSilenceBinding(nil, am, &prev)
+	require.Len(t, bindings, 1)
+
+	next := makeSilenceBinding(am, s, nil, nil, now.Add(time.Minute))
+	bindings = setSilenceBinding(bindings, am, &next)
+	require.Len(t, bindings, 1)
+	require.Empty(t, bindings[0].Instances)
+	require.True(t, bindings[0].Conditions[0].LastTransitionTime.Equal(&prev.Conditions[0].LastTransitionTime))
+
+	bindings = setSilenceBinding(bindings, am, nil)
+	require.Empty(t, bindings)
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"text/template/parse"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// templateNameRe matches the name of the template in the define, template
+// and block actions.
+var templateNameRe = regexp.MustCompile(`(\{\{-?\s*(?:define|template|block)\s+)("(?:[^"\\]|\\.)*")`)
+
+// notificationTemplates holds the notification templates of an
+// AlertmanagerConfig object.
+type notificationTemplates struct {
+	crKey types.NamespacedName
+
+	// files maps the file names to the content of the templates.
+	files map[string][]byte
+	// names is the set of the templates defined by the files (before
+	// prefixing).
+	names map[string]struct{}
+}
+
+// templateFileName returns the name of the template file in the generated
+// configuration secret.
+func templateFileName(crKey types.NamespacedName, i int) string {
+	return fmt.Sprintf("template_%s_%s_%d.tmpl", crKey.Namespace, crKey.Name, i)
+}
+
+// loadNotificationTemplates reads the notification templates referenced by
+// the AlertmanagerConfig object and prefixes the names of the defined
+// templates with "<namespace>/<name>/".
+//
+// It returns an error if a template can't be read or parsed.
+func loadNotificationTemplates(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, store *assets.StoreBuilder) (*notificationTemplates, error) {
+	nt := &notificationTemplates{
+		crKey: types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name},
+		files: map[string][]byte{},
+		names: map[string]struct{}{},
+	}
+
+	contents := make([]string, len(amc.Spec.Templates))
+	for i, ref := range amc.Spec.Templates {
+		if err := ref.Validate(); err != nil {
+			return nil, fmt.Errorf("templates[%d]: %w", i, err)
+		}
+
+		content, err := store.GetKey(ctx, amc.Namespace, ref)
+		if err != nil {
+			return nil, fmt.Errorf("templates[%d]: %w", i, err)
+		}
+
+		fileName := templateFileName(nt.crKey, i)
+		if errs := validation.IsConfigMapKey(fileName); len(errs) > 0 {
+			return nil, fmt.Errorf("templates[%d]: invalid file name %q: %v", i, fileName, errs)
+		}
+
+		// The functions are provided by Alertmanager, the parser only checks
+		// the syntax.
+		t := parse.New(fileName)
+		t.Mode = parse.SkipFuncCheck
+		treeSet := map[string]*parse.Tree{}
+		if _, err := t.Parse(content, "", "", treeSet); err != nil {
+			return nil, fmt.Errorf("templates[%d]: failed to parse %s: %w", i, ref.String(), err)
+		}
+
+		for name := range treeSet {
+			if name == fileName {
+				continue
+			}
+			nt.names[name] = struct{}{}
+		}
+
+		contents[i] = content
+	}
+
+	for i, content := range contents {
+		nt.files[templateFileName(nt.crKey, i)] = []byte(nt.namespaceReferences(content))
+	}
+
+	return nt, nil
+}
+
+// namespaceReferences prefixes the names of the templates defined by the
+// AlertmanagerConfig object in the define, template and block actions.
+func (nt *notificationTemplates) namespaceReferences(s string) string {
+	if len(nt.names) == 0 {
+		return s
+	}
+
+	return templateNameRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := templateNameRe.FindStringSubmatch(m)
+
+		name, err := strconv.Unquote(sub[2])
+		if err != nil {
+			return m
+		}
+
+		if _, found := nt.names[name]; !found {
+			return m
+		}
+
+		return sub[1] + strconv.Quote(makeNamespacedString(name, nt.crKey))
+	})
+}
+
+// namespaceReceiverReferences rewrites the references to the templates
+// defined by the AlertmanagerConfig object in all the string fields of the
+// receiver.
+func (nt *notificationTemplates) namespaceReceiverReferences(r *receiver) {
+	if len(nt.names) == 0 {
+		return
+	}
+
+	nt.walk(reflect.ValueOf(r))
+}
+
+func (nt *notificationTemplates) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			nt.walk(v.Elem())
+		}
+
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				nt.walk(v.Field(i))
+			}
+		}
+
+	case reflect.Slice:
+		for i := range v.Len() {
+			nt.walk(v.Index(i))
+		}
+
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.String {
+			return
+		}
+
+		for _, k := range v.MapKeys() {
+			s := v.MapIndex(k).String()
+			if ns := nt.namespaceReferences(s); ns != s {
+				v.SetMapIndex(k, reflect.ValueOf(ns).Convert(v.Type().Elem()))
+			}
+		}
+
+	case reflect.String:
+		if !v.CanSet() {
+			return
+		}
+
+		if s := nt.namespaceReferences(v.String()); s != v.String() {
+			v.SetString(s)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func TestLoadNotificationTemplates(t *testing.T) {
+	kclient := fake.NewClientset(
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "ns"},
+			Data: map[string]string{
+				"slack.tmpl": `{{ define "slack.title" }}[{{ .Status }}] {{ template "slack.common" . }}{{ end }}
+{{- define "slack.common" }}{{ .CommonLabels.alertname }}{{ end }}
+{{ define "slack.text" }}{{ template "slack.default.text" . }}{{ end }}`,
+				"invalid.tmpl": `{{ define "foo" }}`,
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "ns"},
+			Data: map[string][]byte{
+				"email.tmpl": []byte(`{{ define "email.subject" }}{{ template "slack.common" . }}{{ end }}`),
+			},
+		},
+	)
+
+	for _, tc := range []struct {
+		name      string
+		templates []monitoringv1.SecretOrConfigMap
+		exp       map[string]string
+		expErr    bool
+	}{
+		{
+			name: "no templates",
+			exp:  map[string]string{},
+		},
+		{
+			name: "configmap and secret",
+			templates: []monitoringv1.SecretOrConfigMap{
+				{
+					ConfigMap: &v1.ConfigMapKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+						Key:                  "slack.tmpl",
+					},
+				},
+				{
+					Secret: &v1.SecretKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+						Key:                  "email.tmpl",
+					},
+				},
+			},
+			exp: map[string]string{
+				"template_ns_amc_0.tmpl": `{{ define "ns/amc/slack.title" }}[{{ .Status }}] {{ template "ns/amc/slack.common" . }}{{ end }}
+{{- define "ns/amc/slack.common" }}{{ .CommonLabels.alertname }}{{ end }}
+{{ define "ns/amc/slack.text" }}{{ template "slack.default.text" . }}{{ end }}`,
+				"template_ns_amc_1.tmpl": `{{ define "ns/amc/email.subject" }}{{ template "ns/amc/slack.common" . }}{{ end }}`,
+			},
+		},
+		{
+			name: "invalid template",
+			templates: []monitoringv1.SecretOrConfigMap{
+				{
+					ConfigMap: &v1.ConfigMapKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+						Key:                  "invalid.tmpl",
+					},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "missing key",
+			templates: []monitoringv1.SecretOrConfigMap{
+				{
+					ConfigMap: &v1.ConfigMapKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+						Key:                  "missing.tmpl",
+					},
+				},
+			},
+			expErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amc := &monitoringv1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "amc", Namespace: "ns"},
+				Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+					Templates: tc.templates,
+				},
+			}
+
+			nt, err := loadNotificationTemplates(context.Background(), amc, assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1()))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make(map[string]string, len(nt.files))
+			for k, v := range nt.files {
+				got[k] = string(v)
+			}
+			require.Equal(t, tc.exp, got)
+		})
+	}
+}
+
+func TestNamespaceReceiverReferences(t *testing.T) {
+	nt := &notificationTemplates{
+		crKey: types.NamespacedName{Namespace: "ns", Name: "amc"},
+		names: map[string]struct{}{"slack.title": {}},
+	}
+
+	r := &receiver{
+		Name: "ns/amc/slack",
+		SlackConfigs: []*slackConfig{
+			{
+				Title: `{{ template "slack.title" . }}`,
+				Text:  `{{ template "slack.default.text" . }}`,
+			},
+		},
+		WebhookConfigs: []*webhookConfig{{}},
+	}
+
+	nt.namespaceReceiverReferences(r)
+
+	require.Equal(t, "ns/amc/slack", r.Name)
+	require.Equal(t, `{{ template "ns/amc/slack.title" . }}`, r.SlackConfigs[0].Title)
+	require.Equal(t, `{{ template "slack.default.text" . }}`, r.SlackConfigs[0].Text)
+}
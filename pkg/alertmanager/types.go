@@ -119,6 +119,8 @@ type receiver struct {
 	MSTeamsV2Configs  []*msTeamsV2Config  `yaml:"msteamsv2_configs,omitempty"`
 	JiraConfigs       []*jiraConfig       `yaml:"jira_configs,omitempty"`
 	RocketChatConfigs []*rocketChatConfig `yaml:"rocketchat_configs,omitempty"`
+	IncidentioConfigs []*incidentioConfig `yaml:"incidentio_configs,omitempty"`
+	MattermostConfigs []*mattermostConfig `yaml:"mattermost_configs,omitempty"`
 }
 
 type webhookConfig struct {
@@ -488,4 +490,27 @@ type rocketChatConfig struct {
 	Actions     []*rocketchatAttachmentAction `yaml:"actions,omitempty"`
 }
 
+type incidentioConfig struct {
+	SendResolved         *bool             `yaml:"send_resolved,omitempty"`
+	HTTPConfig           *httpClientConfig `yaml:"http_config,omitempty"`
+	URL                  string            `yaml:"url,omitempty"`
+	URLFile              string            `yaml:"url_file,omitempty"`
+	AlertSourceToken     string            `yaml:"alert_source_token,omitempty"`
+	AlertSourceTokenFile string            `yaml:"alert_source_token_file,omitempty"`
+	MaxAlerts            int32             `yaml:"max_alerts,omitempty"`
+	Timeout              *model.Duration   `yaml:"timeout,omitempty"`
+}
+
+type mattermostConfig struct {
+	SendResolved   *bool             `yaml:"send_resolved,omitempty"`
+	HTTPConfig     *httpClientConfig `yaml:"http_config,omitempty"`
+	WebhookURL     string            `yaml:"webhook_url,omitempty"`
+	WebhookURLFile string            `yaml:"webhook_url_file,omitempty"`
+	Channel        string            `yaml:"channel,omitempty"`
+	Username       string            `yaml:"username,omitempty"`
+	Text           string            `yaml:"text,omitempty"`
+	IconURL        string            `yaml:"icon_url,omitempty"`
+	IconEmoji      string            `yaml:"icon_emoji,omitempty"`
+}
+
 type timeInterval config.TimeInterval
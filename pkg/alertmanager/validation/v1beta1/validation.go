@@ -115,6 +115,18 @@ func validateReceivers(receivers []monitoringv1beta1.Receiver) (map[string]struc
 			return nil, fmt.Errorf("failed to validate 'msteamsv2Config' - receiver %s: %w", receiver.Name, err)
 		}
 
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'jiraConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateIncidentioConfigs(receiver.IncidentioConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'incidentioConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
+		if err := validateMattermostConfigs(receiver.MattermostConfigs); err != nil {
+			return nil, fmt.Errorf("failed to validate 'mattermostConfig' - receiver %s: %w", receiver.Name, err)
+		}
+
 	}
 
 	return receiverNames, nil
@@ -156,6 +168,44 @@ func validateOpsGenieConfigs(configs []monitoringv1beta1.OpsGenieConfig) error {
 	return nil
 }
 
+func validateIncidentioConfigs(configs []monitoringv1beta1.IncidentioConfig) error {
+	for _, config := range configs {
+		if config.URL == nil && config.URLSecret == nil {
+			return errors.New("one of 'url' or 'urlSecret' is required")
+		}
+
+		if config.URL != nil && *config.URL != "" {
+			if _, err := validation.ValidateURL(string(*config.URL)); err != nil {
+				return fmt.Errorf("invalid 'url': %w", err)
+			}
+		}
+
+		if config.AlertSourceToken != nil && config.HTTPConfig != nil && config.HTTPConfig.Authorization != nil {
+			return errors.New("'alertSourceToken' and 'httpConfig.authorization' are mutually exclusive")
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateMattermostConfigs(configs []monitoringv1beta1.MattermostConfig) error {
+	for _, config := range configs {
+		if config.IconURL != nil && *config.IconURL != "" {
+			if _, err := validation.ValidateURL(string(*config.IconURL)); err != nil {
+				return fmt.Errorf("invalid 'iconURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateSlackConfigs(configs []monitoringv1beta1.SlackConfig) error {
 	for _, config := range configs {
 		if err := config.Validate(); err != nil {
@@ -365,6 +415,22 @@ func validateRocketchatConfigs(configs []monitoringv1beta1.RocketChatConfig) err
 	return nil
 }
 
+func validateJiraConfigs(configs []monitoringv1beta1.JiraConfig) error {
+	for _, config := range configs {
+		if config.APIURL != nil && *config.APIURL != "" {
+			if _, err := validation.ValidateURL(string(*config.APIURL)); err != nil {
+				return fmt.Errorf("invalid 'apiURL': %w", err)
+			}
+		}
+
+		if err := config.HTTPConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateMSTeamsConfigs(configs []monitoringv1beta1.MSTeamsConfig) error {
 	for _, config := range configs {
 		if err := config.HTTPConfig.Validate(); err != nil {
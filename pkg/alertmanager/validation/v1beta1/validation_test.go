@@ -120,6 +120,40 @@ func TestValidateAlertmanagerConfig(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "Test fail to validate incidentio config - missing url",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "different",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate mattermost config - invalid icon URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "different",
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									IconURL: ptr.To(monitoringv1beta1.URL("://example.com")),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Test fail to validate wechat config - invalid URL",
 			in: &monitoringv1beta1.AlertmanagerConfig{
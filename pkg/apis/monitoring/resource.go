@@ -48,32 +48,72 @@ const (
 
 	ThanosRulersKind = "ThanosRuler"
 	ThanosRulerName  = "thanosrulers"
+
+	ThanosQueriersKind = "ThanosQuerier"
+	ThanosQuerierName  = "thanosqueriers"
+
+	ThanosCompactorsKind = "ThanosCompactor"
+	ThanosCompactorName  = "thanoscompactors"
+
+	ThanosStoresKind = "ThanosStore"
+	ThanosStoreName  = "thanosstores"
+
+	MonitoringQuotasKind = "MonitoringQuota"
+	MonitoringQuotaName  = "monitoringquotas"
+
+	ScrapeClassDefinitionsKind = "ScrapeClassDefinition"
+	ScrapeClassDefinitionName  = "scrapeclassdefinitions"
+
+	ReferenceGrantsKind = "ReferenceGrant"
+	ReferenceGrantName  = "referencegrants"
+
+	AlertmanagerSilencesKind = "AlertmanagerSilence"
+	AlertmanagerSilenceName  = "alertmanagersilences"
+
+	ClusterAlertmanagerConfigsKind = "ClusterAlertmanagerConfig"
+	ClusterAlertmanagerConfigName  = "clusteralertmanagerconfigs"
 )
 
 var resourceToKindMap = map[string]string{
-	PrometheusName:         PrometheusesKind,
-	PrometheusAgentName:    PrometheusAgentsKind,
-	AlertmanagerName:       AlertmanagersKind,
-	AlertmanagerConfigName: AlertmanagerConfigsKind,
-	ServiceMonitorName:     ServiceMonitorsKind,
-	PodMonitorName:         PodMonitorsKind,
-	PrometheusRuleName:     PrometheusRuleKind,
-	ProbeName:              ProbesKind,
-	ScrapeConfigName:       ScrapeConfigsKind,
-	ThanosRulerName:        ThanosRulersKind,
+	PrometheusName:                PrometheusesKind,
+	PrometheusAgentName:           PrometheusAgentsKind,
+	AlertmanagerName:              AlertmanagersKind,
+	AlertmanagerConfigName:        AlertmanagerConfigsKind,
+	ServiceMonitorName:            ServiceMonitorsKind,
+	PodMonitorName:                PodMonitorsKind,
+	PrometheusRuleName:            PrometheusRuleKind,
+	ProbeName:                     ProbesKind,
+	ScrapeConfigName:              ScrapeConfigsKind,
+	ThanosRulerName:               ThanosRulersKind,
+	ThanosQuerierName:             ThanosQueriersKind,
+	ThanosCompactorName:           ThanosCompactorsKind,
+	ThanosStoreName:               ThanosStoresKind,
+	MonitoringQuotaName:           MonitoringQuotasKind,
+	ScrapeClassDefinitionName:     ScrapeClassDefinitionsKind,
+	ReferenceGrantName:            ReferenceGrantsKind,
+	AlertmanagerSilenceName:       AlertmanagerSilencesKind,
+	ClusterAlertmanagerConfigName: ClusterAlertmanagerConfigsKind,
 }
 
 var kindToResource = map[string]string{
-	PrometheusesKind:        PrometheusName,
-	PrometheusAgentsKind:    PrometheusAgentName,
-	AlertmanagersKind:       AlertmanagerName,
-	AlertmanagerConfigsKind: AlertmanagerConfigName,
-	ServiceMonitorsKind:     ServiceMonitorName,
-	PodMonitorsKind:         PodMonitorName,
-	PrometheusRuleKind:      PrometheusRuleName,
-	ProbesKind:              ProbeName,
-	ScrapeConfigsKind:       ScrapeConfigName,
-	ThanosRulersKind:        ThanosRulerName,
+	PrometheusesKind:               PrometheusName,
+	PrometheusAgentsKind:           PrometheusAgentName,
+	AlertmanagersKind:              AlertmanagerName,
+	AlertmanagerConfigsKind:        AlertmanagerConfigName,
+	ServiceMonitorsKind:            ServiceMonitorName,
+	PodMonitorsKind:                PodMonitorName,
+	PrometheusRuleKind:             PrometheusRuleName,
+	ProbesKind:                     ProbeName,
+	ScrapeConfigsKind:              ScrapeConfigName,
+	ThanosRulersKind:               ThanosRulerName,
+	ThanosQueriersKind:             ThanosQuerierName,
+	ThanosCompactorsKind:           ThanosCompactorName,
+	ThanosStoresKind:               ThanosStoreName,
+	MonitoringQuotasKind:           MonitoringQuotaName,
+	ScrapeClassDefinitionsKind:     ScrapeClassDefinitionName,
+	ReferenceGrantsKind:            ReferenceGrantName,
+	AlertmanagerSilencesKind:       AlertmanagerSilenceName,
+	ClusterAlertmanagerConfigsKind: ClusterAlertmanagerConfigName,
 }
 
 // KindToResource returns the resource name corresponding to the given kind.
@@ -307,12 +307,39 @@ type AlertmanagerSpec struct {
 	// check own namespace.
 	// +optional
 	AlertmanagerConfigNamespaceSelector *metav1.LabelSelector `json:"alertmanagerConfigNamespaceSelector,omitempty"`
+	// clusterAlertmanagerConfigSelector defines the selector of the
+	// ClusterAlertmanagerConfig objects to be merged into the Alertmanager
+	// configuration.
+	//
+	// The routes and inhibition rules of the selected objects apply to the
+	// alerts of all namespaces and their routes are evaluated before the
+	// routes of the AlertmanagerConfig objects.
+	//
+	// If nil, no ClusterAlertmanagerConfig object is selected.
+	// +optional
+	ClusterAlertmanagerConfigSelector *metav1.LabelSelector `json:"clusterAlertmanagerConfigSelector,omitempty"`
 
 	// alertmanagerConfigMatcherStrategy defines how AlertmanagerConfig objects
 	// process incoming alerts.
 	// +optional
 	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
 
+	// alertmanagerSilenceSelector defines the selector of the
+	// AlertmanagerSilence objects which are synchronized with the
+	// Alertmanager pods.
+	//
+	// The matchers of the silences are processed according to the
+	// `alertmanagerConfigMatcherStrategy` field.
+	//
+	// If nil, the operator doesn't manage silences.
+	// +optional
+	AlertmanagerSilenceSelector *metav1.LabelSelector `json:"alertmanagerSilenceSelector,omitempty"`
+	// alertmanagerSilenceNamespaceSelector defines the namespaces to be
+	// selected for AlertmanagerSilence discovery. If nil, only check own
+	// namespace.
+	// +optional
+	AlertmanagerSilenceNamespaceSelector *metav1.LabelSelector `json:"alertmanagerSilenceNamespaceSelector,omitempty"`
+
 	// minReadySeconds defines the minimum number of seconds for which a newly created pod should be ready
 	// without any of its container crashing for it to be considered available.
 	//
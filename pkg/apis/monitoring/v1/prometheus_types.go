@@ -926,6 +926,29 @@ type CommonPrometheusFields struct {
 	// +optional
 	ScrapeClasses []ScrapeClass `json:"scrapeClasses,omitempty"`
 
+	// scrapeClassSelector defines the ScrapeClassDefinition objects to be
+	// selected in addition to the scrape classes defined by
+	// `spec.scrapeClasses`. An empty label selector matches all objects. A
+	// null label selector matches no objects.
+	//
+	// The ScrapeClassDefinition objects from the Prometheus namespace apply
+	// to the scrape resources from all namespaces. The ScrapeClassDefinition
+	// objects from the other namespaces apply only to the scrape resources
+	// from the same namespace.
+	//
+	// Note that the ScrapeClassDefinition custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	ScrapeClassSelector *metav1.LabelSelector `json:"scrapeClassSelector,omitempty"`
+	// scrapeClassNamespaceSelector defines the namespaces to match for
+	// ScrapeClassDefinition discovery. An empty label selector matches all
+	// namespaces. A null label selector matches the current namespace only.
+	//
+	// Note that the ScrapeClassDefinition custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	ScrapeClassNamespaceSelector *metav1.LabelSelector `json:"scrapeClassNamespaceSelector,omitempty"`
+
 	// serviceDiscoveryRole defines the service discovery role used to discover targets from
 	// `ServiceMonitor` objects and Alertmanager endpoints.
 	//
@@ -2499,6 +2522,80 @@ type ScrapeClass struct {
 	//
 	// +optional
 	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+
+	// interval defines the interval at which the targets are scraped.
+	// It applies only if the scrape resource doesn't specify any interval.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// scrapeTimeout defines the timeout after which the scrape is ended.
+	// It applies only if the scrape resource doesn't specify any scrape
+	// timeout.
+	//
+	// The value must be less than or equal to the scrape interval.
+	//
+	// +optional
+	ScrapeTimeout *Duration `json:"scrapeTimeout,omitempty"`
+
+	// sampleLimit defines a per-scrape limit on the number of scraped samples
+	// that will be accepted.
+	// It applies only if the scrape resource doesn't specify any sample
+	// limit. The `enforcedSampleLimit` field of the Prometheus resource still
+	// applies.
+	//
+	// +optional
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+
+	// targetLimit defines a limit on the number of scraped targets that will
+	// be accepted.
+	// It applies only if the scrape resource doesn't specify any target
+	// limit. The `enforcedTargetLimit` field of the Prometheus resource still
+	// applies.
+	//
+	// +optional
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+
+	// labelLimit defines the per-scrape limit on the number of labels that
+	// will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label limit.
+	// The `enforcedLabelLimit` field of the Prometheus resource still applies.
+	//
+	// +optional
+	LabelLimit *uint64 `json:"labelLimit,omitempty"`
+
+	// labelNameLengthLimit defines the per-scrape limit on the length of
+	// labels name that will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label name
+	// length limit. The `enforcedLabelNameLengthLimit` field of the
+	// Prometheus resource still applies.
+	//
+	// +optional
+	LabelNameLengthLimit *uint64 `json:"labelNameLengthLimit,omitempty"`
+
+	// labelValueLengthLimit defines the per-scrape limit on the length of
+	// labels value that will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label value
+	// length limit. The `enforcedLabelValueLengthLimit` field of the
+	// Prometheus resource still applies.
+	//
+	// +optional
+	LabelValueLengthLimit *uint64 `json:"labelValueLengthLimit,omitempty"`
+
+	// nativeHistogramConfig defines the native histogram settings. Each
+	// setting applies only if the scrape resource doesn't specify it.
+	//
+	// +optional
+	NativeHistogramConfig *NativeHistogramConfig `json:"nativeHistogramConfig,omitempty"`
+
+	// proxyConfig defines the HTTP proxy settings to use for the scrape.
+	// It applies only if the scrape resource doesn't specify any proxy
+	// settings.
+	//
+	// For now the `proxyConnectHeader` field isn't supported.
+	//
+	// +optional
+	ProxyConfig *ProxyConfig `json:"proxyConfig,omitempty"`
 }
 
 // TranslationStrategyOption represents a translation strategy option for the OTLP endpoint.
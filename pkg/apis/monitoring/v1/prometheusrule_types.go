@@ -70,6 +70,124 @@ type PrometheusRuleSpec struct {
 	// +listMapKey=name
 	// +optional
 	Groups []RuleGroup `json:"groups,omitempty"`
+
+	// tests defines unit tests for the rules of the object, similar to the
+	// `promtool test rules` command.
+	//
+	// The tests are evaluated by the admission webhook: if any test fails, the
+	// object is rejected. They are ignored by Prometheus and Thanos Ruler.
+	// +listType=atomic
+	// +optional
+	Tests []RuleTest `json:"tests,omitempty"`
+}
+
+// RuleTest defines a unit test for the rules of a PrometheusRule object.
+// The test evaluates the rules against the input series starting at time
+// 0 and compares the result with the expected alerts and samples.
+// +k8s:openapi-gen=true
+type RuleTest struct {
+	// name defines the name of the test.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// interval defines the interval between the samples of the input series.
+	// Default: "1m"
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+	// evaluationInterval defines how often the rules are evaluated.
+	// Default: "1m"
+	// +optional
+	EvaluationInterval *Duration `json:"evaluationInterval,omitempty"`
+	// inputSeries defines the series used as input for the rules.
+	// +listType=atomic
+	// +optional
+	InputSeries []RuleTestInputSeries `json:"inputSeries,omitempty"`
+	// alertRuleTests defines the expected alerts.
+	// +listType=atomic
+	// +optional
+	AlertRuleTests []AlertRuleTest `json:"alertRuleTests,omitempty"`
+	// promqlExprTests defines the expected results of PromQL expressions.
+	// They can be used to test the output of recording rules.
+	// +listType=atomic
+	// +optional
+	PromQLExprTests []PromQLExprTest `json:"promqlExprTests,omitempty"`
+}
+
+// RuleTestInputSeries defines an input series for a rule test.
+// +k8s:openapi-gen=true
+type RuleTestInputSeries struct {
+	// series defines the series in the PromQL notation (e.g.
+	// `up{job="app"}`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Series string `json:"series"`
+	// values defines the values of the series using the expanding notation of
+	// promtool (e.g. `1+1x10`, `0 _ stale 5x3`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Values string `json:"values"`
+}
+
+// AlertRuleTest defines the alerts expected to be firing at a given time.
+// +k8s:openapi-gen=true
+type AlertRuleTest struct {
+	// evalTime defines the time (since the start of the test) at which the
+	// alerts are checked.
+	// +required
+	EvalTime Duration `json:"evalTime"`
+	// alertname defines the name of the alerting rule to check.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Alertname string `json:"alertname"`
+	// expAlerts defines the firing alerts expected at evalTime. An empty list
+	// means that no alert should be firing.
+	// +listType=atomic
+	// +optional
+	ExpAlerts []ExpectedAlert `json:"expAlerts,omitempty"`
+}
+
+// ExpectedAlert defines an expected alert.
+// +k8s:openapi-gen=true
+type ExpectedAlert struct {
+	// expLabels defines the expected labels of the alert, excluding the
+	// `alertname` label.
+	// +optional
+	ExpLabels map[string]string `json:"expLabels,omitempty"`
+	// expAnnotations defines the expected annotations of the alert.
+	// +optional
+	ExpAnnotations map[string]string `json:"expAnnotations,omitempty"`
+}
+
+// PromQLExprTest defines the expected result of a PromQL expression at a
+// given time.
+// +k8s:openapi-gen=true
+type PromQLExprTest struct {
+	// expr defines the PromQL expression to evaluate.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Expr string `json:"expr"`
+	// evalTime defines the time (since the start of the test) at which the
+	// expression is evaluated.
+	// +required
+	EvalTime Duration `json:"evalTime"`
+	// expSamples defines the expected samples. An empty list means that the
+	// expression should return no result.
+	// +listType=atomic
+	// +optional
+	ExpSamples []ExpectedSample `json:"expSamples,omitempty"`
+}
+
+// ExpectedSample defines an expected sample.
+// +k8s:openapi-gen=true
+type ExpectedSample struct {
+	// labels defines the labels of the sample in the PromQL notation (e.g.
+	// `up{job="app"}`).
+	// +optional
+	Labels string `json:"labels,omitempty"`
+	// value defines the expected value of the sample (e.g. "1", "0.5" or
+	// "NaN").
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Value string `json:"value"`
 }
 
 // RuleGroup and Rule are copied instead of vendored because the
@@ -1090,6 +1090,61 @@ type WorkloadBinding struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []ConfigResourceCondition `json:"conditions,omitempty"`
+	// targetDiscovery defines a preview of the Kubernetes objects matched by
+	// the ServiceMonitor or PodMonitor for the referenced Workload object.
+	//
+	// It is only set for ServiceMonitor and PodMonitor resources bound to
+	// Prometheus objects.
+	// +optional
+	TargetDiscovery *TargetDiscoveryStatus `json:"targetDiscovery,omitempty"`
+}
+
+// TargetDiscoveryStatus summarizes the Kubernetes objects matched by the
+// selectors of a ServiceMonitor or a PodMonitor.
+//
+// The operator computes it from its own informers' caches: the actual
+// targets discovered by Prometheus may differ because of the relabeling
+// rules.
+// +k8s:openapi-gen=true
+type TargetDiscoveryStatus struct {
+	// services defines the number of Services matching the ServiceMonitor's
+	// selectors.
+	// +optional
+	Services *int32 `json:"services,omitempty"`
+	// endpointSlices defines the number of EndpointSlices backing the
+	// Services matching the ServiceMonitor's selectors.
+	// +optional
+	EndpointSlices *int32 `json:"endpointSlices,omitempty"`
+	// pods defines the number of Pods matching the PodMonitor's selectors.
+	// +optional
+	Pods *int32 `json:"pods,omitempty"`
+	// endpoints defines the discovery preview for each item of the
+	// ServiceMonitor's `endpoints` or the PodMonitor's `podMetricsEndpoints`.
+	// +listType=map
+	// +listMapKey=index
+	// +optional
+	Endpoints []EndpointDiscoveryStatus `json:"endpoints,omitempty"`
+}
+
+// EndpointDiscoveryStatus summarizes the ports matched by an endpoint of a
+// ServiceMonitor or a PodMonitor.
+// +k8s:openapi-gen=true
+type EndpointDiscoveryStatus struct {
+	// index defines the position of the endpoint in the list of endpoints.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	Index int32 `json:"index"`
+	// matchedPorts defines the names of the Service ports (for
+	// ServiceMonitor) or container ports (for PodMonitor) matching the
+	// endpoint. Unnamed ports are identified by their number.
+	// +listType=set
+	// +optional
+	MatchedPorts []string `json:"matchedPorts,omitempty"`
+	// targets defines the number of addresses matching the endpoint
+	// before relabeling.
+	// +kubebuilder:validation:Minimum=0
+	// +required
+	Targets int32 `json:"targets"`
 }
 
 // ConfigResourceCondition describes the status of configuration resources linked to Prometheus, PrometheusAgent, Alertmanager or ThanosRuler.
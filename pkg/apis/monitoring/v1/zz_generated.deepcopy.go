@@ -56,6 +56,28 @@ func (in *APIServerConfig) DeepCopy() *APIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRuleTest) DeepCopyInto(out *AlertRuleTest) {
+	*out = *in
+	if in.ExpAlerts != nil {
+		in, out := &in.ExpAlerts, &out.ExpAlerts
+		*out = make([]ExpectedAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRuleTest.
+func (in *AlertRuleTest) DeepCopy() *AlertRuleTest {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRuleTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlertingSpec) DeepCopyInto(out *AlertingSpec) {
 	*out = *in
@@ -487,7 +509,22 @@ func (in *AlertmanagerSpec) DeepCopyInto(out *AlertmanagerSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterAlertmanagerConfigSelector != nil {
+		in, out := &in.ClusterAlertmanagerConfigSelector, &out.ClusterAlertmanagerConfigSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	out.AlertmanagerConfigMatcherStrategy = in.AlertmanagerConfigMatcherStrategy
+	if in.AlertmanagerSilenceSelector != nil {
+		in, out := &in.AlertmanagerSilenceSelector, &out.AlertmanagerSilenceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertmanagerSilenceNamespaceSelector != nil {
+		in, out := &in.AlertmanagerSilenceNamespaceSelector, &out.AlertmanagerSilenceNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(int32)
@@ -1148,6 +1185,16 @@ func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScrapeClassSelector != nil {
+		in, out := &in.ScrapeClassSelector, &out.ScrapeClassSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScrapeClassNamespaceSelector != nil {
+		in, out := &in.ScrapeClassNamespaceSelector, &out.ScrapeClassNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceDiscoveryRole != nil {
 		in, out := &in.ServiceDiscoveryRole, &out.ServiceDiscoveryRole
 		*out = new(ServiceDiscoveryRole)
@@ -1443,6 +1490,26 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointDiscoveryStatus) DeepCopyInto(out *EndpointDiscoveryStatus) {
+	*out = *in
+	if in.MatchedPorts != nil {
+		in, out := &in.MatchedPorts, &out.MatchedPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointDiscoveryStatus.
+func (in *EndpointDiscoveryStatus) DeepCopy() *EndpointDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Exemplars) DeepCopyInto(out *Exemplars) {
 	*out = *in
@@ -1463,6 +1530,50 @@ func (in *Exemplars) DeepCopy() *Exemplars {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedAlert) DeepCopyInto(out *ExpectedAlert) {
+	*out = *in
+	if in.ExpLabels != nil {
+		in, out := &in.ExpLabels, &out.ExpLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExpAnnotations != nil {
+		in, out := &in.ExpAnnotations, &out.ExpAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedAlert.
+func (in *ExpectedAlert) DeepCopy() *ExpectedAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectedSample) DeepCopyInto(out *ExpectedSample) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectedSample.
+func (in *ExpectedSample) DeepCopy() *ExpectedSample {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectedSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalJiraConfig) DeepCopyInto(out *GlobalJiraConfig) {
 	*out = *in
@@ -2480,6 +2591,26 @@ func (in *ProberSpec) DeepCopy() *ProberSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromQLExprTest) DeepCopyInto(out *PromQLExprTest) {
+	*out = *in
+	if in.ExpSamples != nil {
+		in, out := &in.ExpSamples, &out.ExpSamples
+		*out = make([]ExpectedSample, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromQLExprTest.
+func (in *PromQLExprTest) DeepCopy() *PromQLExprTest {
+	if in == nil {
+		return nil
+	}
+	out := new(PromQLExprTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 	*out = *in
@@ -2591,6 +2722,13 @@ func (in *PrometheusRuleSpec) DeepCopyInto(out *PrometheusRuleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RuleTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleSpec.
@@ -3209,6 +3347,65 @@ func (in *RuleGroup) DeepCopy() *RuleGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTest) DeepCopyInto(out *RuleTest) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.EvaluationInterval != nil {
+		in, out := &in.EvaluationInterval, &out.EvaluationInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.InputSeries != nil {
+		in, out := &in.InputSeries, &out.InputSeries
+		*out = make([]RuleTestInputSeries, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertRuleTests != nil {
+		in, out := &in.AlertRuleTests, &out.AlertRuleTests
+		*out = make([]AlertRuleTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromQLExprTests != nil {
+		in, out := &in.PromQLExprTests, &out.PromQLExprTests
+		*out = make([]PromQLExprTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTest.
+func (in *RuleTest) DeepCopy() *RuleTest {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleTestInputSeries) DeepCopyInto(out *RuleTestInputSeries) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleTestInputSeries.
+func (in *RuleTestInputSeries) DeepCopy() *RuleTestInputSeries {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleTestInputSeries)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rules) DeepCopyInto(out *Rules) {
 	*out = *in
@@ -3364,6 +3561,51 @@ func (in *ScrapeClass) DeepCopyInto(out *ScrapeClass) {
 		*out = new(AttachMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLimit != nil {
+		in, out := &in.TargetLimit, &out.TargetLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelLimit != nil {
+		in, out := &in.LabelLimit, &out.LabelLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelNameLengthLimit != nil {
+		in, out := &in.LabelNameLengthLimit, &out.LabelNameLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelValueLengthLimit != nil {
+		in, out := &in.LabelValueLengthLimit, &out.LabelValueLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.NativeHistogramConfig != nil {
+		in, out := &in.NativeHistogramConfig, &out.NativeHistogramConfig
+		*out = new(NativeHistogramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClass.
@@ -3676,6 +3918,43 @@ func (in *TSDBSpec) DeepCopy() *TSDBSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetDiscoveryStatus) DeepCopyInto(out *TargetDiscoveryStatus) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EndpointSlices != nil {
+		in, out := &in.EndpointSlices, &out.EndpointSlices
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]EndpointDiscoveryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetDiscoveryStatus.
+func (in *TargetDiscoveryStatus) DeepCopy() *TargetDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosRuler) DeepCopyInto(out *ThanosRuler) {
 	*out = *in
@@ -4260,6 +4539,11 @@ func (in *WorkloadBinding) DeepCopyInto(out *WorkloadBinding) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetDiscovery != nil {
+		in, out := &in.TargetDiscovery, &out.TargetDiscovery
+		*out = new(TargetDiscoveryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadBinding.
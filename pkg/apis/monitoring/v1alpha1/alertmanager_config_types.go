@@ -42,6 +42,7 @@ const (
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:categories="prometheus-operator",shortName="amcfg"
+// +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 
 // AlertmanagerConfig configures the Prometheus Alertmanager,
@@ -55,6 +56,18 @@ type AlertmanagerConfig struct {
 	// spec defines the specification of AlertmanagerConfigSpec
 	// +required
 	Spec AlertmanagerConfigSpec `json:"spec"`
+	// status defines the status subresource. It is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the AlertmanagerConfig. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status monitoringv1.ConfigResourceStatus `json:"status,omitempty,omitzero"`
+}
+
+func (l *AlertmanagerConfig) Bindings() []monitoringv1.WorkloadBinding {
+	return l.Status.Bindings
 }
 
 // AlertmanagerConfigList is a list of AlertmanagerConfig.
@@ -90,6 +103,32 @@ type AlertmanagerConfigSpec struct {
 	// muteTimeIntervals defines the list of MuteTimeInterval specifying when the routes should be muted.
 	// +optional
 	MuteTimeIntervals []MuteTimeInterval `json:"muteTimeIntervals,omitempty"`
+	// templates defines the notification templates.
+	//
+	// The ConfigMaps and Secrets must be in the same namespace as the
+	// AlertmanagerConfig object. The operator prefixes the names of the
+	// templates defined in the files with `<namespace>/<name>/` (like it does
+	// for the receivers) and rewrites the references to these templates in
+	// the templates and the receivers of the AlertmanagerConfig object
+	// accordingly. Other templates (e.g. the default Alertmanager templates)
+	// can be referenced without prefix.
+	// +listType=atomic
+	// +optional
+	Templates []monitoringv1.SecretOrConfigMap `json:"templates,omitempty"`
+	// priority defines the position of the top-level route of the object in
+	// the generated Alertmanager configuration.
+	//
+	// The operator inserts the routes by decreasing priority and sorts the
+	// routes with the same priority by namespace and name. When several
+	// objects selected by the same Alertmanager define the same priority,
+	// the conflict is reported in their status.
+	//
+	// The routes of ClusterAlertmanagerConfig objects are always inserted
+	// before the routes of AlertmanagerConfig objects.
+	//
+	// Defaults to 0.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 // Route defines a node in the routing tree.
@@ -217,6 +256,18 @@ type Receiver struct {
 	// It requires Alertmanager >= 0.28.0.
 	// +optional
 	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
+	// jiraConfigs defines the list of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +optional
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
+	// incidentioConfigs defines the list of incident.io configurations.
+	// It requires Alertmanager >= 0.29.0.
+	// +optional
+	IncidentioConfigs []IncidentioConfig `json:"incidentioConfigs,omitempty"`
+	// mattermostConfigs defines the list of Mattermost configurations.
+	// It requires Alertmanager >= 0.30.0.
+	// +optional
+	MattermostConfigs []MattermostConfig `json:"mattermostConfigs,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -1286,6 +1337,145 @@ type RocketChatActionConfig struct {
 	Msg *string `json:"msg,omitempty"`
 }
 
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+// It requires Alertmanager >= 0.28.0.
+type JiraConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// apiURL defines the Jira API URL i.e. https://company.atlassian.net/rest/api/2/
+	// The full API path must be included.
+	// If not specified, the default API URL from the global configuration is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// project defines the project key where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// issueType defines the type of the issue (e.g. Bug).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	IssueType string `json:"issueType"`
+	// summary defines the issue summary template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Summary *string `json:"summary,omitempty"`
+	// description defines the issue description template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// labels defines the labels to be added to the issue.
+	// +listType=set
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// priority defines the priority of the issue.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Priority *string `json:"priority,omitempty"`
+	// reopenTransition defines the name of the workflow transition to reopen an issue.
+	// The target status should not have the category "done".
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ReopenTransition *string `json:"reopenTransition,omitempty"`
+	// resolveTransition defines the name of the workflow transition to resolve an issue.
+	// The target status must have the category "done".
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ResolveTransition *string `json:"resolveTransition,omitempty"`
+	// wontFixResolution defines the resolution of issues which shouldn't be reopened.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	WontFixResolution *string `json:"wontFixResolution,omitempty"`
+	// reopenDuration defines the duration after which a resolved issue is
+	// reopened instead of creating a new one.
+	// Issues which have been resolved for longer than the duration won't be reopened.
+	// +optional
+	ReopenDuration *monitoringv1.Duration `json:"reopenDuration,omitempty"`
+	// fields defines additional custom fields of the issue.
+	// The keys are the Jira field identifiers (e.g. "customfield_10000").
+	// +optional
+	Fields map[string]apiextensionsv1.JSON `json:"fields,omitempty"`
+	// httpConfig defines the HTTP client configuration for Jira API requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// IncidentioConfig configures notifications via incident.io.
+// See https://prometheus.io/docs/alerting/latest/configuration/#incidentio_config
+// It requires Alertmanager >= 0.29.0.
+type IncidentioConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// url defines the URL of the incident.io alert source to send HTTP POST requests to.
+	// urlSecret takes precedence over url. One of urlSecret and url should be defined.
+	// +optional
+	URL *URL `json:"url,omitempty"`
+	// urlSecret defines the secret's key that contains the URL of the incident.io alert source.
+	// urlSecret takes precedence over url. One of urlSecret and url should be defined.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	URLSecret *v1.SecretKeySelector `json:"urlSecret,omitempty"`
+	// alertSourceToken defines the secret's key that contains the token used
+	// to authenticate with the incident.io alert source.
+	// It is mutually exclusive with `httpConfig.authorization`.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	AlertSourceToken *v1.SecretKeySelector `json:"alertSourceToken,omitempty"`
+	// maxAlerts defines the maximum number of alerts to be sent per incident.io message.
+	// When 0, all alerts are included in the payload.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAlerts int32 `json:"maxAlerts,omitempty"`
+	// timeout defines the maximum time to wait for an incident.io request to complete,
+	// before failing the request and allowing it to be retried.
+	// +optional
+	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	// httpConfig defines the HTTP client configuration for incident.io requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// MattermostConfig configures notifications via Mattermost.
+// See https://prometheus.io/docs/alerting/latest/configuration/#mattermost_config
+// It requires Alertmanager >= 0.30.0.
+type MattermostConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// webhookURL defines the secret's key that contains the Mattermost incoming webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +required
+	WebhookURL v1.SecretKeySelector `json:"webhookURL"`
+	// channel overrides the channel configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+	// username overrides the username configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// text defines the message text template.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// iconURL defines the URL of the image used as the message avatar.
+	// +optional
+	IconURL *URL `json:"iconURL,omitempty"`
+	// iconEmoji defines the emoji used as the message avatar.
+	// It takes precedence over iconURL.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IconEmoji *string `json:"iconEmoji,omitempty"`
+	// httpConfig defines the HTTP client configuration for Mattermost requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -0,0 +1,212 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	AlertmanagerSilenceKind    = "AlertmanagerSilence"
+	AlertmanagerSilenceName    = "alertmanagersilences"
+	AlertmanagerSilenceKindKey = "alertmanagersilence"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amsilence"
+// +kubebuilder:printcolumn:name="Ends At",type="date",JSONPath=".spec.endsAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+
+// The `AlertmanagerSilence` custom resource definition (CRD) declares a
+// silence which the operator creates in the Alertmanager instances selecting
+// the resource (see the `.spec.alertmanagerSilenceSelector` field of the
+// Alertmanager CRD).
+//
+// Like the routes of AlertmanagerConfig resources, the silence only applies
+// by default to alerts for which the `namespace` label is equal to the
+// namespace of the AlertmanagerSilence resource (see the
+// `.spec.alertmanagerConfigMatcherStrategy` field of the Alertmanager CRD).
+type AlertmanagerSilence struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the AlertmanagerSilence.
+	// +required
+	Spec AlertmanagerSilenceSpec `json:"spec"`
+	// status defines the status subresource.
+	//
+	// Most recent observed status of the AlertmanagerSilence. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertmanagerSilenceStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilence) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerSilenceList is a list of AlertmanagerSilences.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of AlertmanagerSilences
+	// +required
+	Items []AlertmanagerSilence `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *AlertmanagerSilenceList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// AlertmanagerSilenceSpec defines the silence.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceSpec struct {
+	// matchers defines the list of matchers that the alerts have to fulfill
+	// to be silenced.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Matchers []Matcher `json:"matchers"`
+
+	// startsAt defines the time from which the silence is effective.
+	// When not defined, the silence is effective immediately.
+	//
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// endsAt defines the time at which the silence expires.
+	//
+	// +required
+	EndsAt metav1.Time `json:"endsAt"`
+
+	// comment defines the description of the silence.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Comment string `json:"comment"`
+}
+
+// Validate returns an error if the silence is invalid.
+func (s *AlertmanagerSilenceSpec) Validate() error {
+	if len(s.Matchers) == 0 {
+		return errors.New("at least one matcher is required")
+	}
+
+	for i, m := range s.Matchers {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("matchers[%d]: %w", i, err)
+		}
+	}
+
+	if s.StartsAt != nil && !s.StartsAt.Before(&s.EndsAt) {
+		return errors.New("startsAt should be before endsAt")
+	}
+
+	if s.Comment == "" {
+		return errors.New("comment is required")
+	}
+
+	return nil
+}
+
+// AlertmanagerSilenceStatus defines the status of the silence in the
+// Alertmanager instances which select it.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceStatus struct {
+	// bindings defines the list of Alertmanager resources which select the
+	// AlertmanagerSilence.
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// +optional
+	Bindings []AlertmanagerSilenceBinding `json:"bindings,omitempty"`
+}
+
+// AlertmanagerSilenceBinding is the status of the silence for an
+// Alertmanager resource.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceBinding struct {
+	// name defines the name of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// namespace defines the namespace of the Alertmanager object.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+	// state defines the state of the silence.
+	// +optional
+	State AlertmanagerSilenceState `json:"state,omitempty"`
+	// expiresAt defines the time at which the silence expires in the
+	// Alertmanager pods.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// instances defines the identifiers of the silence in the Alertmanager
+	// pods.
+	// +listType=map
+	// +listMapKey=pod
+	// +optional
+	Instances []AlertmanagerSilenceInstance `json:"instances,omitempty"`
+	// conditions defines the current state of the silence when bound to the
+	// Alertmanager object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.ConfigResourceCondition `json:"conditions,omitempty"`
+}
+
+// AlertmanagerSilenceState is the state of a silence.
+// +kubebuilder:validation:Enum=Pending;Active;Expired
+type AlertmanagerSilenceState string
+
+const (
+	// PendingSilenceState means that the silence isn't effective yet.
+	PendingSilenceState AlertmanagerSilenceState = "Pending"
+	// ActiveSilenceState means that the silence is effective.
+	ActiveSilenceState AlertmanagerSilenceState = "Active"
+	// ExpiredSilenceState means that the silence has expired.
+	ExpiredSilenceState AlertmanagerSilenceState = "Expired"
+)
+
+// AlertmanagerSilenceInstance identifies the silence in an Alertmanager pod.
+// +k8s:openapi-gen=true
+type AlertmanagerSilenceInstance struct {
+	// pod defines the name of the Alertmanager pod.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Pod string `json:"pod"`
+	// id defines the identifier of the silence in the Alertmanager pod.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ID string `json:"id"`
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ClusterAlertmanagerConfigKind    = "ClusterAlertmanagerConfig"
+	ClusterAlertmanagerConfigName    = "clusteralertmanagerconfigs"
+	ClusterAlertmanagerConfigKindKey = "clusteralertmanagerconfig"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:scope=Cluster,categories="prometheus-operator",shortName="camcfg"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// The `ClusterAlertmanagerConfig` custom resource definition (CRD) is the
+// cluster-scoped counterpart of the AlertmanagerConfig CRD. It is selected by
+// the `.spec.clusterAlertmanagerConfigSelector` field of the Alertmanager CRD.
+//
+// Unlike AlertmanagerConfig resources, the routes and inhibition rules of a
+// ClusterAlertmanagerConfig resource aren't restricted to the alerts of a
+// given namespace and the operator adds its routes before the routes of the
+// AlertmanagerConfig resources.
+//
+// The Secrets and ConfigMaps referenced by the resource are read from the
+// namespace of the Alertmanager resource.
+type ClusterAlertmanagerConfig struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the ClusterAlertmanagerConfig.
+	// +required
+	Spec AlertmanagerConfigSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfig) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ClusterAlertmanagerConfigList is a list of ClusterAlertmanagerConfigs.
+// +k8s:openapi-gen=true
+type ClusterAlertmanagerConfigList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ClusterAlertmanagerConfigs
+	// +required
+	Items []ClusterAlertmanagerConfig `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ClusterAlertmanagerConfigList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
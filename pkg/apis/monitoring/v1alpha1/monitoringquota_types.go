@@ -0,0 +1,137 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	MonitoringQuotasKind   = "MonitoringQuota"
+	MonitoringQuotaName    = "monitoringquotas"
+	MonitoringQuotaKindKey = "monitoringquota"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="mquota"
+// +kubebuilder:printcolumn:name="Sample Limit",type="integer",JSONPath=".spec.sampleLimit",description="The maximum sum of the sample limits"
+// +kubebuilder:printcolumn:name="Target Limit",type="integer",JSONPath=".spec.targetLimit",description="The maximum sum of the target limits"
+// +kubebuilder:printcolumn:name="Enforcement",type="string",JSONPath=".spec.enforcement"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// The `MonitoringQuota` custom resource definition (CRD) defines a scrape
+// budget for the `ServiceMonitor`, `PodMonitor`, `Probe` and `ScrapeConfig`
+// objects living in the same namespace.
+//
+// For each Prometheus and PrometheusAgent object, the operator sums the
+// sample and target limits of the scrape objects selected in the namespace
+// and rejects (or clamps) the objects which exceed the budget. The scrape
+// objects are processed by kind (`ServiceMonitor`, `PodMonitor`, `Probe` and
+// `ScrapeConfig`) then by name.
+//
+// When several `MonitoringQuota` objects exist in the same namespace, the
+// lowest limits apply.
+type MonitoringQuota struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the MonitoringQuota.
+	// +required
+	Spec MonitoringQuotaSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *MonitoringQuota) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// MonitoringQuotaList is a list of MonitoringQuotas.
+// +k8s:openapi-gen=true
+type MonitoringQuotaList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of MonitoringQuotas
+	// +required
+	Items []MonitoringQuota `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *MonitoringQuotaList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// MonitoringQuotaSpec defines the scrape budget of a namespace.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.sampleLimit) || has(self.targetLimit)",message="at least one of sampleLimit or targetLimit must be set"
+type MonitoringQuotaSpec struct {
+	// sampleLimit defines the maximum sum of the sample limits of the scrape
+	// objects in the namespace.
+	//
+	// The sample limit of a scrape object is its `sampleLimit` value, otherwise
+	// the `sampleLimit` value of its scrape class, otherwise the `sampleLimit`
+	// value of the Prometheus object. It is capped by the
+	// `enforcedSampleLimit` value of the Prometheus object.
+	// When the quota defines a sample limit, the scrape objects without
+	// sample limit exceed the budget.
+	//
+	// +optional
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+
+	// targetLimit defines the maximum sum of the target limits of the scrape
+	// objects in the namespace.
+	//
+	// The target limit of a scrape object is its `targetLimit` value, otherwise
+	// the `targetLimit` value of its scrape class, otherwise the `targetLimit`
+	// value of the Prometheus object. It is capped by the
+	// `enforcedTargetLimit` value of the Prometheus object.
+	// When the quota defines a target limit, the scrape objects without
+	// target limit exceed the budget.
+	//
+	// +optional
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+
+	// enforcement defines how the operator handles the scrape objects which
+	// exceed the budget.
+	//
+	// * `Reject` (default): the scrape object is rejected.
+	// * `Clamp`: the limits of the scrape object are lowered to the remaining
+	// budget. The scrape object is rejected when no budget remains.
+	//
+	// When several `MonitoringQuota` objects exist in the same namespace,
+	// `Reject` takes precedence over `Clamp`.
+	//
+	// +optional
+	Enforcement *QuotaEnforcement `json:"enforcement,omitempty"`
+}
+
+// QuotaEnforcement defines how the operator handles the scrape objects
+// exceeding a quota.
+// +kubebuilder:validation:Enum=Reject;Clamp
+type QuotaEnforcement string
+
+const (
+	// RejectQuotaEnforcement rejects the scrape objects exceeding the quota.
+	RejectQuotaEnforcement QuotaEnforcement = "Reject"
+	// ClampQuotaEnforcement lowers the limits of the scrape objects exceeding
+	// the quota.
+	ClampQuotaEnforcement QuotaEnforcement = "Clamp"
+)
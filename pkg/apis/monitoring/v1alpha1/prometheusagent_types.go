@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -111,6 +112,7 @@ func (l *PrometheusAgentList) DeepCopyObject() runtime.Object {
 // +kubebuilder:validation:XValidation:rule="!(has(self.mode) && self.mode == 'DaemonSet' && has(self.persistentVolumeClaimRetentionPolicy))",message="persistentVolumeClaimRetentionPolicy cannot be set when mode is DaemonSet"
 // +kubebuilder:validation:XValidation:rule="!(has(self.mode) && self.mode == 'DaemonSet' && has(self.scrapeConfigSelector))",message="scrapeConfigSelector cannot be set when mode is DaemonSet"
 // +kubebuilder:validation:XValidation:rule="!(has(self.mode) && self.mode == 'DaemonSet' && has(self.probeSelector))",message="probeSelector cannot be set when mode is DaemonSet"
+// +kubebuilder:validation:XValidation:rule="!has(self.ruleSelector) || has(self.ruler)",message="ruler must be set when ruleSelector is set"
 type PrometheusAgentSpec struct {
 	// mode defines how the Prometheus operator deploys the PrometheusAgent pod(s).
 	//
@@ -119,9 +121,104 @@ type PrometheusAgentSpec struct {
 	// +optional
 	Mode *PrometheusAgentMode `json:"mode,omitempty"`
 
+	// ruleSelector defines the PrometheusRule objects to be selected for rule
+	// evaluation. An empty label selector matches all objects. A null label
+	// selector matches no objects.
+	//
+	// Because the Prometheus agent mode has no rule engine, the rules are
+	// evaluated by a ThanosRuler object managed by the operator. The
+	// ThanosRuler runs in stateless mode and writes the results of the
+	// evaluation (recording rules and ALERTS series) to the same remote-write
+	// endpoints as the PrometheusAgent.
+	//
+	// It requires the `ruler` field to be set.
+	//
+	// +optional
+	RuleSelector *metav1.LabelSelector `json:"ruleSelector,omitempty"`
+	// ruleNamespaceSelector defines the namespaces to be selected for
+	// PrometheusRule discovery. If unspecified, only the same namespace as the
+	// PrometheusAgent object is in is used.
+	//
+	// +optional
+	RuleNamespaceSelector *metav1.LabelSelector `json:"ruleNamespaceSelector,omitempty"`
+	// ruler defines the settings of the ThanosRuler object evaluating the
+	// rules selected by `ruleSelector`.
+	//
+	// +optional
+	Ruler *PrometheusAgentRulerSpec `json:"ruler,omitempty"`
+
 	monitoringv1.CommonPrometheusFields `json:",inline"`
 }
 
+// PrometheusAgentRulerSpec defines the settings of the ThanosRuler object
+// managed by the operator to evaluate the rules of a PrometheusAgent.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.queryEndpoints) || has(self.queryConfig)",message="either queryEndpoints or queryConfig must be set"
+type PrometheusAgentRulerSpec struct {
+	// version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+	// image defines the container image for the Thanos ruler. If not
+	// specified, the operator uses the default Thanos image.
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// replicas defines the number of ruler instances to deploy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// resources defines the resource requirements for the ruler container.
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// queryEndpoints defines the list of endpoints from which to query
+	// metrics. The endpoints need to expose the data written by the
+	// PrometheusAgent (e.g. the Thanos Query or Prometheus-compatible API of
+	// the remote storage).
+	//
+	// `queryConfig` takes precedence over this field.
+	//
+	// +listType=set
+	// +optional
+	QueryEndpoints []string `json:"queryEndpoints,omitempty"`
+	// queryConfig defines the list of endpoints from which to query metrics.
+	//
+	// The configuration format is defined at https://thanos.io/tip/components/rule.md/#query-api
+	//
+	// The operator performs no validation of the configuration.
+	//
+	// This field takes precedence over `queryEndpoints`.
+	//
+	// +optional
+	QueryConfig *v1.SecretKeySelector `json:"queryConfig,omitempty"`
+
+	// alertmanagersUrl defines the list of Alertmanager endpoints to send
+	// alerts to.
+	//
+	// `alertmanagersConfig` takes precedence over this field.
+	//
+	// +listType=set
+	// +optional
+	AlertManagersURL []string `json:"alertmanagersUrl,omitempty"`
+	// alertmanagersConfig defines the list of Alertmanager endpoints to send
+	// alerts to.
+	//
+	// The configuration format is defined at https://thanos.io/tip/components/rule.md/#alertmanager.
+	//
+	// The operator performs no validation of the configuration.
+	//
+	// This field takes precedence over `alertmanagersUrl`.
+	//
+	// +optional
+	AlertManagersConfig *v1.SecretKeySelector `json:"alertmanagersConfig,omitempty"`
+
+	// evaluationInterval defines the interval between consecutive
+	// evaluations.
+	//
+	// Default: "15s"
+	// +optional
+	EvaluationInterval *monitoringv1.Duration `json:"evaluationInterval,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=StatefulSet;DaemonSet
 type PrometheusAgentMode string
 
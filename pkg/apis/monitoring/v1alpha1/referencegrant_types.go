@@ -0,0 +1,176 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ReferenceGrantsKind   = "ReferenceGrant"
+	ReferenceGrantName    = "referencegrants"
+	ReferenceGrantKindKey = "referencegrant"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="refgrant"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// The `ReferenceGrant` custom resource definition (CRD) allows configuration
+// resources from other namespaces to reference Secrets and ConfigMaps living
+// in the namespace of the `ReferenceGrant` object.
+//
+// By default, the operator only resolves the Secrets and ConfigMaps
+// referenced by a configuration resource (e.g. `ServiceMonitor`) from the
+// resource's namespace. A configuration resource can reference a Secret or a
+// ConfigMap from another namespace by setting the `name` field of the key
+// selector to `<namespace>/<name>`. The reference is valid only if a
+// `ReferenceGrant` object in the referenced namespace allows it, otherwise
+// the configuration resource is rejected.
+//
+// The concept is similar to the `ReferenceGrant` resource of the Gateway API.
+type ReferenceGrant struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the ReferenceGrant.
+	// +required
+	Spec ReferenceGrantSpec `json:"spec"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ReferenceGrant) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ReferenceGrantList is a list of ReferenceGrants.
+// +k8s:openapi-gen=true
+type ReferenceGrantList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ReferenceGrants
+	// +required
+	Items []ReferenceGrant `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ReferenceGrantList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ReferenceGrantSpec defines which resources can reference the Secrets and
+// ConfigMaps of the namespace.
+// +k8s:openapi-gen=true
+type ReferenceGrantSpec struct {
+	// from defines the resources allowed to reference the objects listed in
+	// `to`.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	From []ReferenceGrantFrom `json:"from"`
+
+	// to defines the Secrets and ConfigMaps which can be referenced by the
+	// resources listed in `from`.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// ReferenceGrantFrom identifies the resources allowed to reference objects
+// from another namespace.
+// +k8s:openapi-gen=true
+type ReferenceGrantFrom struct {
+	// kind defines the kind of the referencing resources.
+	//
+	// +required
+	Kind ReferenceGrantFromKind `json:"kind"`
+
+	// namespace defines the namespace of the referencing resources.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantFromKind is the kind of a resource referencing objects from
+// another namespace.
+// +kubebuilder:validation:Enum=ServiceMonitor;PodMonitor;Probe;ScrapeConfig;AlertmanagerConfig
+type ReferenceGrantFromKind string
+
+// ReferenceGrantTo identifies the objects which can be referenced from
+// another namespace.
+// +k8s:openapi-gen=true
+type ReferenceGrantTo struct {
+	// kind defines the kind of the referenced objects.
+	//
+	// +required
+	Kind ReferenceGrantToKind `json:"kind"`
+
+	// name defines the name of the referenced object.
+	// When not defined, all the objects of the given kind in the namespace
+	// can be referenced.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// ReferenceGrantToKind is the kind of an object which can be referenced from
+// another namespace.
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type ReferenceGrantToKind string
+
+const (
+	SecretReferenceGrantToKind    ReferenceGrantToKind = "Secret"
+	ConfigMapReferenceGrantToKind ReferenceGrantToKind = "ConfigMap"
+)
+
+// Allows returns true if the grant allows a resource of the given kind from
+// the given namespace to reference the object.
+func (rg *ReferenceGrant) Allows(fromKind, fromNamespace string, toKind ReferenceGrantToKind, toName string) bool {
+	var fromAllowed bool
+	for _, from := range rg.Spec.From {
+		if string(from.Kind) == fromKind && from.Namespace == fromNamespace {
+			fromAllowed = true
+			break
+		}
+	}
+
+	if !fromAllowed {
+		return false
+	}
+
+	for _, to := range rg.Spec.To {
+		if to.Kind != toKind {
+			continue
+		}
+
+		if to.Name == nil || *to.Name == toName {
+			return true
+		}
+	}
+
+	return false
+}
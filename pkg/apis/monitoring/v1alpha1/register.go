@@ -53,6 +53,22 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PrometheusAgentList{},
 		&ScrapeConfig{},
 		&ScrapeConfigList{},
+		&ThanosQuerier{},
+		&ThanosQuerierList{},
+		&ThanosCompactor{},
+		&ThanosCompactorList{},
+		&ThanosStore{},
+		&ThanosStoreList{},
+		&MonitoringQuota{},
+		&MonitoringQuotaList{},
+		&ScrapeClassDefinition{},
+		&ScrapeClassDefinitionList{},
+		&ReferenceGrant{},
+		&ReferenceGrantList{},
+		&AlertmanagerSilence{},
+		&AlertmanagerSilenceList{},
+		&ClusterAlertmanagerConfig{},
+		&ClusterAlertmanagerConfigList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,240 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ScrapeClassDefinitionsKind   = "ScrapeClassDefinition"
+	ScrapeClassDefinitionName    = "scrapeclassdefinitions"
+	ScrapeClassDefinitionKindKey = "scrapeclassdefinition"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="scdef"
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.default"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+
+// The `ScrapeClassDefinition` custom resource definition (CRD) defines a
+// scrape class outside of the `Prometheus` and `PrometheusAgent` resources.
+//
+// Prometheus and PrometheusAgent objects select `ScrapeClassDefinition`
+// objects with the `scrapeClassSelector` and `scrapeClassNamespaceSelector`
+// fields. The name of the scrape class is the name of the object.
+//
+// A `ScrapeClassDefinition` object living in the same namespace as the Prometheus
+// object applies to the scrape objects from all namespaces, like the scrape
+// classes defined in the Prometheus object. Otherwise it only applies to the
+// scrape objects from the same namespace.
+type ScrapeClassDefinition struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the ScrapeClassDefinition.
+	// +required
+	Spec ScrapeClassDefinitionSpec `json:"spec"`
+	// status defines the status subresource. It is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the ScrapeClassDefinition. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status v1.ConfigResourceStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ScrapeClassDefinition) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+func (l *ScrapeClassDefinition) Bindings() []v1.WorkloadBinding {
+	return l.Status.Bindings
+}
+
+// ScrapeClassDefinitionList is a list of ScrapeClassDefinitions.
+// +k8s:openapi-gen=true
+type ScrapeClassDefinitionList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ScrapeClassDefinitions
+	// +required
+	Items []ScrapeClassDefinition `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ScrapeClassDefinitionList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// ScrapeClassDefinitionSpec defines the settings of a scrape class. The fields
+// have the same semantics as the scrape classes defined in the Prometheus
+// resource.
+// +k8s:openapi-gen=true
+type ScrapeClassDefinitionSpec struct {
+	// default defines that the scrape class applies to all scrape objects
+	// that don't configure an explicit scrape class name.
+	//
+	// For a `ScrapeClassDefinition` object in the same namespace as the Prometheus
+	// object, it conflicts with the default scrape class of the Prometheus
+	// object (if any). Otherwise, it takes precedence over the default
+	// scrape class of the Prometheus object for the scrape objects in the
+	// same namespace.
+	//
+	// +optional
+	Default *bool `json:"default,omitempty"`
+
+	// fallbackScrapeProtocol defines the protocol to use if a scrape returns blank, unparseable, or otherwise invalid Content-Type.
+	// It will only apply if the scrape resource doesn't specify any FallbackScrapeProtocol
+	//
+	// It requires Prometheus >= v3.0.0.
+	// +optional
+	FallbackScrapeProtocol *v1.ScrapeProtocol `json:"fallbackScrapeProtocol,omitempty"`
+
+	// tlsConfig defines the TLS settings to use for the scrape. When the
+	// scrape objects define their own CA, certificate and/or key, they take
+	// precedence over the corresponding scrape class fields.
+	//
+	// For now only the `caFile`, `certFile` and `keyFile` fields are supported.
+	//
+	// +optional
+	TLSConfig *v1.TLSConfig `json:"tlsConfig,omitempty"`
+
+	// authorization section for the ScrapeClass.
+	// It will only apply if the scrape resource doesn't specify any Authorization.
+	// +optional
+	Authorization *v1.Authorization `json:"authorization,omitempty"`
+
+	// relabelings defines the relabeling rules to apply to all scrape targets.
+	//
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	//
+	// +optional
+	Relabelings []v1.RelabelConfig `json:"relabelings,omitempty"`
+
+	// metricRelabelings defines the relabeling rules to apply to all samples before ingestion.
+	//
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#metric_relabel_configs
+	//
+	// +optional
+	MetricRelabelings []v1.RelabelConfig `json:"metricRelabelings,omitempty"`
+
+	// attachMetadata defines additional metadata to the discovered targets.
+	// When the scrape object defines its own configuration, it takes
+	// precedence over the scrape class configuration.
+	//
+	// +optional
+	AttachMetadata *v1.AttachMetadata `json:"attachMetadata,omitempty"`
+
+	// interval defines the interval at which the targets are scraped.
+	// It applies only if the scrape resource doesn't specify any interval.
+	//
+	// +optional
+	Interval *v1.Duration `json:"interval,omitempty"`
+
+	// scrapeTimeout defines the timeout after which the scrape is ended.
+	// It applies only if the scrape resource doesn't specify any scrape
+	// timeout.
+	//
+	// +optional
+	ScrapeTimeout *v1.Duration `json:"scrapeTimeout,omitempty"`
+
+	// sampleLimit defines a per-scrape limit on the number of scraped samples
+	// that will be accepted.
+	// It applies only if the scrape resource doesn't specify any sample limit.
+	//
+	// +optional
+	SampleLimit *uint64 `json:"sampleLimit,omitempty"`
+
+	// targetLimit defines a limit on the number of scraped targets that will
+	// be accepted.
+	// It applies only if the scrape resource doesn't specify any target limit.
+	//
+	// +optional
+	TargetLimit *uint64 `json:"targetLimit,omitempty"`
+
+	// labelLimit defines the per-scrape limit on the number of labels that
+	// will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label limit.
+	//
+	// +optional
+	LabelLimit *uint64 `json:"labelLimit,omitempty"`
+
+	// labelNameLengthLimit defines the per-scrape limit on the length of
+	// labels name that will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label name
+	// length limit.
+	//
+	// +optional
+	LabelNameLengthLimit *uint64 `json:"labelNameLengthLimit,omitempty"`
+
+	// labelValueLengthLimit defines the per-scrape limit on the length of
+	// labels value that will be accepted for a sample.
+	// It applies only if the scrape resource doesn't specify any label value
+	// length limit.
+	//
+	// +optional
+	LabelValueLengthLimit *uint64 `json:"labelValueLengthLimit,omitempty"`
+
+	// nativeHistogramConfig defines the native histogram settings. Each
+	// setting applies only if the scrape resource doesn't specify it.
+	//
+	// +optional
+	NativeHistogramConfig *v1.NativeHistogramConfig `json:"nativeHistogramConfig,omitempty"`
+
+	// proxyConfig defines the HTTP proxy settings to use for the scrape.
+	// It applies only if the scrape resource doesn't specify any proxy
+	// settings.
+	//
+	// For now the `proxyConnectHeader` field isn't supported.
+	//
+	// +optional
+	ProxyConfig *v1.ProxyConfig `json:"proxyConfig,omitempty"`
+}
+
+// ToScrapeClass returns the scrape class defined by the object.
+func (l *ScrapeClassDefinition) ToScrapeClass() v1.ScrapeClass {
+	return v1.ScrapeClass{
+		Name:                   l.Name,
+		Default:                l.Spec.Default,
+		FallbackScrapeProtocol: l.Spec.FallbackScrapeProtocol,
+		TLSConfig:              l.Spec.TLSConfig,
+		Authorization:          l.Spec.Authorization,
+		Relabelings:            l.Spec.Relabelings,
+		MetricRelabelings:      l.Spec.MetricRelabelings,
+		AttachMetadata:         l.Spec.AttachMetadata,
+		Interval:               l.Spec.Interval,
+		ScrapeTimeout:          l.Spec.ScrapeTimeout,
+		SampleLimit:            l.Spec.SampleLimit,
+		TargetLimit:            l.Spec.TargetLimit,
+		LabelLimit:             l.Spec.LabelLimit,
+		LabelNameLengthLimit:   l.Spec.LabelNameLengthLimit,
+		LabelValueLengthLimit:  l.Spec.LabelValueLengthLimit,
+		NativeHistogramConfig:  l.Spec.NativeHistogramConfig,
+		ProxyConfig:            l.Spec.ProxyConfig,
+	}
+}
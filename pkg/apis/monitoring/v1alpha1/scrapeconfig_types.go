@@ -242,6 +242,24 @@ type ScrapeConfigSpec struct {
 	// ionosSDConfigs defines a list of IONOS service discovery configurations.
 	// +optional
 	IonosSDConfigs []IonosSDConfig `json:"ionosSDConfigs,omitempty"`
+	// marathonSDConfigs defines a list of Marathon service discovery configurations.
+	// +optional
+	MarathonSDConfigs []MarathonSDConfig `json:"marathonSDConfigs,omitempty"`
+	// tritonSDConfigs defines a list of Triton service discovery configurations.
+	// +optional
+	TritonSDConfigs []TritonSDConfig `json:"tritonSDConfigs,omitempty"`
+	// uyuniSDConfigs defines a list of Uyuni service discovery configurations.
+	// +optional
+	UyuniSDConfigs []UyuniSDConfig `json:"uyuniSDConfigs,omitempty"`
+	// vultrSDConfigs defines a list of Vultr service discovery configurations.
+	// +optional
+	VultrSDConfigs []VultrSDConfig `json:"vultrSDConfigs,omitempty"`
+	// serversetSDConfigs defines a list of Zookeeper Serverset service discovery configurations.
+	// +optional
+	ServersetSDConfigs []ServersetSDConfig `json:"serversetSDConfigs,omitempty"`
+	// nerveSDConfigs defines a list of Zookeeper Nerve service discovery configurations.
+	// +optional
+	NerveSDConfigs []NerveSDConfig `json:"nerveSDConfigs,omitempty"`
 	// relabelings defines how to rewrite the target's labels before scraping.
 	// Prometheus Operator automatically adds relabelings for a few standard Kubernetes fields.
 	// The original scrape job's name is available via the `__tmp_prometheus_job_name` label.
@@ -1523,3 +1541,237 @@ type IonosSDConfig struct {
 	// +optional
 	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
 }
+
+// MarathonSDConfig configurations allow retrieving scrape targets using the Marathon REST API.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#marathon_sd_config
+// +k8s:openapi-gen=true
+type MarathonSDConfig struct {
+	// servers defines the list of URLs to be used to contact Marathon servers.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^http(s)?://.+$"
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// refreshInterval defines the time after which the provided names are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authToken defines the secret containing the token used for the
+	// authentication with Marathon (DC/OS API token).
+	// Cannot be set at the same time as `basicAuth` or `authorization`.
+	// +optional
+	AuthToken *corev1.SecretKeySelector `json:"authToken,omitempty"`
+	// basicAuth defines information to use on every scrape request.
+	// Cannot be set at the same time as `authToken`, `authorization`, or `oauth2`.
+	// +optional
+	BasicAuth *v1.BasicAuth `json:"basicAuth,omitempty"`
+	// authorization defines the header configuration to authenticate against the Marathon API.
+	// Cannot be set at the same time as `authToken`, `basicAuth`, or `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// oauth2 defines the optional OAuth 2.0 configuration to authenticate against the Marathon API.
+	// Cannot be set at the same time as `authorization`, or `basicAuth`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// ProxyConfig allows customizing the proxy behaviour for this scrape config.
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Marathon API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// Role of the Triton targets to retrieve. Must be `Container` or `CN`.
+// +kubebuilder:validation:Enum=Container;CN
+type TritonRole string
+
+const (
+	TritonRoleContainer TritonRole = "Container"
+	TritonRoleCN        TritonRole = "CN"
+)
+
+// TritonSDConfig configurations allow retrieving scrape targets from Container Monitor discovery endpoints.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#triton_sd_config
+// +k8s:openapi-gen=true
+type TritonSDConfig struct {
+	// account defines the account used to discover new targets.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Account string `json:"account"`
+	// role defines the type of targets to discover. `Container` discovers
+	// the virtual machines running on Triton, `CN` discovers the compute
+	// nodes making up the Triton infrastructure.
+	// If not set, Prometheus uses its default value (`Container`).
+	// +optional
+	Role *TritonRole `json:"role,omitempty"`
+	// dnsSuffix defines the DNS suffix which should be applied to the target.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	DNSSuffix string `json:"dnsSuffix"`
+	// endpoint defines the Triton discovery endpoint (e.g. `cmon.us-east-3b.triton.zone`).
+	// This is often the same value as `dnsSuffix`.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Endpoint string `json:"endpoint"`
+	// groups defines a list of groups for which targets are retrieved, only
+	// supported when `role` is `Container`. If omitted all containers owned by
+	// the requesting account are scraped.
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// port defines the port to use for discovery and metrics scraping.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the provided names are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// version defines the Triton discovery API version.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Version *int32 `json:"version,omitempty"`
+	// tlsConfig defines the TLS configuration to connect to the Triton discovery endpoint.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// UyuniSDConfig configurations allow retrieving scrape targets from managed systems via Uyuni API.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#uyuni_sd_config
+// +k8s:openapi-gen=true
+type UyuniSDConfig struct {
+	// server defines the URL to connect to the Uyuni server.
+	// +kubebuilder:validation:Pattern:="^http(s)?://.+$"
+	// +required
+	Server string `json:"server"`
+	// username defines the username used to authenticate against the Uyuni API.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Username string `json:"username"`
+	// password defines the secret key containing the password used to
+	// authenticate against the Uyuni API.
+	// +required
+	Password corev1.SecretKeySelector `json:"password"`
+	// entitlement defines the entitlement string to filter eligible systems.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Entitlement *string `json:"entitlement,omitempty"`
+	// separator defines the string by which Uyuni group names are joined into the groups label.
+	// If not set, Prometheus uses its default value.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+	// refreshInterval defines the time after which the provided names are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// authorization defines the header configuration to authenticate against the Uyuni API.
+	// Cannot be set at the same time as `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// oauth2 defines the optional OAuth 2.0 configuration to authenticate against the Uyuni API.
+	// Cannot be set at the same time as `authorization`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// ProxyConfig allows customizing the proxy behaviour for this scrape config.
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Uyuni API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// VultrSDConfig configurations allow retrieving scrape targets from Vultr instances.
+// This service discovery uses the main IPv4 address by default, which can be changed with relabeling.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#vultr_sd_config
+// +k8s:openapi-gen=true
+type VultrSDConfig struct {
+	// authorization defines the header configuration to authenticate against the Vultr API.
+	// Cannot be set at the same time as `oauth2`.
+	// +optional
+	Authorization *v1.SafeAuthorization `json:"authorization,omitempty"`
+	// oauth2 defines the optional OAuth 2.0 configuration to authenticate against the Vultr API.
+	// Cannot be set at the same time as `authorization`.
+	// +optional
+	OAuth2 *v1.OAuth2 `json:"oauth2,omitempty"`
+	// port defines the port to scrape metrics from.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// refreshInterval defines the time after which the instances are refreshed.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	RefreshInterval *v1.Duration `json:"refreshInterval,omitempty"`
+	// ProxyConfig allows customizing the proxy behaviour for this scrape config.
+	// +optional
+	v1.ProxyConfig `json:",inline"`
+	// tlsConfig defines the TLS configuration to connect to the Vultr API.
+	// +optional
+	TLSConfig *v1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+	// followRedirects defines whether HTTP requests follow HTTP 3xx redirects.
+	// +optional
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+	// enableHTTP2 defines whether to enable HTTP2.
+	// +optional
+	EnableHTTP2 *bool `json:"enableHTTP2,omitempty"`
+}
+
+// ServersetSDConfig configurations allow retrieving scrape targets from Serversets which are stored in Zookeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#serverset_sd_config
+// +k8s:openapi-gen=true
+type ServersetSDConfig struct {
+	// servers defines the list of Zookeeper servers (`host:port`).
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// paths defines the list of Zookeeper paths where the serversets are stored.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^/.*$"
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// timeout defines the Zookeeper session timeout.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// NerveSDConfig configurations allow retrieving scrape targets from Nerve services which are stored in Zookeeper.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#nerve_sd_config
+// +k8s:openapi-gen=true
+type NerveSDConfig struct {
+	// servers defines the list of Zookeeper servers (`host:port`).
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +required
+	Servers []string `json:"servers"`
+	// paths defines the list of Zookeeper paths where the Nerve services are registered.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern:="^/.*$"
+	// +listType=set
+	// +required
+	Paths []string `json:"paths"`
+	// timeout defines the Zookeeper session timeout.
+	// If not set, Prometheus uses its default value.
+	// +optional
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
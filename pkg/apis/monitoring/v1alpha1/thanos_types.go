@@ -0,0 +1,185 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// CommonThanosObjectStorageFields are the options shared by the Thanos
+// components reading blocks from object storage (`ThanosCompactor` and
+// `ThanosStore`).
+// +k8s:deepcopy-gen=true
+type CommonThanosObjectStorageFields struct {
+	// version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// image defines the Thanos container image URL.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// imagePullPolicy defines the image pull policy for the Thanos container.
+	// See https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy for more details.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// imagePullSecrets defines an optional list of references to secrets in the same namespace
+	// to use for pulling thanos images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// paused defines when the deployment is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// podMetadata defines labels and annotations which are propagated to the pods.
+	//
+	// The "app.kubernetes.io/name", "app.kubernetes.io/managed-by" and
+	// "app.kubernetes.io/instance" labels and the
+	// "kubectl.kubernetes.io/default-container" annotation are reserved and
+	// cannot be overridden.
+	// +optional
+	PodMetadata *monitoringv1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+
+	// objectStorageConfig defines the secret key holding the object storage
+	// configuration. It uses the same format as the Thanos sidecar's
+	// `objectStorageConfig` field of the Prometheus resource.
+	//
+	// More info: https://thanos.io/tip/thanos/storage.md/
+	//
+	// `objectStorageConfigFile` takes precedence over this field.
+	// +optional
+	ObjectStorageConfig *v1.SecretKeySelector `json:"objectStorageConfig,omitempty"`
+	// objectStorageConfigFile defines the path of the object storage configuration file.
+	//
+	// More info: https://thanos.io/tip/thanos/storage.md/
+	//
+	// This field takes precedence over `objectStorageConfig`.
+	// +optional
+	ObjectStorageConfigFile *string `json:"objectStorageConfigFile,omitempty"`
+
+	// storage defines the specification of how storage shall be used for the
+	// local data directory.
+	// +optional
+	Storage *monitoringv1.StorageSpec `json:"storage,omitempty"`
+
+	// volumes defines additional volumes on the output StatefulSet definition.
+	// +optional
+	Volumes []v1.Volume `json:"volumes,omitempty"`
+	// volumeMounts defines additional VolumeMounts for the Thanos container.
+	// +optional
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// nodeSelector defines which Nodes the Pods are scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// resources defines the resource requirements for the Thanos container.
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// affinity defines the pod's scheduling constraints.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations defines the pod's tolerations.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// topologySpreadConstraints defines the pod's topology spread constraints.
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// securityContext defines the pod-level security attributes and common container settings.
+	// +optional
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// priorityClassName defines the priority class assigned to the Pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// serviceAccountName defines the name of the ServiceAccount to use to run the Pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// logLevel for the Thanos component to be configured with.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// logFormat for the Thanos component to be configured with.
+	// +kubebuilder:validation:Enum="";logfmt;json
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// containers allows injecting additional containers or modifying operator
+	// generated containers. Containers described here modify an operator
+	// generated container if they share the same name and modifications are
+	// done via a strategic merge patch.
+	// Overriding containers is entirely outside the scope of what the maintainers will support and by doing
+	// so, you accept that this behaviour may break at any time without notice.
+	// +optional
+	Containers []v1.Container `json:"containers,omitempty"`
+
+	// additionalArgs defines additional arguments for the Thanos container.
+	// The arguments are passed as-is to the container which may cause issues
+	// if they are invalid or not supported by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) the reconciliation will fail.
+	// +optional
+	AdditionalArgs []monitoringv1.Argument `json:"additionalArgs,omitempty"`
+
+	// terminationGracePeriodSeconds defines the optional duration in seconds the pod needs to terminate gracefully.
+	// Defaults to 120 seconds.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+}
+
+// ThanosWorkloadStatus is the most recent observed status of a Thanos
+// component managed by a StatefulSet. Read-only.
+// +k8s:openapi-gen=true
+type ThanosWorkloadStatus struct {
+	// paused defines whether any actions on the underlying managed objects are
+	// being performed. Only delete actions will be performed.
+	// +optional
+	Paused bool `json:"paused"`
+	// replicas defines the total number of non-terminated pods targeted by this object
+	// (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas"`
+	// updatedReplicas defines the total number of non-terminated pods targeted by this object
+	// that have the desired version spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// availableReplicas defines the total number of available pods (ready for at least minReadySeconds)
+	// targeted by this object.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// unavailableReplicas defines the total number of unavailable pods targeted by this object.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// conditions defines the current state of the object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.Condition `json:"conditions,omitempty"`
+}
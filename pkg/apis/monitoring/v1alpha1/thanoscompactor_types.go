@@ -0,0 +1,132 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosCompactorKind    = "ThanosCompactor"
+	ThanosCompactorName    = "thanoscompactors"
+	ThanosCompactorKindKey = "thanoscompactor"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="compactor"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Compact"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosCompactor` custom resource definition (CRD) defines a desired [Thanos Compact](https://thanos.io/tip/components/compact.md/) setup to run in a Kubernetes cluster.
+//
+// The compactor applies the compaction, downsampling and retention
+// procedures to the blocks uploaded to object storage by the Thanos sidecars.
+// Only one instance should run against a given bucket, the operator always
+// deploys a single replica.
+type ThanosCompactor struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosCompactor. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosCompactorSpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosCompactor. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosWorkloadStatus `json:"status,omitempty"`
+}
+
+// ThanosCompactorList is a list of ThanosCompactors.
+// +k8s:openapi-gen=true
+type ThanosCompactorList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosCompactors
+	// +required
+	Items []ThanosCompactor `json:"items"`
+}
+
+// ThanosCompactorSpec is a specification of the desired behavior of the ThanosCompactor. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosCompactorSpec struct {
+	CommonThanosObjectStorageFields `json:",inline"`
+
+	// retentionResolutionRaw defines how long to retain raw samples in the
+	// bucket. Setting this to 0d retains samples of this resolution forever.
+	// +optional
+	RetentionResolutionRaw *monitoringv1.Duration `json:"retentionResolutionRaw,omitempty"`
+	// retentionResolution5m defines how long to retain samples of resolution 1
+	// (5 minutes) in the bucket. Setting this to 0d retains samples of this
+	// resolution forever.
+	// +optional
+	RetentionResolution5m *monitoringv1.Duration `json:"retentionResolution5m,omitempty"`
+	// retentionResolution1h defines how long to retain samples of resolution 2
+	// (1 hour) in the bucket. Setting this to 0d retains samples of this
+	// resolution forever.
+	// +optional
+	RetentionResolution1h *monitoringv1.Duration `json:"retentionResolution1h,omitempty"`
+
+	// downsamplingDisabled disables the downsampling of the blocks.
+	// +optional
+	DownsamplingDisabled *bool `json:"downsamplingDisabled,omitempty"`
+}
+
+// ExpectedReplicas always returns 1 because the compactor is a singleton.
+func (tc *ThanosCompactor) ExpectedReplicas() int { return 1 }
+
+func (tc *ThanosCompactor) GetAvailableReplicas() int               { return int(tc.Status.AvailableReplicas) }
+func (tc *ThanosCompactor) GetUpdatedReplicas() int                 { return int(tc.Status.UpdatedReplicas) }
+func (tc *ThanosCompactor) GetConditions() []monitoringv1.Condition { return tc.Status.Conditions }
+
+func (tc *ThanosCompactor) SetReplicas(i int)            { tc.Status.Replicas = int32(i) }
+func (tc *ThanosCompactor) SetUpdatedReplicas(i int)     { tc.Status.UpdatedReplicas = int32(i) }
+func (tc *ThanosCompactor) SetAvailableReplicas(i int)   { tc.Status.AvailableReplicas = int32(i) }
+func (tc *ThanosCompactor) SetUnavailableReplicas(i int) { tc.Status.UnavailableReplicas = int32(i) }
+
+// ObjectStorageFields returns the fields shared with the other Thanos
+// components reading blocks from object storage.
+func (tc *ThanosCompactor) ObjectStorageFields() *CommonThanosObjectStorageFields {
+	return &tc.Spec.CommonThanosObjectStorageFields
+}
+
+// WorkloadStatus returns the status of the ThanosCompactor.
+func (tc *ThanosCompactor) WorkloadStatus() *ThanosWorkloadStatus { return &tc.Status }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactor) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosCompactorList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
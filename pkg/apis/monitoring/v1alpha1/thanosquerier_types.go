@@ -0,0 +1,317 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosQuerierKind    = "ThanosQuerier"
+	ThanosQuerierName    = "thanosqueriers"
+	ThanosQuerierKindKey = "thanosquerier"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="querier"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Query"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosQuerier` custom resource definition (CRD) defines a desired [Thanos Query](https://thanos.io/tip/components/query.md/) setup to run in a Kubernetes cluster.
+//
+// The resource defines via label and namespace selectors which `Prometheus`
+// and `ThanosRuler` objects should be used as StoreAPI endpoints by the
+// deployed Thanos Query instances. For `Prometheus` objects, only the
+// instances with the Thanos sidecar enabled are considered.
+type ThanosQuerier struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosQuerier. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosQuerierSpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosQuerier. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosQuerierStatus `json:"status,omitempty"`
+}
+
+// ThanosQuerierList is a list of ThanosQueriers.
+// +k8s:openapi-gen=true
+type ThanosQuerierList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosQueriers
+	// +required
+	Items []ThanosQuerier `json:"items"`
+}
+
+// ThanosQuerierSpec is a specification of the desired behavior of the ThanosQuerier. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosQuerierSpec struct {
+	// version of Thanos to be deployed.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// podMetadata defines labels and annotations which are propagated to the ThanosQuerier pods.
+	//
+	// The following items are reserved and cannot be overridden:
+	// * "app.kubernetes.io/name" label, set to "thanos-querier".
+	// * "app.kubernetes.io/managed-by" label, set to "prometheus-operator".
+	// * "app.kubernetes.io/instance" label, set to the name of the ThanosQuerier instance.
+	// * "thanos-querier" label, set to the name of the ThanosQuerier instance.
+	// * "kubectl.kubernetes.io/default-container" annotation, set to "thanos-querier".
+	// +optional
+	PodMetadata *monitoringv1.EmbeddedObjectMetadata `json:"podMetadata,omitempty"`
+
+	// image defines the Thanos container image URL.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// imagePullPolicy defines the image pull policy for the 'thanos-querier' container.
+	// See https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy for more details.
+	// +kubebuilder:validation:Enum="";Always;Never;IfNotPresent
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// imagePullSecrets defines an optional list of references to secrets in the same namespace
+	// to use for pulling thanos images from registries
+	// see http://kubernetes.io/docs/user-guide/images#specifying-imagepullsecrets-on-a-pod
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// paused defines when a ThanosQuerier deployment is paused, no actions except for deletion
+	// will be performed on the underlying objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// replicas defines the number of Thanos Query instances to deploy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// nodeSelector defines which Nodes the Pods are scheduled on.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// resources defines the resource requirements for single Pods.
+	// If not provided, no requests/limits will be set
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// affinity defines when specified, the pod's scheduling constraints.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations defines when specified, the pod's tolerations.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// topologySpreadConstraints defines the pod's topology spread constraints.
+	// +optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// securityContext defines the pod-level security attributes and common container settings.
+	// This defaults to the default PodSecurityContext.
+	// +optional
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// priorityClassName defines the priority class assigned to the Pods
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// serviceAccountName defines the name of the ServiceAccount to use to run the
+	// Thanos Query Pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// prometheusSelector defines the Prometheus objects to be used as
+	// StoreAPI endpoints. Only the Prometheus objects with the Thanos sidecar
+	// enabled are considered.
+	//
+	// The endpoint is resolved from the governing service of the Prometheus
+	// object which must expose a port named `grpc`.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// +optional
+	PrometheusSelector *metav1.LabelSelector `json:"prometheusSelector,omitempty"`
+	// prometheusNamespaceSelector defines the namespaces to be selected for
+	// Prometheus discovery. If unspecified, only the same namespace as the
+	// ThanosQuerier object is in is used.
+	//
+	// +optional
+	PrometheusNamespaceSelector *metav1.LabelSelector `json:"prometheusNamespaceSelector,omitempty"`
+
+	// thanosRulerSelector defines the ThanosRuler objects to be used as
+	// StoreAPI endpoints.
+	//
+	// The endpoint is resolved from the governing service of the ThanosRuler
+	// object which must expose a port named `grpc`.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// +optional
+	ThanosRulerSelector *metav1.LabelSelector `json:"thanosRulerSelector,omitempty"`
+	// thanosRulerNamespaceSelector defines the namespaces to be selected for
+	// ThanosRuler discovery. If unspecified, only the same namespace as the
+	// ThanosQuerier object is in is used.
+	//
+	// +optional
+	ThanosRulerNamespaceSelector *metav1.LabelSelector `json:"thanosRulerNamespaceSelector,omitempty"`
+
+	// endpoints defines additional StoreAPI endpoints which aren't discovered
+	// from the Prometheus and ThanosRuler objects.
+	//
+	// The format is defined at https://thanos.io/tip/components/query.md/#store-api
+	// (e.g. `thanos-store.monitoring.svc:10901` or
+	// `dnssrv+_grpc._tcp.thanos-store.monitoring.svc`).
+	//
+	// +listType=set
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// replicaLabels defines the labels used to deduplicate series from
+	// replicated StoreAPI endpoints.
+	//
+	// If unspecified, the operator uses `prometheus_replica` and
+	// `thanos_ruler_replica` which are the replica labels set by the operator
+	// for Prometheus and ThanosRuler.
+	//
+	// +listType=set
+	// +optional
+	ReplicaLabels []string `json:"replicaLabels,omitempty"`
+
+	// logLevel for Thanos Query to be configured with.
+	// +kubebuilder:validation:Enum="";debug;info;warn;error
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+	// logFormat for Thanos Query to be configured with.
+	// +kubebuilder:validation:Enum="";logfmt;json
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// containers allows injecting additional containers or modifying operator generated
+	// containers. This can be used to allow adding an authentication proxy to a ThanosQuerier pod or
+	// to change the behavior of an operator generated container. Containers described here modify
+	// an operator generated container if they share the same name and modifications are done via a
+	// strategic merge patch. The current container name is: `thanos-querier`.
+	// Overriding containers is entirely outside the scope of what the maintainers will support and by doing
+	// so, you accept that this behaviour may break at any time without notice.
+	// +optional
+	Containers []v1.Container `json:"containers,omitempty"`
+
+	// additionalArgs defines how to add additional arguments for the Thanos Query container.
+	// It is intended for e.g. activating hidden flags which are not supported by
+	// the dedicated configuration options yet. The arguments are passed as-is to the
+	// Thanos Query container which may cause issues if they are invalid or not supported
+	// by the given Thanos version.
+	// In case of an argument conflict (e.g. an argument which is already set by the
+	// operator itself) or when providing an invalid argument the reconciliation will
+	// fail and an error will be logged.
+	// +optional
+	AdditionalArgs []monitoringv1.Argument `json:"additionalArgs,omitempty"`
+
+	// minReadySeconds defines the minimum number of seconds for which a newly created pod should be ready
+	// without any of its container crashing for it to be considered available.
+	//
+	// If unset, pods will be considered available as soon as they are ready.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+}
+
+// ThanosQuerierStatus is the most recent observed status of the ThanosQuerier. Read-only.
+// More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosQuerierStatus struct {
+	// paused defines whether any actions on the underlying managed objects are
+	// being performed. Only delete actions will be performed.
+	// +optional
+	Paused bool `json:"paused"`
+	// replicas defines the total number of non-terminated pods targeted by this ThanosQuerier deployment
+	// (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas"`
+	// updatedReplicas defines the total number of non-terminated pods targeted by this ThanosQuerier deployment
+	// that have the desired version spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// availableReplicas defines the total number of available pods (ready for at least minReadySeconds)
+	// targeted by this ThanosQuerier deployment.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+	// unavailableReplicas defines the total number of unavailable pods targeted by this ThanosQuerier deployment.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// endpoints defines the StoreAPI endpoints configured for the ThanosQuerier.
+	// +listType=set
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+	// conditions defines the current state of the ThanosQuerier object.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []monitoringv1.Condition `json:"conditions,omitempty"`
+}
+
+func (tq *ThanosQuerier) ExpectedReplicas() int {
+	if tq.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*tq.Spec.Replicas)
+}
+
+func (tq *ThanosQuerier) GetAvailableReplicas() int               { return int(tq.Status.AvailableReplicas) }
+func (tq *ThanosQuerier) GetUpdatedReplicas() int                 { return int(tq.Status.UpdatedReplicas) }
+func (tq *ThanosQuerier) GetConditions() []monitoringv1.Condition { return tq.Status.Conditions }
+
+func (tq *ThanosQuerier) SetReplicas(i int)            { tq.Status.Replicas = int32(i) }
+func (tq *ThanosQuerier) SetUpdatedReplicas(i int)     { tq.Status.UpdatedReplicas = int32(i) }
+func (tq *ThanosQuerier) SetAvailableReplicas(i int)   { tq.Status.AvailableReplicas = int32(i) }
+func (tq *ThanosQuerier) SetUnavailableReplicas(i int) { tq.Status.UnavailableReplicas = int32(i) }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQuerier) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosQuerierList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
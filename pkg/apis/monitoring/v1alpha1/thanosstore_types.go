@@ -0,0 +1,132 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	ThanosStoreKind    = "ThanosStore"
+	ThanosStoreName    = "thanosstores"
+	ThanosStoreKindKey = "thanosstore"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="store"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Store"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
+// +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
+// +kubebuilder:subresource:status
+
+// The `ThanosStore` custom resource definition (CRD) defines a desired [Thanos Store Gateway](https://thanos.io/tip/components/store.md/) setup to run in a Kubernetes cluster.
+//
+// The store gateway exposes the blocks in object storage via the StoreAPI
+// on the `grpc` port of the `thanos-store-operated` governing service.
+type ThanosStore struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the desired behavior of the ThanosStore. More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +required
+	Spec ThanosStoreSpec `json:"spec"`
+	// status defines the most recent observed status of the ThanosStore. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status ThanosWorkloadStatus `json:"status,omitempty"`
+}
+
+// ThanosStoreList is a list of ThanosStores.
+// +k8s:openapi-gen=true
+type ThanosStoreList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of ThanosStores
+	// +required
+	Items []ThanosStore `json:"items"`
+}
+
+// ThanosStoreSpec is a specification of the desired behavior of the ThanosStore. More info:
+// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+// +k8s:openapi-gen=true
+type ThanosStoreSpec struct {
+	CommonThanosObjectStorageFields `json:",inline"`
+
+	// replicas defines the number of Thanos Store instances to deploy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// minTime defines the start of the time range served by the store
+	// gateway. The value can be an RFC3339 timestamp or a duration relative to
+	// the current time (e.g. `-2w`).
+	// +optional
+	MinTime *string `json:"minTime,omitempty"`
+	// maxTime defines the end of the time range served by the store gateway.
+	// The value can be an RFC3339 timestamp or a duration relative to the
+	// current time (e.g. `-2h`).
+	// +optional
+	MaxTime *string `json:"maxTime,omitempty"`
+}
+
+func (ts *ThanosStore) ExpectedReplicas() int {
+	if ts.Spec.Replicas == nil {
+		return 1
+	}
+	return int(*ts.Spec.Replicas)
+}
+
+func (ts *ThanosStore) GetAvailableReplicas() int               { return int(ts.Status.AvailableReplicas) }
+func (ts *ThanosStore) GetUpdatedReplicas() int                 { return int(ts.Status.UpdatedReplicas) }
+func (ts *ThanosStore) GetConditions() []monitoringv1.Condition { return ts.Status.Conditions }
+
+func (ts *ThanosStore) SetReplicas(i int)            { ts.Status.Replicas = int32(i) }
+func (ts *ThanosStore) SetUpdatedReplicas(i int)     { ts.Status.UpdatedReplicas = int32(i) }
+func (ts *ThanosStore) SetAvailableReplicas(i int)   { ts.Status.AvailableReplicas = int32(i) }
+func (ts *ThanosStore) SetUnavailableReplicas(i int) { ts.Status.UnavailableReplicas = int32(i) }
+
+// ObjectStorageFields returns the fields shared with the other Thanos
+// components reading blocks from object storage.
+func (ts *ThanosStore) ObjectStorageFields() *CommonThanosObjectStorageFields {
+	return &ts.Spec.CommonThanosObjectStorageFields
+}
+
+// WorkloadStatus returns the status of the ThanosStore.
+func (ts *ThanosStore) WorkloadStatus() *ThanosWorkloadStatus { return &ts.Status }
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStore) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *ThanosStoreList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
@@ -19,9 +19,10 @@
 package v1alpha1
 
 import (
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -30,6 +31,7 @@ func (in *AlertmanagerConfig) DeepCopyInto(out *AlertmanagerConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfig.
@@ -95,6 +97,18 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]v1.SecretOrConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -107,6 +121,142 @@ func (in *AlertmanagerConfigSpec) DeepCopy() *AlertmanagerConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilence) DeepCopyInto(out *AlertmanagerSilence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilence.
+func (in *AlertmanagerSilence) DeepCopy() *AlertmanagerSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceBinding) DeepCopyInto(out *AlertmanagerSilenceBinding) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]AlertmanagerSilenceInstance, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.ConfigResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceBinding.
+func (in *AlertmanagerSilenceBinding) DeepCopy() *AlertmanagerSilenceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceInstance) DeepCopyInto(out *AlertmanagerSilenceInstance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceInstance.
+func (in *AlertmanagerSilenceInstance) DeepCopy() *AlertmanagerSilenceInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceList) DeepCopyInto(out *AlertmanagerSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlertmanagerSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceList.
+func (in *AlertmanagerSilenceList) DeepCopy() *AlertmanagerSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceSpec) DeepCopyInto(out *AlertmanagerSilenceSpec) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]Matcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceSpec.
+func (in *AlertmanagerSilenceSpec) DeepCopy() *AlertmanagerSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertmanagerSilenceStatus) DeepCopyInto(out *AlertmanagerSilenceStatus) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]AlertmanagerSilenceBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerSilenceStatus.
+func (in *AlertmanagerSilenceStatus) DeepCopy() *AlertmanagerSilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertmanagerSilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AttachMetadata) DeepCopyInto(out *AttachMetadata) {
 	*out = *in
@@ -162,7 +312,7 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -172,17 +322,17 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -198,7 +348,7 @@ func (in *AzureSDConfig) DeepCopyInto(out *AzureSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -213,6 +363,161 @@ func (in *AzureSDConfig) DeepCopy() *AzureSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertmanagerConfig) DeepCopyInto(out *ClusterAlertmanagerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertmanagerConfig.
+func (in *ClusterAlertmanagerConfig) DeepCopy() *ClusterAlertmanagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertmanagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlertmanagerConfigList) DeepCopyInto(out *ClusterAlertmanagerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlertmanagerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertmanagerConfigList.
+func (in *ClusterAlertmanagerConfigList) DeepCopy() *ClusterAlertmanagerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlertmanagerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonThanosObjectStorageFields) DeepCopyInto(out *CommonThanosObjectStorageFields) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(v1.EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectStorageConfig != nil {
+		in, out := &in.ObjectStorageConfig, &out.ObjectStorageConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectStorageConfigFile != nil {
+		in, out := &in.ObjectStorageConfigFile, &out.ObjectStorageConfigFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(v1.StorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]v1.Argument, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonThanosObjectStorageFields.
+func (in *CommonThanosObjectStorageFields) DeepCopy() *CommonThanosObjectStorageFields {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonThanosObjectStorageFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	*out = *in
@@ -243,7 +548,7 @@ func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	}
 	if in.Scheme != nil {
 		in, out := &in.Scheme, &out.Scheme
-		*out = new(monitoringv1.Scheme)
+		*out = new(v1.Scheme)
 		**out = **in
 	}
 	if in.Services != nil {
@@ -280,22 +585,22 @@ func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -311,7 +616,7 @@ func (in *ConsulSDConfig) DeepCopyInto(out *ConsulSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -336,7 +641,7 @@ func (in *DNSSDConfig) DeepCopyInto(out *DNSSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Type != nil {
@@ -381,12 +686,12 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	*out = *in
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -402,7 +707,7 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -412,7 +717,7 @@ func (in *DigitalOceanSDConfig) DeepCopyInto(out *DigitalOceanSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -484,7 +789,7 @@ func (in *DockerSDConfig) DeepCopyInto(out *DockerSDConfig) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -511,22 +816,22 @@ func (in *DockerSDConfig) DeepCopyInto(out *DockerSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -568,28 +873,28 @@ func (in *DockerSwarmSDConfig) DeepCopyInto(out *DockerSwarmSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -644,7 +949,7 @@ func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Filters != nil {
@@ -657,7 +962,7 @@ func (in *EC2SDConfig) DeepCopyInto(out *EC2SDConfig) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -722,7 +1027,7 @@ func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -742,22 +1047,22 @@ func (in *EurekaSDConfig) DeepCopyInto(out *EurekaSDConfig) {
 	*out = *in
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -773,7 +1078,7 @@ func (in *EurekaSDConfig) DeepCopyInto(out *EurekaSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -798,7 +1103,7 @@ func (in *FileSDConfig) DeepCopyInto(out *FileSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -864,7 +1169,7 @@ func (in *GCESDConfig) DeepCopyInto(out *GCESDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -894,17 +1199,17 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	*out = *in
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BearerTokenSecret != nil {
@@ -914,7 +1219,7 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ProxyURLOriginal != nil {
@@ -950,28 +1255,28 @@ func (in *HTTPSDConfig) DeepCopyInto(out *HTTPSDConfig) {
 	*out = *in
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1001,17 +1306,17 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	*out = *in
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1027,7 +1332,7 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Port != nil {
@@ -1037,7 +1342,7 @@ func (in *HetznerSDConfig) DeepCopyInto(out *HetznerSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.LabelSelector != nil {
@@ -1057,6 +1362,51 @@ func (in *HetznerSDConfig) DeepCopy() *HetznerSDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentioConfig) DeepCopyInto(out *IncidentioConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSourceToken != nil {
+		in, out := &in.AlertSourceToken, &out.AlertSourceToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentioConfig.
+func (in *IncidentioConfig) DeepCopy() *IncidentioConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentioConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
@@ -1097,14 +1447,14 @@ func (in *IonosSDConfig) DeepCopyInto(out *IonosSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.Authorization.DeepCopyInto(&out.Authorization)
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1119,7 +1469,7 @@ func (in *IonosSDConfig) DeepCopyInto(out *IonosSDConfig) {
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1135,20 +1485,97 @@ func (in *IonosSDConfig) DeepCopy() *IonosSDConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K8SSelectorConfig) DeepCopyInto(out *K8SSelectorConfig) {
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
 	*out = *in
-	if in.Label != nil {
-		in, out := &in.Label, &out.Label
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
 		*out = new(string)
 		**out = **in
 	}
-	if in.Field != nil {
-		in, out := &in.Field, &out.Field
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-}
-
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8SSelectorConfig) DeepCopyInto(out *K8SSelectorConfig) {
+	*out = *in
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(string)
+		**out = **in
+	}
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SSelectorConfig.
 func (in *K8SSelectorConfig) DeepCopy() *K8SSelectorConfig {
 	if in == nil {
@@ -1201,17 +1628,17 @@ func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1227,7 +1654,7 @@ func (in *KubernetesSDConfig) DeepCopyInto(out *KubernetesSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1252,33 +1679,33 @@ func (in *KumaSDConfig) DeepCopyInto(out *KumaSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.FetchTimeout != nil {
 		in, out := &in.FetchTimeout, &out.FetchTimeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1333,7 +1760,7 @@ func (in *LightSailSDConfig) DeepCopyInto(out *LightSailSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -1343,23 +1770,23 @@ func (in *LightSailSDConfig) DeepCopyInto(out *LightSailSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -1404,17 +1831,17 @@ func (in *LinodeSDConfig) DeepCopyInto(out *LinodeSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1425,7 +1852,7 @@ func (in *LinodeSDConfig) DeepCopyInto(out *LinodeSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EnableHTTP2 != nil {
@@ -1526,6 +1953,67 @@ func (in *MSTeamsV2Config) DeepCopy() *MSTeamsV2Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MarathonSDConfig) DeepCopyInto(out *MarathonSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(v1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarathonSDConfig.
+func (in *MarathonSDConfig) DeepCopy() *MarathonSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MarathonSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Matcher) DeepCopyInto(out *Matcher) {
 	*out = *in
@@ -1541,6 +2029,129 @@ func (in *Matcher) DeepCopy() *Matcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.IconEmoji != nil {
+		in, out := &in.IconEmoji, &out.IconEmoji
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringQuota) DeepCopyInto(out *MonitoringQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringQuota.
+func (in *MonitoringQuota) DeepCopy() *MonitoringQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringQuotaList) DeepCopyInto(out *MonitoringQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MonitoringQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringQuotaList.
+func (in *MonitoringQuotaList) DeepCopy() *MonitoringQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringQuotaSpec) DeepCopyInto(out *MonitoringQuotaSpec) {
+	*out = *in
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLimit != nil {
+		in, out := &in.TargetLimit, &out.TargetLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(QuotaEnforcement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringQuotaSpec.
+func (in *MonitoringQuotaSpec) DeepCopy() *MonitoringQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MuteTimeInterval) DeepCopyInto(out *MuteTimeInterval) {
 	*out = *in
@@ -1588,6 +2199,36 @@ func (in *NamespaceDiscovery) DeepCopy() *NamespaceDiscovery {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NerveSDConfig) DeepCopyInto(out *NerveSDConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NerveSDConfig.
+func (in *NerveSDConfig) DeepCopy() *NerveSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NerveSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	*out = *in
@@ -1603,7 +2244,7 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Region != nil {
@@ -1618,22 +2259,22 @@ func (in *NomadSDConfig) DeepCopyInto(out *NomadSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -1671,7 +2312,7 @@ func (in *OVHCloudSDConfig) DeepCopyInto(out *OVHCloudSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1751,7 +2392,7 @@ func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -1766,7 +2407,7 @@ func (in *OpenStackSDConfig) DeepCopyInto(out *OpenStackSDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1970,37 +2611,108 @@ func (in *PrometheusAgentList) DeepCopy() *PrometheusAgentList {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusAgentSpec) DeepCopyInto(out *PrometheusAgentSpec) {
+func (in *PrometheusAgentRulerSpec) DeepCopyInto(out *PrometheusAgentRulerSpec) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(PrometheusAgentMode)
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.QueryEndpoints != nil {
+		in, out := &in.QueryEndpoints, &out.QueryEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryConfig != nil {
+		in, out := &in.QueryConfig, &out.QueryConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertManagersURL != nil {
+		in, out := &in.AlertManagersURL, &out.AlertManagersURL
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertManagersConfig != nil {
+		in, out := &in.AlertManagersConfig, &out.AlertManagersConfig
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvaluationInterval != nil {
+		in, out := &in.EvaluationInterval, &out.EvaluationInterval
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	in.CommonPrometheusFields.DeepCopyInto(&out.CommonPrometheusFields)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAgentSpec.
-func (in *PrometheusAgentSpec) DeepCopy() *PrometheusAgentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAgentRulerSpec.
+func (in *PrometheusAgentRulerSpec) DeepCopy() *PrometheusAgentRulerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusAgentSpec)
+	out := new(PrometheusAgentRulerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
+func (in *PrometheusAgentSpec) DeepCopyInto(out *PrometheusAgentSpec) {
 	*out = *in
-	if in.IncludeParameters != nil {
-		in, out := &in.IncludeParameters, &out.IncludeParameters
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(PrometheusAgentMode)
+		**out = **in
+	}
+	if in.RuleSelector != nil {
+		in, out := &in.RuleSelector, &out.RuleSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleNamespaceSelector != nil {
+		in, out := &in.RuleNamespaceSelector, &out.RuleNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ruler != nil {
+		in, out := &in.Ruler, &out.Ruler
+		*out = new(PrometheusAgentRulerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CommonPrometheusFields.DeepCopyInto(&out.CommonPrometheusFields)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusAgentSpec.
+func (in *PrometheusAgentSpec) DeepCopy() *PrometheusAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
+	*out = *in
+	if in.IncludeParameters != nil {
+		in, out := &in.IncludeParameters, &out.IncludeParameters
 		*out = new(bool)
 		**out = **in
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Port != nil {
@@ -2010,23 +2722,23 @@ func (in *PuppetDBSDConfig) DeepCopyInto(out *PuppetDBSDConfig) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.FollowRedirects != nil {
@@ -2081,7 +2793,7 @@ func (in *PushoverConfig) DeepCopyInto(out *PushoverConfig) {
 	}
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Device != nil {
@@ -2224,6 +2936,27 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncidentioConfigs != nil {
+		in, out := &in.IncidentioConfigs, &out.IncidentioConfigs
+		*out = make([]IncidentioConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MattermostConfigs != nil {
+		in, out := &in.MattermostConfigs, &out.MattermostConfigs
+		*out = make([]MattermostConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
@@ -2236,6 +2969,110 @@ func (in *Receiver) DeepCopy() *Receiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrant) DeepCopyInto(out *ReferenceGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrant.
+func (in *ReferenceGrant) DeepCopy() *ReferenceGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantFrom) DeepCopyInto(out *ReferenceGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantFrom.
+func (in *ReferenceGrantFrom) DeepCopy() *ReferenceGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantList) DeepCopyInto(out *ReferenceGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReferenceGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantList.
+func (in *ReferenceGrantList) DeepCopy() *ReferenceGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantSpec) DeepCopyInto(out *ReferenceGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ReferenceGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ReferenceGrantTo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantSpec.
+func (in *ReferenceGrantSpec) DeepCopy() *ReferenceGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantTo) DeepCopyInto(out *ReferenceGrantTo) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantTo.
+func (in *ReferenceGrantTo) DeepCopy() *ReferenceGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RocketChatActionConfig) DeepCopyInto(out *RocketChatActionConfig) {
 	*out = *in
@@ -2412,7 +3249,7 @@ func (in *Route) DeepCopyInto(out *Route) {
 	}
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
-		*out = make([]v1.JSON, len(*in))
+		*out = make([]apiextensionsv1.JSON, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2449,7 +3286,7 @@ func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
 	}
 	if in.Sigv4 != nil {
 		in, out := &in.Sigv4, &out.Sigv4
-		*out = new(monitoringv1.Sigv4)
+		*out = new(v1.Sigv4)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Attributes != nil {
@@ -2507,7 +3344,7 @@ func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
 	}
 	if in.RefreshInterval != nil {
 		in, out := &in.RefreshInterval, &out.RefreshInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
@@ -2523,7 +3360,7 @@ func (in *ScalewaySDConfig) DeepCopyInto(out *ScalewaySDConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -2538,6 +3375,148 @@ func (in *ScalewaySDConfig) DeepCopy() *ScalewaySDConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeClassDefinition) DeepCopyInto(out *ScrapeClassDefinition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClassDefinition.
+func (in *ScrapeClassDefinition) DeepCopy() *ScrapeClassDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeClassDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeClassDefinitionList) DeepCopyInto(out *ScrapeClassDefinitionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScrapeClassDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClassDefinitionList.
+func (in *ScrapeClassDefinitionList) DeepCopy() *ScrapeClassDefinitionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeClassDefinitionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeClassDefinitionSpec) DeepCopyInto(out *ScrapeClassDefinitionSpec) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FallbackScrapeProtocol != nil {
+		in, out := &in.FallbackScrapeProtocol, &out.FallbackScrapeProtocol
+		*out = new(v1.ScrapeProtocol)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.Authorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Relabelings != nil {
+		in, out := &in.Relabelings, &out.Relabelings
+		*out = make([]v1.RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]v1.RelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AttachMetadata != nil {
+		in, out := &in.AttachMetadata, &out.AttachMetadata
+		*out = new(v1.AttachMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScrapeTimeout != nil {
+		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SampleLimit != nil {
+		in, out := &in.SampleLimit, &out.SampleLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TargetLimit != nil {
+		in, out := &in.TargetLimit, &out.TargetLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelLimit != nil {
+		in, out := &in.LabelLimit, &out.LabelLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelNameLengthLimit != nil {
+		in, out := &in.LabelNameLengthLimit, &out.LabelNameLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LabelValueLengthLimit != nil {
+		in, out := &in.LabelValueLengthLimit, &out.LabelValueLengthLimit
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.NativeHistogramConfig != nil {
+		in, out := &in.NativeHistogramConfig, &out.NativeHistogramConfig
+		*out = new(v1.NativeHistogramConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = new(v1.ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeClassDefinitionSpec.
+func (in *ScrapeClassDefinitionSpec) DeepCopy() *ScrapeClassDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeClassDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScrapeConfig) DeepCopyInto(out *ScrapeConfig) {
 	*out = *in
@@ -2750,9 +3729,51 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MarathonSDConfigs != nil {
+		in, out := &in.MarathonSDConfigs, &out.MarathonSDConfigs
+		*out = make([]MarathonSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TritonSDConfigs != nil {
+		in, out := &in.TritonSDConfigs, &out.TritonSDConfigs
+		*out = make([]TritonSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UyuniSDConfigs != nil {
+		in, out := &in.UyuniSDConfigs, &out.UyuniSDConfigs
+		*out = make([]UyuniSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VultrSDConfigs != nil {
+		in, out := &in.VultrSDConfigs, &out.VultrSDConfigs
+		*out = make([]VultrSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServersetSDConfigs != nil {
+		in, out := &in.ServersetSDConfigs, &out.ServersetSDConfigs
+		*out = make([]ServersetSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NerveSDConfigs != nil {
+		in, out := &in.NerveSDConfigs, &out.NerveSDConfigs
+		*out = make([]NerveSDConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RelabelConfigs != nil {
 		in, out := &in.RelabelConfigs, &out.RelabelConfigs
-		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		*out = make([]v1.RelabelConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2764,22 +3785,22 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.ScrapeInterval != nil {
 		in, out := &in.ScrapeInterval, &out.ScrapeInterval
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScrapeTimeout != nil {
 		in, out := &in.ScrapeTimeout, &out.ScrapeTimeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ScrapeProtocols != nil {
 		in, out := &in.ScrapeProtocols, &out.ScrapeProtocols
-		*out = make([]monitoringv1.ScrapeProtocol, len(*in))
+		*out = make([]v1.ScrapeProtocol, len(*in))
 		copy(*out, *in)
 	}
 	if in.FallbackScrapeProtocol != nil {
 		in, out := &in.FallbackScrapeProtocol, &out.FallbackScrapeProtocol
-		*out = new(monitoringv1.ScrapeProtocol)
+		*out = new(v1.ScrapeProtocol)
 		**out = **in
 	}
 	if in.HonorTimestamps != nil {
@@ -2815,7 +3836,7 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.Scheme != nil {
 		in, out := &in.Scheme, &out.Scheme
-		*out = new(monitoringv1.Scheme)
+		*out = new(v1.Scheme)
 		**out = **in
 	}
 	if in.EnableCompression != nil {
@@ -2830,22 +3851,22 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SampleLimit != nil {
@@ -2881,7 +3902,7 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	}
 	if in.MetricRelabelConfigs != nil {
 		in, out := &in.MetricRelabelConfigs, &out.MetricRelabelConfigs
-		*out = make([]monitoringv1.RelabelConfig, len(*in))
+		*out = make([]v1.RelabelConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2889,12 +3910,12 @@ func (in *ScrapeConfigSpec) DeepCopyInto(out *ScrapeConfigSpec) {
 	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
 	if in.NameValidationScheme != nil {
 		in, out := &in.NameValidationScheme, &out.NameValidationScheme
-		*out = new(monitoringv1.NameValidationSchemeOptions)
+		*out = new(v1.NameValidationSchemeOptions)
 		**out = **in
 	}
 	if in.NameEscapingScheme != nil {
 		in, out := &in.NameEscapingScheme, &out.NameEscapingScheme
-		*out = new(monitoringv1.NameEscapingSchemeOptions)
+		*out = new(v1.NameEscapingSchemeOptions)
 		**out = **in
 	}
 	if in.ScrapeClassName != nil {
@@ -2915,15 +3936,45 @@ func (in *ScrapeConfigSpec) DeepCopy() *ScrapeConfigSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SlackAction) DeepCopyInto(out *SlackAction) {
+func (in *ServersetSDConfig) DeepCopyInto(out *ServersetSDConfig) {
 	*out = *in
-	if in.ConfirmField != nil {
-		in, out := &in.ConfirmField, &out.ConfirmField
-		*out = new(SlackConfirmationField)
-		**out = **in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-}
-
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServersetSDConfig.
+func (in *ServersetSDConfig) DeepCopy() *ServersetSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServersetSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackAction) DeepCopyInto(out *SlackAction) {
+	*out = *in
+	if in.ConfirmField != nil {
+		in, out := &in.ConfirmField, &out.ConfirmField
+		*out = new(SlackConfirmationField)
+		**out = **in
+	}
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackAction.
 func (in *SlackAction) DeepCopy() *SlackAction {
 	if in == nil {
@@ -3090,6 +4141,370 @@ func (in *TelegramConfig) DeepCopy() *TelegramConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactor) DeepCopyInto(out *ThanosCompactor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactor.
+func (in *ThanosCompactor) DeepCopy() *ThanosCompactor {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorList) DeepCopyInto(out *ThanosCompactorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosCompactor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorList.
+func (in *ThanosCompactorList) DeepCopy() *ThanosCompactorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosCompactorSpec) DeepCopyInto(out *ThanosCompactorSpec) {
+	*out = *in
+	in.CommonThanosObjectStorageFields.DeepCopyInto(&out.CommonThanosObjectStorageFields)
+	if in.RetentionResolutionRaw != nil {
+		in, out := &in.RetentionResolutionRaw, &out.RetentionResolutionRaw
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetentionResolution5m != nil {
+		in, out := &in.RetentionResolution5m, &out.RetentionResolution5m
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetentionResolution1h != nil {
+		in, out := &in.RetentionResolution1h, &out.RetentionResolution1h
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DownsamplingDisabled != nil {
+		in, out := &in.DownsamplingDisabled, &out.DownsamplingDisabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosCompactorSpec.
+func (in *ThanosCompactorSpec) DeepCopy() *ThanosCompactorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosCompactorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerier) DeepCopyInto(out *ThanosQuerier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerier.
+func (in *ThanosQuerier) DeepCopy() *ThanosQuerier {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerierList) DeepCopyInto(out *ThanosQuerierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosQuerier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerierList.
+func (in *ThanosQuerierList) DeepCopy() *ThanosQuerierList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerierSpec) DeepCopyInto(out *ThanosQuerierSpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodMetadata != nil {
+		in, out := &in.PodMetadata, &out.PodMetadata
+		*out = new(v1.EmbeddedObjectMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusSelector != nil {
+		in, out := &in.PrometheusSelector, &out.PrometheusSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusNamespaceSelector != nil {
+		in, out := &in.PrometheusNamespaceSelector, &out.PrometheusNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerSelector != nil {
+		in, out := &in.ThanosRulerSelector, &out.ThanosRulerSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThanosRulerNamespaceSelector != nil {
+		in, out := &in.ThanosRulerNamespaceSelector, &out.ThanosRulerNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicaLabels != nil {
+		in, out := &in.ReplicaLabels, &out.ReplicaLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalArgs != nil {
+		in, out := &in.AdditionalArgs, &out.AdditionalArgs
+		*out = make([]v1.Argument, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerierSpec.
+func (in *ThanosQuerierSpec) DeepCopy() *ThanosQuerierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosQuerierStatus) DeepCopyInto(out *ThanosQuerierStatus) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosQuerierStatus.
+func (in *ThanosQuerierStatus) DeepCopy() *ThanosQuerierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosQuerierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStore) DeepCopyInto(out *ThanosStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStore.
+func (in *ThanosStore) DeepCopy() *ThanosStore {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreList) DeepCopyInto(out *ThanosStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThanosStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreList.
+func (in *ThanosStoreList) DeepCopy() *ThanosStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosStoreSpec) DeepCopyInto(out *ThanosStoreSpec) {
+	*out = *in
+	in.CommonThanosObjectStorageFields.DeepCopyInto(&out.CommonThanosObjectStorageFields)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinTime != nil {
+		in, out := &in.MinTime, &out.MinTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTime != nil {
+		in, out := &in.MaxTime, &out.MaxTime
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosStoreSpec.
+func (in *ThanosStoreSpec) DeepCopy() *ThanosStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosWorkloadStatus) DeepCopyInto(out *ThanosWorkloadStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosWorkloadStatus.
+func (in *ThanosWorkloadStatus) DeepCopy() *ThanosWorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosWorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeInterval) DeepCopyInto(out *TimeInterval) {
 	*out = *in
@@ -3145,6 +4560,108 @@ func (in *TimeRange) DeepCopy() *TimeRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TritonSDConfig) DeepCopyInto(out *TritonSDConfig) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(TritonRole)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TritonSDConfig.
+func (in *TritonSDConfig) DeepCopy() *TritonSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TritonSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UyuniSDConfig) DeepCopyInto(out *UyuniSDConfig) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+	if in.Entitlement != nil {
+		in, out := &in.Entitlement, &out.Entitlement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UyuniSDConfig.
+func (in *UyuniSDConfig) DeepCopy() *UyuniSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UyuniSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VictorOpsConfig) DeepCopyInto(out *VictorOpsConfig) {
 	*out = *in
@@ -3180,6 +4697,57 @@ func (in *VictorOpsConfig) DeepCopy() *VictorOpsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VultrSDConfig) DeepCopyInto(out *VultrSDConfig) {
+	*out = *in
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(v1.SafeAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(v1.OAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(v1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableHTTP2 != nil {
+		in, out := &in.EnableHTTP2, &out.EnableHTTP2
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VultrSDConfig.
+func (in *VultrSDConfig) DeepCopy() *VultrSDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VultrSDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeChatConfig) DeepCopyInto(out *WeChatConfig) {
 	*out = *in
@@ -3270,7 +4838,7 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -327,6 +327,50 @@ func convertRocketchatConfigFrom(in v1alpha1.RocketChatConfig) RocketChatConfig
 	}
 }
 
+func convertJiraConfigFrom(in v1alpha1.JiraConfig) JiraConfig {
+	return JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            in.Fields,
+		HTTPConfig:        convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertIncidentioConfigFrom(in v1alpha1.IncidentioConfig) IncidentioConfig {
+	return IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*URL)(in.URL),
+		URLSecret:        in.URLSecret,
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertMattermostConfigFrom(in v1alpha1.MattermostConfig) MattermostConfig {
+	return MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsFrom(in []v1alpha1.SlackField) []SlackField {
 	out := make([]SlackField, len(in))
 
@@ -542,6 +586,7 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
 
 	for _, in := range src.Spec.Receivers {
 		out := Receiver{
@@ -653,6 +698,27 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigFrom(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -683,5 +749,8 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	}
 	dst.Spec.Route = r
 
+	dst.Spec.Templates = src.Spec.Templates
+	dst.Spec.Priority = src.Spec.Priority
+
 	return nil
 }
@@ -323,6 +323,50 @@ func convertRocketchatConfigTo(in RocketChatConfig) v1alpha1.RocketChatConfig {
 	}
 }
 
+func convertJiraConfigTo(in JiraConfig) v1alpha1.JiraConfig {
+	return v1alpha1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*v1alpha1.URL)(in.APIURL),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Labels:            in.Labels,
+		Priority:          in.Priority,
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		Fields:            in.Fields,
+		HTTPConfig:        convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertIncidentioConfigTo(in IncidentioConfig) v1alpha1.IncidentioConfig {
+	return v1alpha1.IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              (*v1alpha1.URL)(in.URL),
+		URLSecret:        in.URLSecret,
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertMattermostConfigTo(in MattermostConfig) v1alpha1.MattermostConfig {
+	return v1alpha1.MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*v1alpha1.URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsTo(in []SlackField) []v1alpha1.SlackField {
 	out := make([]v1alpha1.SlackField, len(in))
 
@@ -538,6 +582,7 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1alpha1.AlertmanagerConfig)
 
 	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
 
 	for _, in := range src.Spec.Receivers {
 		out := v1alpha1.Receiver{
@@ -649,6 +694,27 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigTo(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigTo(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigTo(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -680,5 +746,8 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.Route = r
 
+	dst.Spec.Templates = src.Spec.Templates
+	dst.Spec.Priority = src.Spec.Priority
+
 	return nil
 }
@@ -19,9 +19,9 @@
 package v1beta1
 
 import (
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -30,6 +30,7 @@ func (in *AlertmanagerConfig) DeepCopyInto(out *AlertmanagerConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfig.
@@ -95,6 +96,18 @@ func (in *AlertmanagerConfigSpec) DeepCopyInto(out *AlertmanagerConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]v1.SecretOrConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertmanagerConfigSpec.
@@ -213,7 +226,7 @@ func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -233,17 +246,17 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	*out = *in
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
-		*out = new(monitoringv1.SafeAuthorization)
+		*out = new(v1.SafeAuthorization)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(monitoringv1.BasicAuth)
+		*out = new(v1.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
-		*out = new(monitoringv1.OAuth2)
+		*out = new(v1.OAuth2)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BearerTokenSecret != nil {
@@ -253,7 +266,7 @@ func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	}
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(monitoringv1.SafeTLSConfig)
+		*out = new(v1.SafeTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ProxyURLOriginal != nil {
@@ -284,6 +297,51 @@ func (in *HTTPConfig) DeepCopy() *HTTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentioConfig) DeepCopyInto(out *IncidentioConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.URLSecret != nil {
+		in, out := &in.URLSecret, &out.URLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSourceToken != nil {
+		in, out := &in.AlertSourceToken, &out.AlertSourceToken
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentioConfig.
+func (in *IncidentioConfig) DeepCopy() *IncidentioConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentioConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
@@ -314,6 +372,83 @@ func (in *InhibitRule) DeepCopy() *InhibitRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraConfig) DeepCopyInto(out *JiraConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIURL != nil {
+		in, out := &in.APIURL, &out.APIURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenTransition != nil {
+		in, out := &in.ReopenTransition, &out.ReopenTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveTransition != nil {
+		in, out := &in.ResolveTransition, &out.ResolveTransition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WontFixResolution != nil {
+		in, out := &in.WontFixResolution, &out.WontFixResolution
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReopenDuration != nil {
+		in, out := &in.ReopenDuration, &out.ReopenDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraConfig.
+func (in *JiraConfig) DeepCopy() *JiraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyValue) DeepCopyInto(out *KeyValue) {
 	*out = *in
@@ -425,6 +560,57 @@ func (in *Matcher) DeepCopy() *Matcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostConfig) DeepCopyInto(out *MattermostConfig) {
+	*out = *in
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(URL)
+		**out = **in
+	}
+	if in.IconEmoji != nil {
+		in, out := &in.IconEmoji, &out.IconEmoji
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPConfig != nil {
+		in, out := &in.HTTPConfig, &out.HTTPConfig
+		*out = new(HTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostConfig.
+func (in *MattermostConfig) DeepCopy() *MattermostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsGenieConfig) DeepCopyInto(out *OpsGenieConfig) {
 	*out = *in
@@ -595,7 +781,7 @@ func (in *PushoverConfig) DeepCopyInto(out *PushoverConfig) {
 	}
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Device != nil {
@@ -738,6 +924,27 @@ func (in *Receiver) DeepCopyInto(out *Receiver) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.JiraConfigs != nil {
+		in, out := &in.JiraConfigs, &out.JiraConfigs
+		*out = make([]JiraConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncidentioConfigs != nil {
+		in, out := &in.IncidentioConfigs, &out.IncidentioConfigs
+		*out = make([]IncidentioConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MattermostConfigs != nil {
+		in, out := &in.MattermostConfigs, &out.MattermostConfigs
+		*out = make([]MattermostConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
@@ -926,7 +1133,7 @@ func (in *Route) DeepCopyInto(out *Route) {
 	}
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
-		*out = make([]v1.JSON, len(*in))
+		*out = make([]apiextensionsv1.JSON, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -963,7 +1170,7 @@ func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
 	}
 	if in.Sigv4 != nil {
 		in, out := &in.Sigv4, &out.Sigv4
-		*out = new(monitoringv1.Sigv4)
+		*out = new(v1.Sigv4)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Attributes != nil {
@@ -1356,7 +1563,7 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(monitoringv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+// ListAllByNamespaceFn lists the objects of a namespace matching the selector
+// (e.g. the ListAllByNamespace method of an informer).
+type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
+
+type referrerKey struct{}
+
+// WithReferrer returns a context which tells the store the kind of the
+// resource (e.g. "ServiceMonitor") on whose behalf the Secret and ConfigMap
+// references are resolved.
+//
+// The store resolves cross-namespace references only if the context carries
+// the referrer's kind.
+func WithReferrer(ctx context.Context, kind string) context.Context {
+	return context.WithValue(ctx, referrerKey{}, kind)
+}
+
+func referrerFromContext(ctx context.Context) string {
+	kind, _ := ctx.Value(referrerKey{}).(string)
+	return kind
+}
+
+// WithReferenceGrants enables the cross-namespace references: a Secret or
+// ConfigMap key selector whose name is `<namespace>/<name>` references an
+// object from another namespace if a ReferenceGrant object from this
+// namespace allows it.
+//
+// The listFn function lists the ReferenceGrant objects.
+func (s *StoreBuilder) WithReferenceGrants(listFn ListAllByNamespaceFn) *StoreBuilder {
+	s.listReferenceGrants = listFn
+	return s
+}
+
+// splitReference returns the namespace and name of the object referenced by
+// name from the given namespace.
+// The secret provider references are always scoped to the given namespace.
+func splitReference(namespace, name string) (string, string) {
+	if isSecretProviderReference(name) {
+		return namespace, name
+	}
+
+	if ns, n, found := strings.Cut(name, "/"); found {
+		return ns, n
+	}
+
+	return namespace, name
+}
+
+// resolveReference returns the namespace and name of the object referenced
+// by name from the given namespace. It returns an error if the reference
+// targets another namespace and no ReferenceGrant object allows it.
+func (s *StoreBuilder) resolveReference(ctx context.Context, namespace, name string, kind monitoringv1alpha1.ReferenceGrantToKind) (string, string, error) {
+	if isSecretProviderReference(name) {
+		return "", "", fmt.Errorf("%s %q: secret providers are only supported for secrets", strings.ToLower(string(kind)), name)
+	}
+
+	refNamespace, refName := splitReference(namespace, name)
+	if refNamespace == namespace {
+		return refNamespace, refName, nil
+	}
+
+	// Record the namespace of the grants in the tracker to trigger a
+	// reconciliation when they change.
+	s.refTracker.insert(&monitoringv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: refNamespace},
+	})
+
+	if s.listReferenceGrants == nil {
+		return "", "", fmt.Errorf("%s %q: cross-namespace references aren't supported", strings.ToLower(string(kind)), name)
+	}
+
+	referrer := referrerFromContext(ctx)
+	if referrer == "" {
+		return "", "", fmt.Errorf("%s %q: cross-namespace references aren't allowed for this resource", strings.ToLower(string(kind)), name)
+	}
+
+	var allowed bool
+	if err := s.listReferenceGrants(refNamespace, labels.Everything(), func(obj any) {
+		if rg, ok := obj.(*monitoringv1alpha1.ReferenceGrant); ok && rg.Allows(referrer, namespace, kind, refName) {
+			allowed = true
+		}
+	}); err != nil {
+		return "", "", fmt.Errorf("failed to list referencegrants in namespace %q: %w", refNamespace, err)
+	}
+
+	if allowed {
+		return refNamespace, refName, nil
+	}
+
+	return "", "", fmt.Errorf("%s %q: no ReferenceGrant in namespace %q allows the reference from %s objects in namespace %q", strings.ToLower(string(kind)), name, refNamespace, referrer, namespace)
+}
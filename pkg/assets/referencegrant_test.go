@@ -0,0 +1,211 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestCrossNamespaceReferences(t *testing.T) {
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "token",
+				Namespace: "shared",
+			},
+			Data: map[string][]byte{
+				"token": []byte("secret-token"),
+			},
+		},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other",
+				Namespace: "shared",
+			},
+			Data: map[string][]byte{
+				"token": []byte("other-token"),
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca",
+				Namespace: "shared",
+			},
+			Data: map[string]string{
+				"ca.crt": caPEM,
+			},
+		},
+	)
+
+	grants := []*monitoringv1alpha1.ReferenceGrant{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "team-a",
+				Namespace: "shared",
+			},
+			Spec: monitoringv1alpha1.ReferenceGrantSpec{
+				From: []monitoringv1alpha1.ReferenceGrantFrom{
+					{Kind: "ServiceMonitor", Namespace: "team-a"},
+				},
+				To: []monitoringv1alpha1.ReferenceGrantTo{
+					{Kind: monitoringv1alpha1.SecretReferenceGrantToKind, Name: ptr.To("token")},
+					{Kind: monitoringv1alpha1.ConfigMapReferenceGrantToKind},
+				},
+			},
+		},
+	}
+	listGrants := func(namespace string, _ labels.Selector, appendFn cache.AppendFunc) error {
+		for _, rg := range grants {
+			if rg.Namespace == namespace {
+				appendFn(rg)
+			}
+		}
+
+		return nil
+	}
+
+	secretSelector := func(name string) v1.SecretKeySelector {
+		return v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: name},
+			Key:                  "token",
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		grants    bool
+		referrer  string
+		namespace string
+		selector  v1.SecretKeySelector
+		expected  string
+		err       bool
+	}{
+		{
+			name:      "allowed reference",
+			grants:    true,
+			referrer:  "ServiceMonitor",
+			namespace: "team-a",
+			selector:  secretSelector("shared/token"),
+			expected:  "secret-token",
+		},
+		{
+			name:      "same namespace",
+			grants:    true,
+			namespace: "shared",
+			selector:  secretSelector("shared/other"),
+			expected:  "other-token",
+		},
+		{
+			name:      "name not allowed",
+			grants:    true,
+			referrer:  "ServiceMonitor",
+			namespace: "team-a",
+			selector:  secretSelector("shared/other"),
+			err:       true,
+		},
+		{
+			name:      "namespace not allowed",
+			grants:    true,
+			referrer:  "ServiceMonitor",
+			namespace: "team-b",
+			selector:  secretSelector("shared/token"),
+			err:       true,
+		},
+		{
+			name:      "kind not allowed",
+			grants:    true,
+			referrer:  "PodMonitor",
+			namespace: "team-a",
+			selector:  secretSelector("shared/token"),
+			err:       true,
+		},
+		{
+			name:      "no referrer",
+			grants:    true,
+			namespace: "team-a",
+			selector:  secretSelector("shared/token"),
+			err:       true,
+		},
+		{
+			name:      "grants not supported",
+			referrer:  "ServiceMonitor",
+			namespace: "team-a",
+			selector:  secretSelector("shared/token"),
+			err:       true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewStoreBuilder(c.CoreV1(), c.CoreV1())
+			if tc.grants {
+				store.WithReferenceGrants(listGrants)
+			}
+
+			ctx := context.Background()
+			if tc.referrer != "" {
+				ctx = WithReferrer(ctx, tc.referrer)
+			}
+
+			s, err := store.GetSecretKey(ctx, tc.namespace, tc.selector)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, s)
+
+			b, err := store.ForNamespace(tc.namespace).GetSecretKey(tc.selector)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+
+	t.Run("tls config and tracker", func(t *testing.T) {
+		store := NewStoreBuilder(c.CoreV1(), c.CoreV1()).WithReferenceGrants(listGrants)
+		ctx := WithReferrer(context.Background(), "ServiceMonitor")
+
+		ca := monitoringv1.SecretOrConfigMap{
+			ConfigMap: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "shared/ca"},
+				Key:                  "ca.crt",
+			},
+		}
+		err := store.AddSafeTLSConfig(ctx, "team-a", &monitoringv1.SafeTLSConfig{CA: ca})
+		require.NoError(t, err)
+
+		// The TLS asset is keyed by the namespace of the referenced object.
+		key := store.ForNamespace("team-a").TLSAsset(ca)
+		require.Equal(t, "1_shared_ca_ca.crt", key)
+		require.Equal(t, []byte(caPEM), store.TLSAssets()[key])
+
+		rt := store.RefTracker()
+		require.True(t, rt.Has(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "shared"}}))
+		require.False(t, rt.Has(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "team-a"}}))
+		require.True(t, rt.Has(&monitoringv1alpha1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Name: "any", Namespace: "shared"}}))
+		require.False(t, rt.Has(&monitoringv1alpha1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Name: "any", Namespace: "team-a"}}))
+	})
+}
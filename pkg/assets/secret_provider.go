@@ -0,0 +1,481 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecretProvider retrieves credentials from a source other than the
+// Kubernetes API (e.g. files mounted by the Secrets Store CSI driver).
+//
+// Like Kubernetes Secrets, the secrets of a provider are scoped by namespace:
+// a resource can only read the secrets of its own namespace.
+type SecretProvider interface {
+	// GetSecretKey returns the value of the key for the named secret in the
+	// given namespace.
+	GetSecretKey(ctx context.Context, namespace, name, key string) ([]byte, error)
+}
+
+var secretProviderNameRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// parseSecretProviderReference returns the provider and the secret's name of
+// a Secret key selector's name written as `<provider>:<name>`.
+//
+// Kubernetes object names can't contain colons which makes the reference
+// unambiguous.
+func parseSecretProviderReference(name string) (string, string, bool) {
+	return strings.Cut(name, ":")
+}
+
+func isSecretProviderReference(name string) bool {
+	_, _, found := parseSecretProviderReference(name)
+	return found
+}
+
+// secretProviderObject returns the Secret object which represents the
+// provider's secret in the store and in the RefTracker.
+//
+// The name contains a colon so that it can't conflict with a Kubernetes
+// Secret.
+func secretProviderObject(namespace, name string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
+
+// validateSecretProviderPath returns an error if the name of a provider's
+// secret isn't a relative path made of non-empty segments (e.g. "app" or
+// "team/app"). It prevents the references from escaping the namespace's
+// scope of the provider.
+func validateSecretProviderPath(name string) error {
+	if name == "" {
+		return errors.New("empty name")
+	}
+
+	if strings.ContainsAny(name, "?#%\\") {
+		return fmt.Errorf("invalid name %q: it can't contain '?', '#', '%%' or '\\' characters", name)
+	}
+
+	for _, segment := range strings.Split(name, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("invalid name %q: empty, '.' and '..' path segments aren't allowed", name)
+		}
+	}
+
+	return nil
+}
+
+type secretProviderCacheKey struct {
+	namespace string
+	name      string
+	key       string
+}
+
+type secretProviderCacheEntry struct {
+	value []byte
+	// recent is true until the next refresh following the insertion. It
+	// prevents the eviction of values which have been read by a
+	// reconciliation not yet recorded by the reference checkers.
+	recent bool
+}
+
+// SecretProviders holds the secret providers which can be referenced by the
+// Secret key selectors.
+//
+// The values are cached and refreshed periodically by Run(). The handlers
+// registered with OnChange() are notified when a value changes and the values
+// which aren't referenced anymore according to the functions registered with
+// AddReferenceChecker() are evicted.
+//
+// It is safe for concurrent use.
+type SecretProviders struct {
+	logger          *slog.Logger
+	refreshInterval time.Duration
+
+	mtx       sync.Mutex
+	providers map[string]SecretProvider
+	cache     map[secretProviderCacheKey]*secretProviderCacheEntry
+	handlers  []func(*v1.Secret)
+	checkers  []func(*v1.Secret) bool
+}
+
+// NewSecretProviders returns an empty SecretProviders.
+func NewSecretProviders(logger *slog.Logger, refreshInterval time.Duration) *SecretProviders {
+	return &SecretProviders{
+		logger:          logger,
+		refreshInterval: refreshInterval,
+		providers:       map[string]SecretProvider{},
+		cache:           map[secretProviderCacheKey]*secretProviderCacheEntry{},
+	}
+}
+
+// Register adds a provider with the given name. Secret key selectors
+// reference the provider's secrets with `<name>:<secret>`.
+func (sp *SecretProviders) Register(name string, p SecretProvider) error {
+	if !secretProviderNameRe.MatchString(name) {
+		return fmt.Errorf("invalid secret provider name %q: it should match %s", name, secretProviderNameRe.String())
+	}
+
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	if _, found := sp.providers[name]; found {
+		return fmt.Errorf("secret provider %q already registered", name)
+	}
+	sp.providers[name] = p
+
+	return nil
+}
+
+// OnChange registers a function called when the refreshed value of a secret
+// differs from the cached value. The argument is the Secret object recorded
+// in the RefTracker for the provider's secret.
+func (sp *SecretProviders) OnChange(fn func(*v1.Secret)) {
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	sp.handlers = append(sp.handlers, fn)
+}
+
+// AddReferenceChecker registers a function which returns true if the Secret
+// object recorded in the RefTracker for a provider's secret is still
+// referenced. When at least one function is registered, Refresh() evicts the
+// cached values which aren't referenced by any function.
+func (sp *SecretProviders) AddReferenceChecker(fn func(*v1.Secret) bool) {
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	sp.checkers = append(sp.checkers, fn)
+}
+
+func (sp *SecretProviders) provider(ref string) (SecretProvider, string, error) {
+	name, secret, _ := parseSecretProviderReference(ref)
+
+	if err := validateSecretProviderPath(secret); err != nil {
+		return nil, "", fmt.Errorf("secret provider %q: %w", name, err)
+	}
+
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	p, found := sp.providers[name]
+	if !found {
+		return nil, "", fmt.Errorf("secret provider %q not configured", name)
+	}
+
+	return p, secret, nil
+}
+
+// getSecretKey returns the value of the key for the provider's secret
+// referenced by ref (`<provider>:<name>`) in the given namespace.
+func (sp *SecretProviders) getSecretKey(ctx context.Context, namespace, ref, key string) ([]byte, error) {
+	ck := secretProviderCacheKey{namespace: namespace, name: ref, key: key}
+
+	sp.mtx.Lock()
+	e, found := sp.cache[ck]
+	sp.mtx.Unlock()
+
+	if found {
+		return e.value, nil
+	}
+
+	b, err := sp.fetch(ctx, ck)
+	if err != nil {
+		return nil, err
+	}
+
+	sp.mtx.Lock()
+	sp.cache[ck] = &secretProviderCacheEntry{value: b, recent: true}
+	sp.mtx.Unlock()
+
+	return b, nil
+}
+
+func (sp *SecretProviders) fetch(ctx context.Context, ck secretProviderCacheKey) ([]byte, error) {
+	p, name, err := sp.provider(ck.name)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := p.GetSecretKey(ctx, ck.namespace, name, ck.key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get key %q from %q in namespace %q: %w", ck.key, ck.name, ck.namespace, err)
+	}
+
+	return b, nil
+}
+
+// evict removes the cached values which aren't referenced anymore and returns
+// the keys of the remaining values.
+func (sp *SecretProviders) evict() []secretProviderCacheKey {
+	sp.mtx.Lock()
+	defer sp.mtx.Unlock()
+
+	keys := make([]secretProviderCacheKey, 0, len(sp.cache))
+	for ck, e := range sp.cache {
+		if len(sp.checkers) > 0 && !e.recent && !sp.isReferenced(secretProviderObject(ck.namespace, ck.name)) {
+			delete(sp.cache, ck)
+			continue
+		}
+
+		e.recent = false
+		keys = append(keys, ck)
+	}
+
+	return keys
+}
+
+func (sp *SecretProviders) isReferenced(s *v1.Secret) bool {
+	for _, fn := range sp.checkers {
+		if fn(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Refresh evicts the values which aren't referenced anymore, fetches again
+// the other cached values and notifies the handlers about the secrets which
+// have changed.
+//
+// When a value can't be fetched, the cached value is kept.
+func (sp *SecretProviders) Refresh(ctx context.Context) error {
+	keys := sp.evict()
+
+	var (
+		errs    []error
+		changed = map[types.NamespacedName]struct{}{}
+	)
+	for _, ck := range keys {
+		b, err := sp.fetch(ctx, ck)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		sp.mtx.Lock()
+		if e, found := sp.cache[ck]; found && !bytes.Equal(e.value, b) {
+			e.value = b
+			changed[types.NamespacedName{Namespace: ck.namespace, Name: ck.name}] = struct{}{}
+		}
+		sp.mtx.Unlock()
+	}
+
+	sp.mtx.Lock()
+	handlers := sp.handlers
+	sp.mtx.Unlock()
+
+	for nn := range changed {
+		for _, fn := range handlers {
+			fn(secretProviderObject(nn.Namespace, nn.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run refreshes the cached values periodically until the context is
+// canceled.
+func (sp *SecretProviders) Run(ctx context.Context) error {
+	ticker := time.NewTicker(sp.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := sp.Refresh(ctx); err != nil {
+			sp.logger.Warn("failed to refresh secrets from providers", "err", err)
+		}
+	}
+}
+
+// FileSecretProvider reads secrets from files, typically mounted by the
+// Secrets Store CSI driver.
+//
+// The value of the key for the named secret is the content of the
+// `<dir>/<namespace>/<name>/<key>` file.
+type FileSecretProvider struct {
+	dir string
+}
+
+var _ = SecretProvider(&FileSecretProvider{})
+
+// NewFileSecretProvider returns a FileSecretProvider reading files from the
+// given directory.
+func NewFileSecretProvider(dir string) *FileSecretProvider {
+	return &FileSecretProvider{dir: dir}
+}
+
+// GetSecretKey implements the SecretProvider interface.
+func (fp *FileSecretProvider) GetSecretKey(_ context.Context, namespace, name, key string) ([]byte, error) {
+	if namespace == "" || key == "" || strings.ContainsRune(key, '/') {
+		return nil, fmt.Errorf("invalid namespace %q or key %q", namespace, key)
+	}
+
+	if err := validateSecretProviderPath(name); err != nil {
+		return nil, err
+	}
+
+	p := filepath.Join(namespace, name, key)
+	if !filepath.IsLocal(p) {
+		return nil, fmt.Errorf("invalid path %q", p)
+	}
+
+	return os.ReadFile(filepath.Join(fp.dir, p))
+}
+
+// VaultSecretProvider reads secrets from an HTTP endpoint implementing the
+// Vault API, typically a Vault agent running next to the operator.
+//
+// The named secret is the path of the secret in the Vault API relative to
+// `<path prefix>/<namespace>` (e.g. `app` is read from
+// `secret/data/team-a/app` for the `team-a` namespace and the `secret/data`
+// prefix of the `secret` KV version 2 engine). Both KV version 1 and version
+// 2 engines are supported.
+type VaultSecretProvider struct {
+	address    string
+	pathPrefix string
+	tokenFile  string
+	client     *http.Client
+}
+
+var _ = SecretProvider(&VaultSecretProvider{})
+
+// NewVaultSecretProvider returns a VaultSecretProvider sending requests to
+// the given address. The secrets of a namespace are read below the
+// `<pathPrefix>/<namespace>/` path.
+//
+// If tokenFile isn't empty, the requests are authenticated with the token
+// read from the file. Otherwise the endpoint is expected to handle the
+// authentication (e.g. Vault agent with `use_auto_auth_token` enabled).
+func NewVaultSecretProvider(address, pathPrefix, tokenFile string, client *http.Client) (*VaultSecretProvider, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid address %q: unsupported scheme %q", address, u.Scheme)
+	}
+
+	pathPrefix = strings.Trim(pathPrefix, "/")
+	if err := validateSecretProviderPath(pathPrefix); err != nil {
+		return nil, fmt.Errorf("invalid path prefix: %w", err)
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &VaultSecretProvider{
+		address:    strings.TrimSuffix(address, "/"),
+		pathPrefix: pathPrefix,
+		tokenFile:  tokenFile,
+		client:     client,
+	}, nil
+}
+
+// GetSecretKey implements the SecretProvider interface.
+func (vp *VaultSecretProvider) GetSecretKey(ctx context.Context, namespace, name, key string) ([]byte, error) {
+	if err := validateSecretProviderPath(namespace); err != nil || strings.ContainsRune(namespace, '/') {
+		return nil, fmt.Errorf("invalid namespace %q", namespace)
+	}
+
+	if err := validateSecretProviderPath(name); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, vp.address+"/v1/"+vp.pathPrefix+"/"+namespace+"/"+name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if vp.tokenFile != "" {
+		token, err := os.ReadFile(vp.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token: %w", err)
+		}
+		req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	}
+
+	resp, err := vp.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	data := secret.Data
+	// The KV version 2 engine nests the key/value pairs under data.data next
+	// to data.metadata.
+	if inner, found := data["data"]; found {
+		if _, found := data["metadata"]; found {
+			data = nil
+			if err := json.Unmarshal(inner, &data); err != nil {
+				return nil, fmt.Errorf("failed to decode data: %w", err)
+			}
+		}
+	}
+
+	raw, found := data[key]
+	if !found {
+		return nil, fmt.Errorf("key %q not found", key)
+	}
+
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("key %q: value isn't a string", key)
+	}
+
+	return []byte(v), nil
+}
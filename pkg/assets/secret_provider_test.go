@@ -0,0 +1,380 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// memorySecretProvider is a stand-in for external secret providers. The
+// secrets are indexed by `<namespace>/<name>`.
+type memorySecretProvider struct {
+	data  map[string]map[string]string
+	calls int
+	err   error
+}
+
+func (mp *memorySecretProvider) GetSecretKey(_ context.Context, namespace, name, key string) ([]byte, error) {
+	mp.calls++
+
+	if mp.err != nil {
+		return nil, mp.err
+	}
+
+	v, found := mp.data[namespace+"/"+name][key]
+	if !found {
+		return nil, fmt.Errorf("key %q not found in %q", key, name)
+	}
+
+	return []byte(v), nil
+}
+
+func TestSecretProviders(t *testing.T) {
+	mp := &memorySecretProvider{
+		data: map[string]map[string]string{
+			"ns1/team-a/creds": {
+				"token":  "secret-token",
+				"ca.crt": caPEM,
+			},
+			"ns2/team-a/creds": {
+				"token": "ns2-token",
+			},
+		},
+	}
+
+	sp := NewSecretProviders(slog.New(slog.DiscardHandler), time.Minute)
+	require.NoError(t, sp.Register("local", mp))
+	require.Error(t, sp.Register("local", mp))
+	require.Error(t, sp.Register("Invalid_Name", mp))
+
+	var changed []*v1.Secret
+	sp.OnChange(func(s *v1.Secret) { changed = append(changed, s) })
+
+	ctx := context.Background()
+	c := fake.NewSimpleClientset(
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "creds",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"token": []byte("kube-token"),
+			},
+		},
+	)
+
+	store := NewStoreBuilder(c.CoreV1(), c.CoreV1())
+
+	// Secret providers are disabled by default.
+	_, err := store.GetSecretKey(ctx, "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.Error(t, err)
+
+	store.WithSecretProviders(sp)
+
+	token, err := store.GetSecretKey(ctx, "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "secret-token", token)
+
+	// Kubernetes Secrets are still resolved.
+	token, err = store.GetSecretKey(ctx, "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "kube-token", token)
+
+	tlsConfig := &monitoringv1.SafeTLSConfig{
+		CA: monitoringv1.SecretOrConfigMap{
+			Secret: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+				Key:                  "ca.crt",
+			},
+		},
+	}
+	require.NoError(t, store.AddSafeTLSConfig(ctx, "ns1", tlsConfig))
+	require.Equal(t, map[string][]byte{"0_ns1_local_team-a_creds_ca.crt": []byte(caPEM)}, store.TLSAssets())
+
+	// The secrets are scoped by namespace.
+	token, err = store.GetSecretKey(ctx, "ns2", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ns2-token", token)
+
+	for _, tc := range []struct {
+		name string
+		sel  v1.SecretKeySelector
+		err  bool
+	}{
+		{
+			name: "unknown key",
+			sel: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+				Key:                  "password",
+			},
+			err: true,
+		},
+		{
+			name: "unknown provider",
+			sel: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "vault:team-a/creds"},
+				Key:                  "token",
+			},
+			err: true,
+		},
+		{
+			name: "parent directory",
+			sel: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "local:../ns2/team-a/creds"},
+				Key:                  "token",
+			},
+			err: true,
+		},
+		{
+			name: "query string",
+			sel: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds?version=1"},
+				Key:                  "token",
+			},
+			err: true,
+		},
+		{
+			name: "absolute path",
+			sel: v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "local:/team-a/creds"},
+				Key:                  "token",
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := store.GetSecretKey(ctx, "ns1", tc.sel)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Secret providers aren't supported for ConfigMaps.
+	_, err = store.GetConfigMapKey(ctx, "ns1", v1.ConfigMapKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.Error(t, err)
+
+	// The values are available from the namespaced store.
+	b, err := store.ForNamespace("ns1").GetSecretKey(v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "secret-token", string(b))
+	require.Equal(t, "0_ns1_local_team-a_creds_ca.crt", store.ForNamespace("ns1").TLSAsset(tlsConfig.CA))
+
+	require.True(t, store.RefTracker().Has(secretProviderObject("ns1", "local:team-a/creds")))
+	require.False(t, store.RefTracker().Has(secretProviderObject("ns1", "local:team-b/creds")))
+	require.False(t, store.RefTracker().Has(secretProviderObject("ns3", "local:team-a/creds")))
+
+	// The values are cached.
+	calls := mp.calls
+	_, err = NewStoreBuilder(c.CoreV1(), c.CoreV1()).WithSecretProviders(sp).GetSecretKey(ctx, "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, calls, mp.calls)
+
+	// Unchanged values don't notify the handlers.
+	require.NoError(t, sp.Refresh(ctx))
+	require.Empty(t, changed)
+
+	// The cached values are kept when the provider fails.
+	mp.err = errors.New("unavailable")
+	require.Error(t, sp.Refresh(ctx))
+	require.Empty(t, changed)
+	mp.err = nil
+
+	mp.data["ns1/team-a/creds"]["token"] = "new-token"
+	require.NoError(t, sp.Refresh(ctx))
+	require.Len(t, changed, 1)
+	require.Equal(t, "local:team-a/creds", changed[0].Name)
+	require.Equal(t, "ns1", changed[0].Namespace)
+	require.True(t, store.RefTracker().Has(changed[0]))
+
+	token, err = NewStoreBuilder(c.CoreV1(), c.CoreV1()).WithSecretProviders(sp).GetSecretKey(ctx, "ns1", v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "local:team-a/creds"},
+		Key:                  "token",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "new-token", token)
+}
+
+func TestSecretProvidersEviction(t *testing.T) {
+	mp := &memorySecretProvider{
+		data: map[string]map[string]string{
+			"ns1/a": {"token": "a"},
+			"ns1/b": {"token": "b"},
+		},
+	}
+
+	sp := NewSecretProviders(slog.New(slog.DiscardHandler), time.Minute)
+	require.NoError(t, sp.Register("local", mp))
+
+	ctx := context.Background()
+	for _, name := range []string{"a", "b"} {
+		_, err := sp.getSecretKey(ctx, "ns1", "local:"+name, "token")
+		require.NoError(t, err)
+	}
+
+	// Without reference checkers, nothing is evicted.
+	require.NoError(t, sp.Refresh(ctx))
+	require.NoError(t, sp.Refresh(ctx))
+	require.Len(t, sp.cache, 2)
+
+	referenced := map[string]bool{"local:a": true}
+	sp.AddReferenceChecker(func(s *v1.Secret) bool { return referenced[s.Name] })
+
+	require.NoError(t, sp.Refresh(ctx))
+	require.Len(t, sp.cache, 1)
+	require.Contains(t, sp.cache, secretProviderCacheKey{namespace: "ns1", name: "local:a", key: "token"})
+
+	// A value read after the last refresh isn't evicted before the next one.
+	_, err := sp.getSecretKey(ctx, "ns1", "local:b", "token")
+	require.NoError(t, err)
+	require.NoError(t, sp.Refresh(ctx))
+	require.Len(t, sp.cache, 2)
+
+	calls := mp.calls
+	require.NoError(t, sp.Refresh(ctx))
+	require.Len(t, sp.cache, 1)
+	// Only the referenced value has been fetched again.
+	require.Equal(t, calls+1, mp.calls)
+}
+
+func TestFileSecretProvider(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ns1", "team-a"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ns1", "team-a", "token"), []byte("secret-token"), 0o600))
+
+	fp := NewFileSecretProvider(dir)
+
+	b, err := fp.GetSecretKey(context.Background(), "ns1", "team-a", "token")
+	require.NoError(t, err)
+	require.Equal(t, "secret-token", string(b))
+
+	for _, tc := range []struct {
+		namespace string
+		name      string
+		key       string
+	}{
+		{namespace: "ns1", name: "team-a", key: "password"},
+		{namespace: "ns2", name: "team-a", key: "token"},
+		{namespace: "ns2", name: "../ns1/team-a", key: "token"},
+		{namespace: "ns2", name: "..", key: "token"},
+		{namespace: "ns2", name: "/etc", key: "passwd"},
+		{namespace: "ns1", name: "team-a", key: "../team-a/token"},
+		{namespace: "", name: "ns1/team-a", key: "token"},
+	} {
+		_, err = fp.GetSecretKey(context.Background(), tc.namespace, tc.name, tc.key)
+		require.Error(t, err, "%+v", tc)
+	}
+}
+
+func TestVaultSecretProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/ns1/team-a":
+			// KV version 2.
+			_, _ = w.Write([]byte(`{"data":{"data":{"token":"v2-token","port":443},"metadata":{"version":1}}}`))
+		case "/v1/kv/ns1/team-a":
+			// KV version 1.
+			_, _ = w.Write([]byte(`{"data":{"token":"v1-token"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("vault-token\n"), 0o600))
+
+	vp, err := NewVaultSecretProvider(srv.URL, "secret/data", tokenFile, srv.Client())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	b, err := vp.GetSecretKey(ctx, "ns1", "team-a", "token")
+	require.NoError(t, err)
+	require.Equal(t, "v2-token", string(b))
+
+	_, err = vp.GetSecretKey(ctx, "ns1", "team-a", "port")
+	require.Error(t, err)
+
+	_, err = vp.GetSecretKey(ctx, "ns1", "team-a", "password")
+	require.Error(t, err)
+
+	// The secrets of a namespace can't be read from another namespace.
+	for _, name := range []string{"team-a", "../ns1/team-a", "team-a/../../ns1/team-a", "x?/../../ns1/team-a", "%2e%2e/ns1/team-a"} {
+		_, err = vp.GetSecretKey(ctx, "ns2", name, "token")
+		require.Error(t, err, name)
+	}
+
+	vp, err = NewVaultSecretProvider(srv.URL, "/kv/", tokenFile, srv.Client())
+	require.NoError(t, err)
+	b, err = vp.GetSecretKey(ctx, "ns1", "team-a", "token")
+	require.NoError(t, err)
+	require.Equal(t, "v1-token", string(b))
+
+	vp, err = NewVaultSecretProvider(srv.URL, "secret/data", "", srv.Client())
+	require.NoError(t, err)
+	_, err = vp.GetSecretKey(ctx, "ns1", "team-a", "token")
+	require.Error(t, err)
+
+	_, err = NewVaultSecretProvider("unix:///run/vault.sock", "secret/data", "", nil)
+	require.Error(t, err)
+
+	_, err = NewVaultSecretProvider(srv.URL, "secret/../sys", "", nil)
+	require.Error(t, err)
+}
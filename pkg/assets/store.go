@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 )
 
 // StoreBuilder is a store that fetches and caches TLS materials, bearer tokens
@@ -44,6 +45,9 @@ type StoreBuilder struct {
 	refTracker RefTracker
 
 	tlsAssetKeys map[tlsAssetKey]struct{}
+
+	listReferenceGrants ListAllByNamespaceFn
+	secretProviders     *SecretProviders
 }
 
 // NewTestStoreBuilder returns a *StoreBuilder already initialized with the
@@ -280,9 +284,14 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 		return "", errors.New("namespace cannot be empty")
 	}
 
+	namespace, name, err := s.resolveReference(ctx, namespace, sel.Name, monitoringv1alpha1.ConfigMapReferenceGrantToKind)
+	if err != nil {
+		return "", err
+	}
+
 	cm := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      sel.Name,
+			Name:      name,
 			Namespace: namespace,
 		},
 	}
@@ -293,7 +302,7 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 	}
 
 	if !exists {
-		cm, err := s.cmClient.ConfigMaps(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
+		cm, err := s.cmClient.ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return "", fmt.Errorf("unable to get configmap %q: %w", sel.Name, err)
 		}
@@ -317,9 +326,18 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 		return "", errors.New("namespace cannot be empty")
 	}
 
+	if isSecretProviderReference(sel.Name) {
+		return s.getSecretProviderKey(ctx, namespace, sel)
+	}
+
+	namespace, name, err := s.resolveReference(ctx, namespace, sel.Name, monitoringv1alpha1.SecretReferenceGrantToKind)
+	if err != nil {
+		return "", err
+	}
+
 	sec := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      sel.Name,
+			Name:      name,
 			Namespace: namespace,
 		},
 	}
@@ -330,7 +348,7 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 	}
 
 	if !exists {
-		secret, err := s.sClient.Secrets(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
+		secret, err := s.sClient.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return "", fmt.Errorf("unable to get secret %q: %w", sel.Name, err)
 		}
@@ -348,9 +366,57 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel v
 	return string(secret.Data[sel.Key]), nil
 }
 
+// WithSecretProviders enables the secret providers: a Secret key selector
+// whose name is `<provider>:<name>` references a secret from the provider
+// instead of a Kubernetes Secret.
+func (s *StoreBuilder) WithSecretProviders(sp *SecretProviders) *StoreBuilder {
+	s.secretProviders = sp
+	return s
+}
+
+// getSecretProviderKey returns the value of the key from the secret provider
+// and adds it to the store. The secret is looked up in the scope of the given
+// namespace.
+func (s *StoreBuilder) getSecretProviderKey(ctx context.Context, namespace string, sel v1.SecretKeySelector) (string, error) {
+	if s.secretProviders == nil {
+		return "", fmt.Errorf("secret %q: secret providers aren't supported", sel.Name)
+	}
+
+	sec := secretProviderObject(namespace, sel.Name)
+	s.refTracker.insert(sec)
+
+	b, err := s.secretProviders.getSecretKey(ctx, namespace, sel.Name, sel.Key)
+	if err != nil {
+		return "", err
+	}
+
+	obj, exists, err := s.objStore.Get(sec)
+	if err != nil {
+		return "", fmt.Errorf("unexpected store error when getting secret %q: %w", sel.Name, err)
+	}
+
+	if exists {
+		sec = obj.(*v1.Secret).DeepCopy()
+	}
+	if sec.Data == nil {
+		sec.Data = map[string][]byte{}
+	}
+	sec.Data[sel.Key] = b
+
+	if err = s.objStore.Update(sec); err != nil {
+		return "", fmt.Errorf("unexpected store error when adding secret %q: %w", sel.Name, err)
+	}
+
+	return string(b), nil
+}
+
 // ForNamespace returns a StoreGetter scoped to the given namespace.
 // It reads data only from the cache which needs to be populated beforehand.
 // The namespace argument can't be empty.
+//
+// The cross-namespace references are resolved without checking the
+// ReferenceGrant objects since the store has verified them when the data was
+// loaded.
 func (s *StoreBuilder) ForNamespace(namespace string) StoreGetter {
 	if namespace == "" {
 		panic("namespace can't be empty")
@@ -369,36 +435,38 @@ type cacheOnlyStore struct {
 var _ = StoreGetter(&cacheOnlyStore{})
 
 func (cos *cacheOnlyStore) GetConfigMapKey(sel v1.ConfigMapKeySelector) (string, error) {
-	obj, exists, err := cos.c.Get(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}})
+	ns, name := splitReference(cos.ns, sel.Name)
+	obj, exists, err := cos.c.Get(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}})
 	if err != nil {
-		return "", fmt.Errorf("failed to get configmap %s/%s: %w", cos.ns, sel.Name, err)
+		return "", fmt.Errorf("failed to get configmap %s/%s: %w", ns, name, err)
 	}
 
 	if !exists {
-		return "", fmt.Errorf("configmap %s/%s not found", cos.ns, sel.Name)
+		return "", fmt.Errorf("configmap %s/%s not found", ns, name)
 	}
 
 	cm := obj.(*v1.ConfigMap)
 	if _, found := cm.Data[sel.Key]; !found {
-		return "", fmt.Errorf("key %q in configmap %s/%s not found", sel.Key, cos.ns, sel.Name)
+		return "", fmt.Errorf("key %q in configmap %s/%s not found", sel.Key, ns, name)
 	}
 
 	return cm.Data[sel.Key], nil
 }
 
 func (cos *cacheOnlyStore) GetSecretKey(sel v1.SecretKeySelector) ([]byte, error) {
-	obj, exists, err := cos.c.Get(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: sel.Name, Namespace: cos.ns}})
+	ns, name := splitReference(cos.ns, sel.Name)
+	obj, exists, err := cos.c.Get(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get secret %s/%s: %w", cos.ns, sel.Name, err)
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", ns, name, err)
 	}
 
 	if !exists {
-		return nil, fmt.Errorf("secret %s/%s not found", cos.ns, sel.Name)
+		return nil, fmt.Errorf("secret %s/%s not found", ns, name)
 	}
 
 	s := obj.(*v1.Secret)
 	if _, found := s.Data[sel.Key]; !found {
-		return nil, fmt.Errorf("key %q in secret %s/%s not found", sel.Key, cos.ns, sel.Name)
+		return nil, fmt.Errorf("key %q in secret %s/%s not found", sel.Key, ns, name)
 	}
 
 	return s.Data[sel.Key], nil
@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"regexp"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -52,26 +53,41 @@ func tlsAssetKeyFromSecretSelector(ns string, sel *v1.SecretKeySelector) tlsAsse
 }
 
 // tlsAssetKeyFromSelector returns a TLSAssetKey struct from a secret or configmap key selector.
+// The selector's name can reference an object from another namespace
+// (`<namespace>/<name>`).
 func tlsAssetKeyFromSelector(ns string, sel monitoringv1.SecretOrConfigMap) tlsAssetKey {
 	if sel.Secret != nil {
+		ns, name := splitReference(ns, sel.Secret.Name)
 		return tlsAssetKey{
 			from: fromSecret,
 			ns:   ns,
-			name: sel.Secret.Name,
+			name: name,
 			key:  sel.Secret.Key,
 		}
 	}
 
+	ns, name := splitReference(ns, sel.ConfigMap.Name)
 	return tlsAssetKey{
 		from: fromConfigMap,
 		ns:   ns,
-		name: sel.ConfigMap.Name,
+		name: name,
 		key:  sel.ConfigMap.Key,
 	}
 }
 
+// invalidSecretKeyCharsRe matches the characters which aren't valid in the
+// keys of a Secret.
+var invalidSecretKeyCharsRe = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
 func (k tlsAssetKey) toString() string {
-	return fmt.Sprintf("%d_%s_%s_%s", k.from, k.ns, k.name, k.key)
+	name := k.name
+	if isSecretProviderReference(name) {
+		// The names of the secret provider references contain characters
+		// (e.g. colons) which aren't valid in Secret keys.
+		name = invalidSecretKeyCharsRe.ReplaceAllString(name, "_")
+	}
+
+	return fmt.Sprintf("%d_%s_%s_%s", k.from, k.ns, name, k.key)
 }
 
 // addTLSAssets processes the given SafeTLSConfig and adds the referenced CA, certificate and key to the store.
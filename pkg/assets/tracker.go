@@ -15,16 +15,31 @@
 package assets
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 )
 
 // RefTracker is a set-based struct which records the references to secrets and
-// configmaps.
+// configmaps. It also records the namespaces of the ReferenceGrant objects
+// which have been checked for cross-namespace references.
 type RefTracker map[string]struct{}
 
+// refKeyFunc returns a unique key for a ConfigMap, a Secret or the namespace
+// of a ReferenceGrant.
+func refKeyFunc(obj any) (string, error) {
+	if rg, ok := obj.(*monitoringv1alpha1.ReferenceGrant); ok {
+		return fmt.Sprintf("%s/%s", monitoringv1alpha1.ReferenceGrantKindKey, rg.GetNamespace()), nil
+	}
+
+	return assetKeyFunc(obj)
+}
+
 // insert records the object in the tracker.
 func (r RefTracker) insert(obj any) {
-	key, err := assetKeyFunc(obj)
+	key, err := refKeyFunc(obj)
 	if err != nil {
 		return
 	}
@@ -32,8 +47,10 @@ func (r RefTracker) insert(obj any) {
 }
 
 // Has returns true if the tracker knows about the given object.
+// For a ReferenceGrant object, it returns true if any ReferenceGrant object of
+// the same namespace has been checked.
 func (r RefTracker) Has(obj runtime.Object) bool {
-	key, err := assetKeyFunc(obj)
+	key, err := refKeyFunc(obj)
 	if err != nil {
 		return false
 	}
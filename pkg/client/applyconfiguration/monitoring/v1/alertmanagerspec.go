@@ -73,7 +73,10 @@ type AlertmanagerSpecApplyConfiguration struct {
 	ForceEnableClusterMode               *bool                                                   `json:"forceEnableClusterMode,omitempty"`
 	AlertmanagerConfigSelector           *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigSelector,omitempty"`
 	AlertmanagerConfigNamespaceSelector  *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerConfigNamespaceSelector,omitempty"`
+	ClusterAlertmanagerConfigSelector    *metav1.LabelSelectorApplyConfiguration                 `json:"clusterAlertmanagerConfigSelector,omitempty"`
 	AlertmanagerConfigMatcherStrategy    *AlertmanagerConfigMatcherStrategyApplyConfiguration    `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	AlertmanagerSilenceSelector          *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerSilenceSelector,omitempty"`
+	AlertmanagerSilenceNamespaceSelector *metav1.LabelSelectorApplyConfiguration                 `json:"alertmanagerSilenceNamespaceSelector,omitempty"`
 	MinReadySeconds                      *int32                                                  `json:"minReadySeconds,omitempty"`
 	HostAliases                          []HostAliasApplyConfiguration                           `json:"hostAliases,omitempty"`
 	Web                                  *AlertmanagerWebSpecApplyConfiguration                  `json:"web,omitempty"`
@@ -495,6 +498,14 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigNamespaceSele
 	return b
 }
 
+// WithClusterAlertmanagerConfigSelector sets the ClusterAlertmanagerConfigSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterAlertmanagerConfigSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithClusterAlertmanagerConfigSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.ClusterAlertmanagerConfigSelector = value
+	return b
+}
+
 // WithAlertmanagerConfigMatcherStrategy sets the AlertmanagerConfigMatcherStrategy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AlertmanagerConfigMatcherStrategy field is set to the value of the last call.
@@ -503,6 +514,22 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigMatcherStrate
 	return b
 }
 
+// WithAlertmanagerSilenceSelector sets the AlertmanagerSilenceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerSilenceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerSilenceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.AlertmanagerSilenceSelector = value
+	return b
+}
+
+// WithAlertmanagerSilenceNamespaceSelector sets the AlertmanagerSilenceNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerSilenceNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerSilenceNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.AlertmanagerSilenceNamespaceSelector = value
+	return b
+}
+
 // WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MinReadySeconds field is set to the value of the last call.
@@ -117,6 +117,8 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	ReloadStrategy                       *monitoringv1.ReloadStrategyType                        `json:"reloadStrategy,omitempty"`
 	MaximumStartupDurationSeconds        *int32                                                  `json:"maximumStartupDurationSeconds,omitempty"`
 	ScrapeClasses                        []ScrapeClassApplyConfiguration                         `json:"scrapeClasses,omitempty"`
+	ScrapeClassSelector                  *metav1.LabelSelectorApplyConfiguration                 `json:"scrapeClassSelector,omitempty"`
+	ScrapeClassNamespaceSelector         *metav1.LabelSelectorApplyConfiguration                 `json:"scrapeClassNamespaceSelector,omitempty"`
 	ServiceDiscoveryRole                 *monitoringv1.ServiceDiscoveryRole                      `json:"serviceDiscoveryRole,omitempty"`
 	TSDB                                 *TSDBSpecApplyConfiguration                             `json:"tsdb,omitempty"`
 	ScrapeFailureLogFile                 *string                                                 `json:"scrapeFailureLogFile,omitempty"`
@@ -926,6 +928,22 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithScrapeClasses(values ...*
 	return b
 }
 
+// WithScrapeClassSelector sets the ScrapeClassSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithScrapeClassSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.ScrapeClassSelector = value
+	return b
+}
+
+// WithScrapeClassNamespaceSelector sets the ScrapeClassNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassNamespaceSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithScrapeClassNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.ScrapeClassNamespaceSelector = value
+	return b
+}
+
 // WithServiceDiscoveryRole sets the ServiceDiscoveryRole field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceDiscoveryRole field is set to the value of the last call.
@@ -20,6 +20,7 @@ package v1
 // with apply.
 type PrometheusRuleSpecApplyConfiguration struct {
 	Groups []RuleGroupApplyConfiguration `json:"groups,omitempty"`
+	Tests  []RuleTestApplyConfiguration  `json:"tests,omitempty"`
 }
 
 // PrometheusRuleSpecApplyConfiguration constructs a declarative configuration of the PrometheusRuleSpec type for use with
@@ -40,3 +41,16 @@ func (b *PrometheusRuleSpecApplyConfiguration) WithGroups(values ...*RuleGroupAp
 	}
 	return b
 }
+
+// WithTests adds the given value to the Tests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tests field.
+func (b *PrometheusRuleSpecApplyConfiguration) WithTests(values ...*RuleTestApplyConfiguration) *PrometheusRuleSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTests")
+		}
+		b.Tests = append(b.Tests, *values[i])
+	}
+	return b
+}
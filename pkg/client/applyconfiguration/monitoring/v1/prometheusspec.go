@@ -852,6 +852,22 @@ func (b *PrometheusSpecApplyConfiguration) WithScrapeClasses(values ...*ScrapeCl
 	return b
 }
 
+// WithScrapeClassSelector sets the ScrapeClassSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithScrapeClassSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ScrapeClassSelector = value
+	return b
+}
+
+// WithScrapeClassNamespaceSelector sets the ScrapeClassNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithScrapeClassNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ScrapeClassNamespaceSelector = value
+	return b
+}
+
 // WithServiceDiscoveryRole sets the ServiceDiscoveryRole field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceDiscoveryRole field is set to the value of the last call.
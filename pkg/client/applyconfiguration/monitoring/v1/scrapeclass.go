@@ -23,14 +23,23 @@ import (
 // ScrapeClassApplyConfiguration represents a declarative configuration of the ScrapeClass type for use
 // with apply.
 type ScrapeClassApplyConfiguration struct {
-	Name                   *string                           `json:"name,omitempty"`
-	Default                *bool                             `json:"default,omitempty"`
-	FallbackScrapeProtocol *monitoringv1.ScrapeProtocol      `json:"fallbackScrapeProtocol,omitempty"`
-	TLSConfig              *TLSConfigApplyConfiguration      `json:"tlsConfig,omitempty"`
-	Authorization          *AuthorizationApplyConfiguration  `json:"authorization,omitempty"`
-	Relabelings            []RelabelConfigApplyConfiguration `json:"relabelings,omitempty"`
-	MetricRelabelings      []RelabelConfigApplyConfiguration `json:"metricRelabelings,omitempty"`
-	AttachMetadata         *AttachMetadataApplyConfiguration `json:"attachMetadata,omitempty"`
+	Name                   *string                                  `json:"name,omitempty"`
+	Default                *bool                                    `json:"default,omitempty"`
+	FallbackScrapeProtocol *monitoringv1.ScrapeProtocol             `json:"fallbackScrapeProtocol,omitempty"`
+	TLSConfig              *TLSConfigApplyConfiguration             `json:"tlsConfig,omitempty"`
+	Authorization          *AuthorizationApplyConfiguration         `json:"authorization,omitempty"`
+	Relabelings            []RelabelConfigApplyConfiguration        `json:"relabelings,omitempty"`
+	MetricRelabelings      []RelabelConfigApplyConfiguration        `json:"metricRelabelings,omitempty"`
+	AttachMetadata         *AttachMetadataApplyConfiguration        `json:"attachMetadata,omitempty"`
+	Interval               *monitoringv1.Duration                   `json:"interval,omitempty"`
+	ScrapeTimeout          *monitoringv1.Duration                   `json:"scrapeTimeout,omitempty"`
+	SampleLimit            *uint64                                  `json:"sampleLimit,omitempty"`
+	TargetLimit            *uint64                                  `json:"targetLimit,omitempty"`
+	LabelLimit             *uint64                                  `json:"labelLimit,omitempty"`
+	LabelNameLengthLimit   *uint64                                  `json:"labelNameLengthLimit,omitempty"`
+	LabelValueLengthLimit  *uint64                                  `json:"labelValueLengthLimit,omitempty"`
+	NativeHistogramConfig  *NativeHistogramConfigApplyConfiguration `json:"nativeHistogramConfig,omitempty"`
+	ProxyConfig            *ProxyConfigApplyConfiguration           `json:"proxyConfig,omitempty"`
 }
 
 // ScrapeClassApplyConfiguration constructs a declarative configuration of the ScrapeClass type for use with
@@ -112,3 +121,75 @@ func (b *ScrapeClassApplyConfiguration) WithAttachMetadata(value *AttachMetadata
 	b.AttachMetadata = value
 	return b
 }
+
+// WithInterval sets the Interval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interval field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithInterval(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.Interval = &value
+	return b
+}
+
+// WithScrapeTimeout sets the ScrapeTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeTimeout field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeTimeout(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.ScrapeTimeout = &value
+	return b
+}
+
+// WithSampleLimit sets the SampleLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SampleLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithSampleLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.SampleLimit = &value
+	return b
+}
+
+// WithTargetLimit sets the TargetLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithTargetLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.TargetLimit = &value
+	return b
+}
+
+// WithLabelLimit sets the LabelLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelLimit = &value
+	return b
+}
+
+// WithLabelNameLengthLimit sets the LabelNameLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelNameLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelNameLengthLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelNameLengthLimit = &value
+	return b
+}
+
+// WithLabelValueLengthLimit sets the LabelValueLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelValueLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelValueLengthLimit(value uint64) *ScrapeClassApplyConfiguration {
+	b.LabelValueLengthLimit = &value
+	return b
+}
+
+// WithNativeHistogramConfig sets the NativeHistogramConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeHistogramConfig field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNativeHistogramConfig(value *NativeHistogramConfigApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfig = value
+	return b
+}
+
+// WithProxyConfig sets the ProxyConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyConfig field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyConfig(value *ProxyConfigApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.ProxyConfig = value
+	return b
+}
@@ -17,19 +17,22 @@
 package v1alpha1
 
 import (
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apismonitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
-	v1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // PrometheusAgentSpecApplyConfiguration represents a declarative configuration of the PrometheusAgentSpec type for use
 // with apply.
 type PrometheusAgentSpecApplyConfiguration struct {
-	Mode                                        *monitoringv1alpha1.PrometheusAgentMode `json:"mode,omitempty"`
-	v1.CommonPrometheusFieldsApplyConfiguration `json:",inline"`
+	Mode                                                  *monitoringv1alpha1.PrometheusAgentMode     `json:"mode,omitempty"`
+	RuleSelector                                          *v1.LabelSelectorApplyConfiguration         `json:"ruleSelector,omitempty"`
+	RuleNamespaceSelector                                 *v1.LabelSelectorApplyConfiguration         `json:"ruleNamespaceSelector,omitempty"`
+	Ruler                                                 *PrometheusAgentRulerSpecApplyConfiguration `json:"ruler,omitempty"`
+	monitoringv1.CommonPrometheusFieldsApplyConfiguration `json:",inline"`
 }
 
 // PrometheusAgentSpecApplyConfiguration constructs a declarative configuration of the PrometheusAgentSpec type for use with
@@ -46,10 +49,34 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithMode(value monitoringv1alpha
 	return b
 }
 
+// WithRuleSelector sets the RuleSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuleSelector field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithRuleSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.RuleSelector = value
+	return b
+}
+
+// WithRuleNamespaceSelector sets the RuleNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuleNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithRuleNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.RuleNamespaceSelector = value
+	return b
+}
+
+// WithRuler sets the Ruler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ruler field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithRuler(value *PrometheusAgentRulerSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.Ruler = value
+	return b
+}
+
 // WithPodMetadata sets the PodMetadata field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodMetadata field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithPodMetadata(value *v1.EmbeddedObjectMetadataApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithPodMetadata(value *monitoringv1.EmbeddedObjectMetadataApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.PodMetadata = value
 	return b
 }
@@ -57,7 +84,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPodMetadata(value *v1.Embedd
 // WithServiceMonitorSelector sets the ServiceMonitorSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceMonitorSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ServiceMonitorSelector = value
 	return b
 }
@@ -65,7 +92,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorSelector(value
 // WithServiceMonitorNamespaceSelector sets the ServiceMonitorNamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceMonitorNamespaceSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ServiceMonitorNamespaceSelector = value
 	return b
 }
@@ -73,7 +100,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithServiceMonitorNamespaceSelec
 // WithPodMonitorSelector sets the PodMonitorSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodMonitorSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.PodMonitorSelector = value
 	return b
 }
@@ -81,7 +108,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorSelector(value *me
 // WithPodMonitorNamespaceSelector sets the PodMonitorNamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodMonitorNamespaceSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.PodMonitorNamespaceSelector = value
 	return b
 }
@@ -89,7 +116,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPodMonitorNamespaceSelector(
 // WithProbeSelector sets the ProbeSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ProbeSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithProbeSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithProbeSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ProbeSelector = value
 	return b
 }
@@ -97,7 +124,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithProbeSelector(value *metav1.
 // WithProbeNamespaceSelector sets the ProbeNamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ProbeNamespaceSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithProbeNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithProbeNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ProbeNamespaceSelector = value
 	return b
 }
@@ -105,7 +132,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithProbeNamespaceSelector(value
 // WithScrapeConfigSelector sets the ScrapeConfigSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ScrapeConfigSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ScrapeConfigSelector = value
 	return b
 }
@@ -113,7 +140,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigSelector(value *
 // WithScrapeConfigNamespaceSelector sets the ScrapeConfigNamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ScrapeConfigNamespaceSelector field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeConfigNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ScrapeConfigNamespaceSelector = value
 	return b
 }
@@ -211,7 +238,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithLogFormat(value string) *Pro
 // WithScrapeInterval sets the ScrapeInterval field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ScrapeInterval field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeInterval(value monitoringv1.Duration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeInterval(value apismonitoringv1.Duration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ScrapeInterval = &value
 	return b
 }
@@ -219,7 +246,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeInterval(value monitor
 // WithScrapeTimeout sets the ScrapeTimeout field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ScrapeTimeout field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeTimeout(value monitoringv1.Duration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeTimeout(value apismonitoringv1.Duration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ScrapeTimeout = &value
 	return b
 }
@@ -227,7 +254,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeTimeout(value monitori
 // WithScrapeProtocols adds the given value to the ScrapeProtocols field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ScrapeProtocols field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeProtocols(values ...monitoringv1.ScrapeProtocol) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeProtocols(values ...apismonitoringv1.ScrapeProtocol) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		b.CommonPrometheusFieldsApplyConfiguration.ScrapeProtocols = append(b.CommonPrometheusFieldsApplyConfiguration.ScrapeProtocols, values[i])
 	}
@@ -267,7 +294,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithEnableOTLPReceiver(value boo
 // WithRemoteWriteReceiverMessageVersions adds the given value to the RemoteWriteReceiverMessageVersions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RemoteWriteReceiverMessageVersions field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWriteReceiverMessageVersions(values ...monitoringv1.RemoteWriteMessageVersion) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWriteReceiverMessageVersions(values ...apismonitoringv1.RemoteWriteMessageVersion) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteReceiverMessageVersions = append(b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteReceiverMessageVersions, values[i])
 	}
@@ -277,7 +304,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWriteReceiverMessageVe
 // WithEnableFeatures adds the given value to the EnableFeatures field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the EnableFeatures field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithEnableFeatures(values ...monitoringv1.EnableFeature) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithEnableFeatures(values ...apismonitoringv1.EnableFeature) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		b.CommonPrometheusFieldsApplyConfiguration.EnableFeatures = append(b.CommonPrometheusFieldsApplyConfiguration.EnableFeatures, values[i])
 	}
@@ -303,7 +330,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithRoutePrefix(value string) *P
 // WithStorage sets the Storage field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Storage field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithStorage(value *v1.StorageSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithStorage(value *monitoringv1.StorageSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.Storage = value
 	return b
 }
@@ -339,7 +366,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPersistentVolumeClaimRetenti
 // WithWeb sets the Web field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Web field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithWeb(value *v1.PrometheusWebSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithWeb(value *monitoringv1.PrometheusWebSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.Web = value
 	return b
 }
@@ -423,7 +450,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithTolerations(values ...corev1
 // WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithTopologySpreadConstraints(values ...*v1.TopologySpreadConstraintApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithTopologySpreadConstraints(values ...*monitoringv1.TopologySpreadConstraintApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithTopologySpreadConstraints")
@@ -436,7 +463,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithTopologySpreadConstraints(va
 // WithRemoteWrite adds the given value to the RemoteWrite field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RemoteWrite field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWrite(values ...*v1.RemoteWriteSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWrite(values ...*monitoringv1.RemoteWriteSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithRemoteWrite")
@@ -449,7 +476,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithRemoteWrite(values ...*v1.Re
 // WithOTLP sets the OTLP field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OTLP field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithOTLP(value *v1.OTLPConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithOTLP(value *monitoringv1.OTLPConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.OTLP = value
 	return b
 }
@@ -465,7 +492,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithSecurityContext(value corev1
 // WithDNSPolicy sets the DNSPolicy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DNSPolicy field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithDNSPolicy(value monitoringv1.DNSPolicy) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithDNSPolicy(value apismonitoringv1.DNSPolicy) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.DNSPolicy = &value
 	return b
 }
@@ -473,7 +500,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithDNSPolicy(value monitoringv1
 // WithDNSConfig sets the DNSConfig field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DNSConfig field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithDNSConfig(value *v1.PodDNSConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithDNSConfig(value *monitoringv1.PodDNSConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.DNSConfig = value
 	return b
 }
@@ -525,7 +552,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithAdditionalScrapeConfigs(valu
 // WithAPIServerConfig sets the APIServerConfig field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the APIServerConfig field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithAPIServerConfig(value *v1.APIServerConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithAPIServerConfig(value *monitoringv1.APIServerConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.APIServerConfig = value
 	return b
 }
@@ -549,7 +576,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPortName(value string) *Prom
 // WithArbitraryFSAccessThroughSMs sets the ArbitraryFSAccessThroughSMs field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ArbitraryFSAccessThroughSMs field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithArbitraryFSAccessThroughSMs(value *v1.ArbitraryFSAccessThroughSMsConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithArbitraryFSAccessThroughSMs(value *monitoringv1.ArbitraryFSAccessThroughSMsConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ArbitraryFSAccessThroughSMs = value
 	return b
 }
@@ -637,7 +664,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithEnforcedKeepDroppedTargets(v
 // WithEnforcedBodySizeLimit sets the EnforcedBodySizeLimit field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EnforcedBodySizeLimit field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithEnforcedBodySizeLimit(value monitoringv1.ByteSize) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithEnforcedBodySizeLimit(value apismonitoringv1.ByteSize) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.EnforcedBodySizeLimit = &value
 	return b
 }
@@ -645,7 +672,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithEnforcedBodySizeLimit(value
 // WithNameValidationScheme sets the NameValidationScheme field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NameValidationScheme field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithNameValidationScheme(value monitoringv1.NameValidationSchemeOptions) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithNameValidationScheme(value apismonitoringv1.NameValidationSchemeOptions) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.NameValidationScheme = &value
 	return b
 }
@@ -653,7 +680,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithNameValidationScheme(value m
 // WithNameEscapingScheme sets the NameEscapingScheme field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NameEscapingScheme field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithNameEscapingScheme(value monitoringv1.NameEscapingSchemeOptions) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithNameEscapingScheme(value apismonitoringv1.NameEscapingSchemeOptions) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.NameEscapingScheme = &value
 	return b
 }
@@ -685,7 +712,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithMinReadySeconds(value int32)
 // WithHostAliases adds the given value to the HostAliases field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the HostAliases field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithHostAliases(values ...*v1.HostAliasApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithHostAliases(values ...*monitoringv1.HostAliasApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithHostAliases")
@@ -698,7 +725,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithHostAliases(values ...*v1.Ho
 // WithAdditionalArgs adds the given value to the AdditionalArgs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AdditionalArgs field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithAdditionalArgs(values ...*v1.ArgumentApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithAdditionalArgs(values ...*monitoringv1.ArgumentApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithAdditionalArgs")
@@ -719,7 +746,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithWALCompression(value bool) *
 // WithExcludedFromEnforcement adds the given value to the ExcludedFromEnforcement field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ExcludedFromEnforcement field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithExcludedFromEnforcement(values ...*v1.ObjectReferenceApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithExcludedFromEnforcement(values ...*monitoringv1.ObjectReferenceApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithExcludedFromEnforcement")
@@ -750,7 +777,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithPodTargetLabels(values ...st
 // WithTracingConfig sets the TracingConfig field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TracingConfig field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithTracingConfig(value *v1.PrometheusTracingConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithTracingConfig(value *monitoringv1.PrometheusTracingConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.TracingConfig = value
 	return b
 }
@@ -758,7 +785,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithTracingConfig(value *v1.Prom
 // WithBodySizeLimit sets the BodySizeLimit field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the BodySizeLimit field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithBodySizeLimit(value monitoringv1.ByteSize) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithBodySizeLimit(value apismonitoringv1.ByteSize) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.BodySizeLimit = &value
 	return b
 }
@@ -814,7 +841,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithKeepDroppedTargets(value uin
 // WithReloadStrategy sets the ReloadStrategy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReloadStrategy field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithReloadStrategy(value monitoringv1.ReloadStrategyType) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithReloadStrategy(value apismonitoringv1.ReloadStrategyType) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ReloadStrategy = &value
 	return b
 }
@@ -830,7 +857,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithMaximumStartupDurationSecond
 // WithScrapeClasses adds the given value to the ScrapeClasses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ScrapeClasses field.
-func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeClasses(values ...*v1.ScrapeClassApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeClasses(values ...*monitoringv1.ScrapeClassApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithScrapeClasses")
@@ -840,10 +867,26 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeClasses(values ...*v1.
 	return b
 }
 
+// WithScrapeClassSelector sets the ScrapeClassSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassSelector field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeClassSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ScrapeClassSelector = value
+	return b
+}
+
+// WithScrapeClassNamespaceSelector sets the ScrapeClassNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusAgentSpecApplyConfiguration) WithScrapeClassNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.ScrapeClassNamespaceSelector = value
+	return b
+}
+
 // WithServiceDiscoveryRole sets the ServiceDiscoveryRole field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceDiscoveryRole field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithServiceDiscoveryRole(value monitoringv1.ServiceDiscoveryRole) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithServiceDiscoveryRole(value apismonitoringv1.ServiceDiscoveryRole) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.ServiceDiscoveryRole = &value
 	return b
 }
@@ -851,7 +894,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithServiceDiscoveryRole(value m
 // WithTSDB sets the TSDB field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TSDB field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithTSDB(value *v1.TSDBSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithTSDB(value *monitoringv1.TSDBSpecApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.TSDB = value
 	return b
 }
@@ -875,7 +918,7 @@ func (b *PrometheusAgentSpecApplyConfiguration) WithServiceName(value string) *P
 // WithRuntime sets the Runtime field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Runtime field is set to the value of the last call.
-func (b *PrometheusAgentSpecApplyConfiguration) WithRuntime(value *v1.RuntimeConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
+func (b *PrometheusAgentSpecApplyConfiguration) WithRuntime(value *monitoringv1.RuntimeConfigApplyConfiguration) *PrometheusAgentSpecApplyConfiguration {
 	b.CommonPrometheusFieldsApplyConfiguration.Runtime = value
 	return b
 }
@@ -35,6 +35,9 @@ type ReceiverApplyConfiguration struct {
 	MSTeamsConfigs    []MSTeamsConfigApplyConfiguration    `json:"msteamsConfigs,omitempty"`
 	MSTeamsV2Configs  []MSTeamsV2ConfigApplyConfiguration  `json:"msteamsv2Configs,omitempty"`
 	RocketChatConfigs []RocketChatConfigApplyConfiguration `json:"rocketchatConfigs,omitempty"`
+	JiraConfigs       []JiraConfigApplyConfiguration       `json:"jiraConfigs,omitempty"`
+	IncidentioConfigs []IncidentioConfigApplyConfiguration `json:"incidentioConfigs,omitempty"`
+	MattermostConfigs []MattermostConfigApplyConfiguration `json:"mattermostConfigs,omitempty"`
 }
 
 // ReceiverApplyConfiguration constructs a declarative configuration of the Receiver type for use with
@@ -245,3 +248,42 @@ func (b *ReceiverApplyConfiguration) WithRocketChatConfigs(values ...*RocketChat
 	}
 	return b
 }
+
+// WithJiraConfigs adds the given value to the JiraConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the JiraConfigs field.
+func (b *ReceiverApplyConfiguration) WithJiraConfigs(values ...*JiraConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithJiraConfigs")
+		}
+		b.JiraConfigs = append(b.JiraConfigs, *values[i])
+	}
+	return b
+}
+
+// WithIncidentioConfigs adds the given value to the IncidentioConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IncidentioConfigs field.
+func (b *ReceiverApplyConfiguration) WithIncidentioConfigs(values ...*IncidentioConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIncidentioConfigs")
+		}
+		b.IncidentioConfigs = append(b.IncidentioConfigs, *values[i])
+	}
+	return b
+}
+
+// WithMattermostConfigs adds the given value to the MattermostConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MattermostConfigs field.
+func (b *ReceiverApplyConfiguration) WithMattermostConfigs(values ...*MattermostConfigApplyConfiguration) *ReceiverApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMattermostConfigs")
+		}
+		b.MattermostConfigs = append(b.MattermostConfigs, *values[i])
+	}
+	return b
+}
@@ -50,6 +50,12 @@ type ScrapeConfigSpecApplyConfiguration struct {
 	OVHCloudSDConfigs                          []OVHCloudSDConfigApplyConfiguration     `json:"ovhcloudSDConfigs,omitempty"`
 	ScalewaySDConfigs                          []ScalewaySDConfigApplyConfiguration     `json:"scalewaySDConfigs,omitempty"`
 	IonosSDConfigs                             []IonosSDConfigApplyConfiguration        `json:"ionosSDConfigs,omitempty"`
+	MarathonSDConfigs                          []MarathonSDConfigApplyConfiguration     `json:"marathonSDConfigs,omitempty"`
+	TritonSDConfigs                            []TritonSDConfigApplyConfiguration       `json:"tritonSDConfigs,omitempty"`
+	UyuniSDConfigs                             []UyuniSDConfigApplyConfiguration        `json:"uyuniSDConfigs,omitempty"`
+	VultrSDConfigs                             []VultrSDConfigApplyConfiguration        `json:"vultrSDConfigs,omitempty"`
+	ServersetSDConfigs                         []ServersetSDConfigApplyConfiguration    `json:"serversetSDConfigs,omitempty"`
+	NerveSDConfigs                             []NerveSDConfigApplyConfiguration        `json:"nerveSDConfigs,omitempty"`
 	RelabelConfigs                             []v1.RelabelConfigApplyConfiguration     `json:"relabelings,omitempty"`
 	MetricsPath                                *string                                  `json:"metricsPath,omitempty"`
 	ScrapeInterval                             *monitoringv1.Duration                   `json:"scrapeInterval,omitempty"`
@@ -394,6 +400,84 @@ func (b *ScrapeConfigSpecApplyConfiguration) WithIonosSDConfigs(values ...*Ionos
 	return b
 }
 
+// WithMarathonSDConfigs adds the given value to the MarathonSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MarathonSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithMarathonSDConfigs(values ...*MarathonSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMarathonSDConfigs")
+		}
+		b.MarathonSDConfigs = append(b.MarathonSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithTritonSDConfigs adds the given value to the TritonSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TritonSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithTritonSDConfigs(values ...*TritonSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTritonSDConfigs")
+		}
+		b.TritonSDConfigs = append(b.TritonSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithUyuniSDConfigs adds the given value to the UyuniSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UyuniSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithUyuniSDConfigs(values ...*UyuniSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUyuniSDConfigs")
+		}
+		b.UyuniSDConfigs = append(b.UyuniSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithVultrSDConfigs adds the given value to the VultrSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VultrSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithVultrSDConfigs(values ...*VultrSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVultrSDConfigs")
+		}
+		b.VultrSDConfigs = append(b.VultrSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithServersetSDConfigs adds the given value to the ServersetSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServersetSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithServersetSDConfigs(values ...*ServersetSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithServersetSDConfigs")
+		}
+		b.ServersetSDConfigs = append(b.ServersetSDConfigs, *values[i])
+	}
+	return b
+}
+
+// WithNerveSDConfigs adds the given value to the NerveSDConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NerveSDConfigs field.
+func (b *ScrapeConfigSpecApplyConfiguration) WithNerveSDConfigs(values ...*NerveSDConfigApplyConfiguration) *ScrapeConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNerveSDConfigs")
+		}
+		b.NerveSDConfigs = append(b.NerveSDConfigs, *values[i])
+	}
+	return b
+}
+
 // WithRelabelConfigs adds the given value to the RelabelConfigs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RelabelConfigs field.
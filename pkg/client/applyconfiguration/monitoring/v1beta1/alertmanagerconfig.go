@@ -17,6 +17,7 @@
 package v1beta1
 
 import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -27,7 +28,8 @@ import (
 type AlertmanagerConfigApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *AlertmanagerConfigSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *AlertmanagerConfigSpecApplyConfiguration            `json:"spec,omitempty"`
+	Status                           *monitoringv1.ConfigResourceStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // AlertmanagerConfig constructs a declarative configuration of the AlertmanagerConfig type for use with
@@ -218,6 +220,14 @@ func (b *AlertmanagerConfigApplyConfiguration) GetAPIVersion() *string {
 	return b.TypeMetaApplyConfiguration.APIVersion
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *AlertmanagerConfigApplyConfiguration) WithStatus(value *monitoringv1.ConfigResourceStatusApplyConfiguration) *AlertmanagerConfigApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetName retrieves the value of the Name field in the declarative configuration.
 func (b *AlertmanagerConfigApplyConfiguration) GetName() *string {
 	b.ensureObjectMetaApplyConfigurationExists()
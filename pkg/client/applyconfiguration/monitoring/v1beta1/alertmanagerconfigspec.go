@@ -16,13 +16,19 @@
 
 package v1beta1
 
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+)
+
 // AlertmanagerConfigSpecApplyConfiguration represents a declarative configuration of the AlertmanagerConfigSpec type for use
 // with apply.
 type AlertmanagerConfigSpecApplyConfiguration struct {
-	Route         *RouteApplyConfiguration         `json:"route,omitempty"`
-	Receivers     []ReceiverApplyConfiguration     `json:"receivers,omitempty"`
-	InhibitRules  []InhibitRuleApplyConfiguration  `json:"inhibitRules,omitempty"`
-	TimeIntervals []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	Route         *RouteApplyConfiguration                 `json:"route,omitempty"`
+	Receivers     []ReceiverApplyConfiguration             `json:"receivers,omitempty"`
+	InhibitRules  []InhibitRuleApplyConfiguration          `json:"inhibitRules,omitempty"`
+	TimeIntervals []TimeIntervalApplyConfiguration         `json:"timeIntervals,omitempty"`
+	Templates     []v1.SecretOrConfigMapApplyConfiguration `json:"templates,omitempty"`
+	Priority      *int32                                   `json:"priority,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -77,3 +83,24 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithTimeIntervals(values ...*
 	}
 	return b
 }
+
+// WithTemplates adds the given value to the Templates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Templates field.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithTemplates(values ...*v1.SecretOrConfigMapApplyConfiguration) *AlertmanagerConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTemplates")
+		}
+		b.Templates = append(b.Templates, *values[i])
+	}
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithPriority(value int32) *AlertmanagerConfigSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
@@ -54,6 +54,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.AlertmanagerStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AlertmanagerWebSpec"):
 		return &monitoringv1.AlertmanagerWebSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlertRuleTest"):
+		return &monitoringv1.AlertRuleTestApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("APIServerConfig"):
 		return &monitoringv1.APIServerConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ArbitraryFSAccessThroughSMsConfig"):
@@ -90,8 +92,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.EmbeddedPersistentVolumeClaimApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Endpoint"):
 		return &monitoringv1.EndpointApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EndpointDiscoveryStatus"):
+		return &monitoringv1.EndpointDiscoveryStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Exemplars"):
 		return &monitoringv1.ExemplarsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExpectedAlert"):
+		return &monitoringv1.ExpectedAlertApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExpectedSample"):
+		return &monitoringv1.ExpectedSampleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalJiraConfig"):
 		return &monitoringv1.GlobalJiraConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalRocketChatConfig"):
@@ -166,6 +174,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.PrometheusTracingConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PrometheusWebSpec"):
 		return &monitoringv1.PrometheusWebSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PromQLExprTest"):
+		return &monitoringv1.PromQLExprTestApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProxyConfig"):
 		return &monitoringv1.ProxyConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("QuerySpec"):
@@ -188,6 +198,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.RulesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RulesAlert"):
 		return &monitoringv1.RulesAlertApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuleTest"):
+		return &monitoringv1.RuleTestApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RuleTestInputSeries"):
+		return &monitoringv1.RuleTestInputSeriesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RuntimeConfig"):
 		return &monitoringv1.RuntimeConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SafeAuthorization"):
@@ -210,6 +224,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.Sigv4ApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StorageSpec"):
 		return &monitoringv1.StorageSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TargetDiscoveryStatus"):
+		return &monitoringv1.TargetDiscoveryStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRuler"):
 		return &monitoringv1.ThanosRulerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerSpec"):
@@ -242,10 +258,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.AlertmanagerConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerConfigSpec"):
 		return &monitoringv1alpha1.AlertmanagerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilence"):
+		return &monitoringv1alpha1.AlertmanagerSilenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceBinding"):
+		return &monitoringv1alpha1.AlertmanagerSilenceBindingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceInstance"):
+		return &monitoringv1alpha1.AlertmanagerSilenceInstanceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceSpec"):
+		return &monitoringv1alpha1.AlertmanagerSilenceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AlertmanagerSilenceStatus"):
+		return &monitoringv1alpha1.AlertmanagerSilenceStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AttachMetadata"):
 		return &monitoringv1alpha1.AttachMetadataApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AzureSDConfig"):
 		return &monitoringv1alpha1.AzureSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterAlertmanagerConfig"):
+		return &monitoringv1alpha1.ClusterAlertmanagerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CommonThanosObjectStorageFields"):
+		return &monitoringv1alpha1.CommonThanosObjectStorageFieldsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ConsulSDConfig"):
 		return &monitoringv1alpha1.ConsulSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DayOfMonthRange"):
@@ -278,10 +308,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.HTTPConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPSDConfig"):
 		return &monitoringv1alpha1.HTTPSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1alpha1.IncidentioConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1alpha1.InhibitRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IonosSDConfig"):
 		return &monitoringv1alpha1.IonosSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1alpha1.JiraConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("K8SSelectorConfig"):
 		return &monitoringv1alpha1.K8SSelectorConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyValue"):
@@ -294,8 +328,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.LightSailSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LinodeSDConfig"):
 		return &monitoringv1alpha1.LinodeSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MarathonSDConfig"):
+		return &monitoringv1alpha1.MarathonSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1alpha1.MatcherApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1alpha1.MattermostConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MonitoringQuota"):
+		return &monitoringv1alpha1.MonitoringQuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MonitoringQuotaSpec"):
+		return &monitoringv1alpha1.MonitoringQuotaSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1alpha1.MSTeamsConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
@@ -304,6 +346,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.MuteTimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceDiscovery"):
 		return &monitoringv1alpha1.NamespaceDiscoveryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NerveSDConfig"):
+		return &monitoringv1alpha1.NerveSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NomadSDConfig"):
 		return &monitoringv1alpha1.NomadSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackSDConfig"):
@@ -322,6 +366,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PagerDutyLinkConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusAgent"):
 		return &monitoringv1alpha1.PrometheusAgentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusAgentRulerSpec"):
+		return &monitoringv1alpha1.PrometheusAgentRulerSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PrometheusAgentSpec"):
 		return &monitoringv1alpha1.PrometheusAgentSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PuppetDBSDConfig"):
@@ -330,6 +376,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PushoverConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Receiver"):
 		return &monitoringv1alpha1.ReceiverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReferenceGrant"):
+		return &monitoringv1alpha1.ReferenceGrantApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReferenceGrantFrom"):
+		return &monitoringv1alpha1.ReferenceGrantFromApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReferenceGrantSpec"):
+		return &monitoringv1alpha1.ReferenceGrantSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ReferenceGrantTo"):
+		return &monitoringv1alpha1.ReferenceGrantToApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatActionConfig"):
 		return &monitoringv1alpha1.RocketChatActionConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatConfig"):
@@ -340,10 +394,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.RouteApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScalewaySDConfig"):
 		return &monitoringv1alpha1.ScalewaySDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeClassDefinition"):
+		return &monitoringv1alpha1.ScrapeClassDefinitionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeClassDefinitionSpec"):
+		return &monitoringv1alpha1.ScrapeClassDefinitionSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfig"):
 		return &monitoringv1alpha1.ScrapeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
 		return &monitoringv1alpha1.ScrapeConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServersetSDConfig"):
+		return &monitoringv1alpha1.ServersetSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackAction"):
 		return &monitoringv1alpha1.SlackActionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackConfig"):
@@ -358,12 +418,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.StaticConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TelegramConfig"):
 		return &monitoringv1alpha1.TelegramConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactor"):
+		return &monitoringv1alpha1.ThanosCompactorApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosCompactorSpec"):
+		return &monitoringv1alpha1.ThanosCompactorSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerier"):
+		return &monitoringv1alpha1.ThanosQuerierApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerierSpec"):
+		return &monitoringv1alpha1.ThanosQuerierSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosQuerierStatus"):
+		return &monitoringv1alpha1.ThanosQuerierStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStore"):
+		return &monitoringv1alpha1.ThanosStoreApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosStoreSpec"):
+		return &monitoringv1alpha1.ThanosStoreSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ThanosWorkloadStatus"):
+		return &monitoringv1alpha1.ThanosWorkloadStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeInterval"):
 		return &monitoringv1alpha1.TimeIntervalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TimeRange"):
 		return &monitoringv1alpha1.TimeRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TritonSDConfig"):
+		return &monitoringv1alpha1.TritonSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UyuniSDConfig"):
+		return &monitoringv1alpha1.UyuniSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VictorOpsConfig"):
 		return &monitoringv1alpha1.VictorOpsConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VultrSDConfig"):
+		return &monitoringv1alpha1.VultrSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebexConfig"):
 		return &monitoringv1alpha1.WebexConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("WebhookConfig"):
@@ -384,12 +466,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.EmailConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("HTTPConfig"):
 		return &monitoringv1beta1.HTTPConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1beta1.IncidentioConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1beta1.InhibitRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1beta1.JiraConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KeyValue"):
 		return &monitoringv1beta1.KeyValueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1beta1.MatcherApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1beta1.MattermostConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1beta1.MSTeamsConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
@@ -71,10 +71,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=monitoring.coreos.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("alertmanagerconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().AlertmanagerConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("alertmanagersilences"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().AlertmanagerSilences().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clusteralertmanagerconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ClusterAlertmanagerConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("monitoringquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().MonitoringQuotas().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("prometheusagents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().PrometheusAgents().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("referencegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ReferenceGrants().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scrapeclassdefinitions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ScrapeClassDefinitions().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scrapeconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ScrapeConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("thanoscompactors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ThanosCompactors().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("thanosqueriers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ThanosQueriers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("thanosstores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ThanosStores().Informer()}, nil
 
 		// Group=monitoring.coreos.com, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("alertmanagerconfigs"):
@@ -24,10 +24,26 @@ import (
 type Interface interface {
 	// AlertmanagerConfigs returns a AlertmanagerConfigInformer.
 	AlertmanagerConfigs() AlertmanagerConfigInformer
+	// AlertmanagerSilences returns a AlertmanagerSilenceInformer.
+	AlertmanagerSilences() AlertmanagerSilenceInformer
+	// ClusterAlertmanagerConfigs returns a ClusterAlertmanagerConfigInformer.
+	ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInformer
+	// MonitoringQuotas returns a MonitoringQuotaInformer.
+	MonitoringQuotas() MonitoringQuotaInformer
 	// PrometheusAgents returns a PrometheusAgentInformer.
 	PrometheusAgents() PrometheusAgentInformer
+	// ReferenceGrants returns a ReferenceGrantInformer.
+	ReferenceGrants() ReferenceGrantInformer
+	// ScrapeClassDefinitions returns a ScrapeClassDefinitionInformer.
+	ScrapeClassDefinitions() ScrapeClassDefinitionInformer
 	// ScrapeConfigs returns a ScrapeConfigInformer.
 	ScrapeConfigs() ScrapeConfigInformer
+	// ThanosCompactors returns a ThanosCompactorInformer.
+	ThanosCompactors() ThanosCompactorInformer
+	// ThanosQueriers returns a ThanosQuerierInformer.
+	ThanosQueriers() ThanosQuerierInformer
+	// ThanosStores returns a ThanosStoreInformer.
+	ThanosStores() ThanosStoreInformer
 }
 
 type version struct {
@@ -46,12 +62,52 @@ func (v *version) AlertmanagerConfigs() AlertmanagerConfigInformer {
 	return &alertmanagerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// AlertmanagerSilences returns a AlertmanagerSilenceInformer.
+func (v *version) AlertmanagerSilences() AlertmanagerSilenceInformer {
+	return &alertmanagerSilenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterAlertmanagerConfigs returns a ClusterAlertmanagerConfigInformer.
+func (v *version) ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInformer {
+	return &clusterAlertmanagerConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MonitoringQuotas returns a MonitoringQuotaInformer.
+func (v *version) MonitoringQuotas() MonitoringQuotaInformer {
+	return &monitoringQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PrometheusAgents returns a PrometheusAgentInformer.
 func (v *version) PrometheusAgents() PrometheusAgentInformer {
 	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ReferenceGrants returns a ReferenceGrantInformer.
+func (v *version) ReferenceGrants() ReferenceGrantInformer {
+	return &referenceGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScrapeClassDefinitions returns a ScrapeClassDefinitionInformer.
+func (v *version) ScrapeClassDefinitions() ScrapeClassDefinitionInformer {
+	return &scrapeClassDefinitionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScrapeConfigs returns a ScrapeConfigInformer.
 func (v *version) ScrapeConfigs() ScrapeConfigInformer {
 	return &scrapeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ThanosCompactors returns a ThanosCompactorInformer.
+func (v *version) ThanosCompactors() ThanosCompactorInformer {
+	return &thanosCompactorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ThanosQueriers returns a ThanosQuerierInformer.
+func (v *version) ThanosQueriers() ThanosQuerierInformer {
+	return &thanosQuerierInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ThanosStores returns a ThanosStoreInformer.
+func (v *version) ThanosStores() ThanosStoreInformer {
+	return &thanosStoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -24,6 +24,26 @@ type AlertmanagerConfigListerExpansion interface{}
 // AlertmanagerConfigNamespaceLister.
 type AlertmanagerConfigNamespaceListerExpansion interface{}
 
+// AlertmanagerSilenceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceLister.
+type AlertmanagerSilenceListerExpansion interface{}
+
+// AlertmanagerSilenceNamespaceListerExpansion allows custom methods to be added to
+// AlertmanagerSilenceNamespaceLister.
+type AlertmanagerSilenceNamespaceListerExpansion interface{}
+
+// ClusterAlertmanagerConfigListerExpansion allows custom methods to be added to
+// ClusterAlertmanagerConfigLister.
+type ClusterAlertmanagerConfigListerExpansion interface{}
+
+// MonitoringQuotaListerExpansion allows custom methods to be added to
+// MonitoringQuotaLister.
+type MonitoringQuotaListerExpansion interface{}
+
+// MonitoringQuotaNamespaceListerExpansion allows custom methods to be added to
+// MonitoringQuotaNamespaceLister.
+type MonitoringQuotaNamespaceListerExpansion interface{}
+
 // PrometheusAgentListerExpansion allows custom methods to be added to
 // PrometheusAgentLister.
 type PrometheusAgentListerExpansion interface{}
@@ -32,6 +52,22 @@ type PrometheusAgentListerExpansion interface{}
 // PrometheusAgentNamespaceLister.
 type PrometheusAgentNamespaceListerExpansion interface{}
 
+// ReferenceGrantListerExpansion allows custom methods to be added to
+// ReferenceGrantLister.
+type ReferenceGrantListerExpansion interface{}
+
+// ReferenceGrantNamespaceListerExpansion allows custom methods to be added to
+// ReferenceGrantNamespaceLister.
+type ReferenceGrantNamespaceListerExpansion interface{}
+
+// ScrapeClassDefinitionListerExpansion allows custom methods to be added to
+// ScrapeClassDefinitionLister.
+type ScrapeClassDefinitionListerExpansion interface{}
+
+// ScrapeClassDefinitionNamespaceListerExpansion allows custom methods to be added to
+// ScrapeClassDefinitionNamespaceLister.
+type ScrapeClassDefinitionNamespaceListerExpansion interface{}
+
 // ScrapeConfigListerExpansion allows custom methods to be added to
 // ScrapeConfigLister.
 type ScrapeConfigListerExpansion interface{}
@@ -39,3 +75,27 @@ type ScrapeConfigListerExpansion interface{}
 // ScrapeConfigNamespaceListerExpansion allows custom methods to be added to
 // ScrapeConfigNamespaceLister.
 type ScrapeConfigNamespaceListerExpansion interface{}
+
+// ThanosCompactorListerExpansion allows custom methods to be added to
+// ThanosCompactorLister.
+type ThanosCompactorListerExpansion interface{}
+
+// ThanosCompactorNamespaceListerExpansion allows custom methods to be added to
+// ThanosCompactorNamespaceLister.
+type ThanosCompactorNamespaceListerExpansion interface{}
+
+// ThanosQuerierListerExpansion allows custom methods to be added to
+// ThanosQuerierLister.
+type ThanosQuerierListerExpansion interface{}
+
+// ThanosQuerierNamespaceListerExpansion allows custom methods to be added to
+// ThanosQuerierNamespaceLister.
+type ThanosQuerierNamespaceListerExpansion interface{}
+
+// ThanosStoreListerExpansion allows custom methods to be added to
+// ThanosStoreLister.
+type ThanosStoreListerExpansion interface{}
+
+// ThanosStoreNamespaceListerExpansion allows custom methods to be added to
+// ThanosStoreNamespaceLister.
+type ThanosStoreNamespaceListerExpansion interface{}
@@ -18,6 +18,22 @@ package v1alpha1
 
 type AlertmanagerConfigExpansion interface{}
 
+type AlertmanagerSilenceExpansion interface{}
+
+type ClusterAlertmanagerConfigExpansion interface{}
+
+type MonitoringQuotaExpansion interface{}
+
 type PrometheusAgentExpansion interface{}
 
+type ReferenceGrantExpansion interface{}
+
+type ScrapeClassDefinitionExpansion interface{}
+
 type ScrapeConfigExpansion interface{}
+
+type ThanosCompactorExpansion interface{}
+
+type ThanosQuerierExpansion interface{}
+
+type ThanosStoreExpansion interface{}
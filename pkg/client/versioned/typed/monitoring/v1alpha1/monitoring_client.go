@@ -27,8 +27,16 @@ import (
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
+	AlertmanagerSilencesGetter
+	ClusterAlertmanagerConfigsGetter
+	MonitoringQuotasGetter
 	PrometheusAgentsGetter
+	ReferenceGrantsGetter
+	ScrapeClassDefinitionsGetter
 	ScrapeConfigsGetter
+	ThanosCompactorsGetter
+	ThanosQueriersGetter
+	ThanosStoresGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -40,14 +48,46 @@ func (c *MonitoringV1alpha1Client) AlertmanagerConfigs(namespace string) Alertma
 	return newAlertmanagerConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) AlertmanagerSilences(namespace string) AlertmanagerSilenceInterface {
+	return newAlertmanagerSilences(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ClusterAlertmanagerConfigs() ClusterAlertmanagerConfigInterface {
+	return newClusterAlertmanagerConfigs(c)
+}
+
+func (c *MonitoringV1alpha1Client) MonitoringQuotas(namespace string) MonitoringQuotaInterface {
+	return newMonitoringQuotas(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) PrometheusAgentInterface {
 	return newPrometheusAgents(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ReferenceGrants(namespace string) ReferenceGrantInterface {
+	return newReferenceGrants(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ScrapeClassDefinitions(namespace string) ScrapeClassDefinitionInterface {
+	return newScrapeClassDefinitions(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
 	return newScrapeConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) ThanosCompactors(namespace string) ThanosCompactorInterface {
+	return newThanosCompactors(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosQueriers(namespace string) ThanosQuerierInterface {
+	return newThanosQueriers(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) ThanosStores(namespace string) ThanosStoreInterface {
+	return newThanosStores(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
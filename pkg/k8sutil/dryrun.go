@@ -0,0 +1,385 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// dryRunPassthroughGroups lists the API groups for which write requests are
+// forwarded unmodified because they don't persist anything (e.g.
+// SelfSubjectAccessReviews).
+var dryRunPassthroughGroups = map[string]struct{}{
+	"authentication.k8s.io": {},
+	"authorization.k8s.io":  {},
+}
+
+// dryRunRoundTripper is an http.RoundTripper which turns all the write
+// requests sent to the Kubernetes API into server-side dry-run requests and
+// logs the difference between the current object and the object that would
+// have been persisted.
+type dryRunRoundTripper struct {
+	logger *slog.Logger
+	next   http.RoundTripper
+
+	// compressedSecretKeys maps the Secret keys holding gzipped data which
+	// should be decompressed and redacted before computing the difference.
+	compressedSecretKeys map[string]func([]byte) ([]byte, error)
+}
+
+// DryRunOption configures the round tripper returned by
+// NewDryRunRoundTripper.
+type DryRunOption func(*dryRunRoundTripper)
+
+// WithDryRunCompressedSecretKey tells the round tripper that the values of the
+// given Secret key are gzipped. Instead of being redacted, the values are
+// decompressed and passed through the redact function so that the reported
+// difference is readable.
+func WithDryRunCompressedSecretKey(key string, redact func([]byte) ([]byte, error)) DryRunOption {
+	return func(rt *dryRunRoundTripper) {
+		rt.compressedSecretKeys[key] = redact
+	}
+}
+
+// NewDryRunRoundTripper returns an http.RoundTripper which executes every
+// create, update, patch and delete request against the Kubernetes API in
+// server-side dry-run mode (https://kubernetes.io/docs/reference/using-api/api-concepts/#dry-run).
+//
+// The API server still validates, defaults and admits the requests, but
+// nothing is persisted. For each request, the round tripper logs the
+// structured difference between the current state of the object and the
+// state that would have been written. The values of the Secret objects are
+// redacted: the difference only shows which keys are added, removed or
+// modified.
+//
+// It is meant to be used with rest.Config.Wrap().
+func NewDryRunRoundTripper(logger *slog.Logger, next http.RoundTripper, opts ...DryRunOption) http.RoundTripper {
+	rt := &dryRunRoundTripper{
+		logger:               logger.With("component", "dry-run"),
+		next:                 next,
+		compressedSecretKeys: map[string]func([]byte) ([]byte, error){},
+	}
+
+	for _, opt := range opts {
+		opt(rt)
+	}
+
+	return rt
+}
+
+// apiRequest describes the target of a request to the Kubernetes API.
+type apiRequest struct {
+	group       string
+	version     string
+	namespace   string
+	resource    string
+	name        string
+	subresource string
+}
+
+// parseAPIRequestPath returns the resource targeted by the given URL path. The
+// second value is false if the path doesn't point to a resource.
+func parseAPIRequestPath(path string) (apiRequest, bool) {
+	var (
+		r        apiRequest
+		segments = strings.Split(strings.Trim(path, "/"), "/")
+	)
+
+	switch {
+	case len(segments) >= 3 && segments[0] == "api":
+		r.version = segments[1]
+		segments = segments[2:]
+	case len(segments) >= 4 && segments[0] == "apis":
+		r.group = segments[1]
+		r.version = segments[2]
+		segments = segments[3:]
+	default:
+		return r, false
+	}
+
+	// The "namespaces" resource itself is cluster-scoped.
+	if segments[0] == "namespaces" && len(segments) >= 3 {
+		r.namespace = segments[1]
+		segments = segments[2:]
+	}
+
+	r.resource = segments[0]
+	if len(segments) > 1 {
+		r.name = segments[1]
+	}
+	if len(segments) > 2 {
+		r.subresource = segments[2]
+	}
+
+	return r, true
+}
+
+func (rt *dryRunRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var verb string
+	switch req.Method {
+	case http.MethodPost:
+		verb = "create"
+	case http.MethodPut:
+		verb = "update"
+	case http.MethodPatch:
+		verb = "patch"
+	case http.MethodDelete:
+		verb = "delete"
+	default:
+		return rt.next.RoundTrip(req)
+	}
+
+	r, ok := parseAPIRequestPath(req.URL.Path)
+	if !ok {
+		return rt.next.RoundTrip(req)
+	}
+
+	if _, found := dryRunPassthroughGroups[r.group]; found {
+		return rt.next.RoundTrip(req)
+	}
+
+	// Retrieve the current state of the object before issuing the dry-run
+	// request (the create requests don't target an existing object).
+	var current map[string]any
+	if verb != "create" {
+		var err error
+		current, err = rt.get(req)
+		if err != nil {
+			rt.loggerFor(verb, r).Warn("failed to get the current object", "err", err)
+		}
+	}
+
+	dryRunReq := req.Clone(req.Context())
+	q := dryRunReq.URL.Query()
+	q.Set("dryRun", metav1.DryRunAll)
+	dryRunReq.URL.RawQuery = q.Encode()
+	// Request a JSON response to compute the difference (the client decodes
+	// the response based on its content type).
+	dryRunReq.Header.Set("Accept", "application/json")
+
+	resp, err := rt.next.RoundTrip(dryRunReq)
+	if err != nil || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	var desired map[string]any
+	if verb != "delete" {
+		b, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(b))
+
+		if isJSONContentType(resp.Header.Get("Content-Type")) {
+			if err := json.Unmarshal(b, &desired); err != nil {
+				rt.loggerFor(verb, r).Warn("failed to decode the dry-run response", "err", err)
+			}
+		}
+
+		// The name of the object isn't part of the path for create requests.
+		if md, ok := desired["metadata"].(map[string]any); ok && r.name == "" {
+			r.name, _ = md["name"].(string)
+		}
+	}
+
+	logger := rt.loggerFor(verb, r)
+
+	// Events are informational and don't modify the managed resources.
+	level := slog.LevelInfo
+	if r.resource == "events" {
+		level = slog.LevelDebug
+	}
+
+	current, desired = normalizeDryRunObject(current), normalizeDryRunObject(desired)
+	if r.group == "" && r.resource == "secrets" {
+		rt.redactSecretData(current, desired)
+	}
+
+	diff := cmp.Diff(current, desired)
+	if diff == "" {
+		logger.Debug("dry-run: no change")
+		return resp, nil
+	}
+
+	logger.Log(req.Context(), level, "dry-run: change not persisted", "diff", diff)
+
+	return resp, nil
+}
+
+func (rt *dryRunRoundTripper) loggerFor(verb string, r apiRequest) *slog.Logger {
+	logger := rt.logger.With(
+		"verb", verb,
+		"resource", r.resource,
+		"namespace", r.namespace,
+		"name", r.name,
+	)
+
+	if r.subresource != "" {
+		logger = logger.With("subresource", r.subresource)
+	}
+
+	return logger
+}
+
+// get returns the current state of the object targeted by the request.
+func (rt *dryRunRoundTripper) get(req *http.Request) (map[string]any, error) {
+	u := *req.URL
+	u.RawQuery = ""
+
+	getReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve the authentication and impersonation headers.
+	getReq.Header = req.Header.Clone()
+	getReq.Header.Del("Content-Type")
+	getReq.Header.Del("Content-Length")
+	getReq.Header.Set("Accept", "application/json")
+
+	resp, err := rt.next.RoundTrip(getReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var obj map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// normalizeDryRunObject removes the fields which are always modified by the
+// API server to avoid noise in the reported differences.
+func normalizeDryRunObject(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+
+	md, ok := obj["metadata"].(map[string]any)
+	if !ok {
+		return obj
+	}
+
+	for _, k := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp"} {
+		delete(md, k)
+	}
+
+	return obj
+}
+
+const (
+	dryRunRedacted         = "<redacted>"
+	dryRunRedactedModified = "<redacted (modified)>"
+)
+
+// redactSecretData replaces the values of the data and stringData fields of
+// the Secret objects so that the credentials don't end up in the logs. When
+// the value of a key differs between the current and the desired objects, the
+// desired value is replaced by a distinct placeholder for the key to appear in
+// the difference.
+func (rt *dryRunRoundTripper) redactSecretData(current, desired map[string]any) {
+	for _, field := range []string{"data", "stringData"} {
+		cur, _ := current[field].(map[string]any)
+		des, _ := desired[field].(map[string]any)
+
+		for k, v := range des {
+			if redact, found := rt.compressedSecretKeys[k]; found && field == "data" {
+				des[k] = decompressAndRedact(v, redact)
+				continue
+			}
+
+			if old, found := cur[k]; found && old != v {
+				des[k] = dryRunRedactedModified
+				continue
+			}
+
+			des[k] = dryRunRedacted
+		}
+
+		for k, v := range cur {
+			if redact, found := rt.compressedSecretKeys[k]; found && field == "data" {
+				cur[k] = decompressAndRedact(v, redact)
+				continue
+			}
+
+			cur[k] = dryRunRedacted
+		}
+	}
+}
+
+// decompressAndRedact returns the redacted content of a base64-encoded and
+// gzipped value. It returns a placeholder if any step fails.
+func decompressAndRedact(v any, redact func([]byte) ([]byte, error)) any {
+	s, ok := v.(string)
+	if !ok {
+		return dryRunRedacted
+	}
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return dryRunRedacted
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return dryRunRedacted
+	}
+	defer gr.Close()
+
+	b, err = io.ReadAll(gr)
+	if err != nil {
+		return dryRunRedacted
+	}
+
+	b, err = redact(b)
+	if err != nil {
+		return dryRunRedacted
+	}
+
+	return string(b)
+}
+
+func isJSONContentType(ct string) bool {
+	mt, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+
+	return mt == "application/json"
+}
@@ -0,0 +1,357 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sutil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestParseAPIRequestPath(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		expected apiRequest
+		ok       bool
+	}{
+		{
+			path: "/api/v1/namespaces/default/secrets/foo",
+			expected: apiRequest{
+				version:   "v1",
+				namespace: "default",
+				resource:  "secrets",
+				name:      "foo",
+			},
+			ok: true,
+		},
+		{
+			path: "/api/v1/namespaces/default/configmaps",
+			expected: apiRequest{
+				version:   "v1",
+				namespace: "default",
+				resource:  "configmaps",
+			},
+			ok: true,
+		},
+		{
+			path: "/api/v1/namespaces/default",
+			expected: apiRequest{
+				version:  "v1",
+				resource: "namespaces",
+				name:     "default",
+			},
+			ok: true,
+		},
+		{
+			path: "/apis/monitoring.coreos.com/v1/namespaces/default/prometheuses/k8s/status",
+			expected: apiRequest{
+				group:       "monitoring.coreos.com",
+				version:     "v1",
+				namespace:   "default",
+				resource:    "prometheuses",
+				name:        "k8s",
+				subresource: "status",
+			},
+			ok: true,
+		},
+		{
+			path: "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews",
+			expected: apiRequest{
+				group:    "authorization.k8s.io",
+				version:  "v1",
+				resource: "selfsubjectaccessreviews",
+			},
+			ok: true,
+		},
+		{
+			path: "/version",
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			r, ok := parseAPIRequestPath(tc.path)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tc.expected, r)
+		})
+	}
+}
+
+type fakeAPIServer struct {
+	mtx      sync.Mutex
+	requests []*http.Request
+	objects  map[string][]byte
+}
+
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.requests = append(f.requests, req)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch req.Method {
+	case http.MethodGet:
+		b, found := f.objects[req.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+			return
+		}
+		_, _ = w.Write(b)
+	case http.MethodDelete:
+		_ = json.NewEncoder(w).Encode(metav1.Status{Status: metav1.StatusSuccess})
+	default:
+		// Echo the request's body like the API server does for dry-run requests.
+		b, _ := io.ReadAll(req.Body)
+		if req.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(b)
+	}
+}
+
+func (f *fakeAPIServer) lastRequest() *http.Request {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	return f.requests[len(f.requests)-1]
+}
+
+func newDryRunTestClient(t *testing.T, f *fakeAPIServer, logs io.Writer, opts ...DryRunOption) kubernetes.Interface {
+	t.Helper()
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	logger := slog.New(slog.NewJSONHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	// The fake API server echoes the request's body hence the JSON content type.
+	cfg := &rest.Config{Host: srv.URL, ContentConfig: rest.ContentConfig{ContentType: "application/json"}}
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return NewDryRunRoundTripper(logger, rt, opts...)
+	})
+
+	kclient, err := kubernetes.NewForConfig(cfg)
+	require.NoError(t, err)
+
+	return kclient
+}
+
+func TestDryRunRoundTripper(t *testing.T) {
+	existing, err := json.Marshal(&v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "cm",
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{"key": "old"},
+	})
+	require.NoError(t, err)
+
+	f := &fakeAPIServer{
+		objects: map[string][]byte{
+			"/api/v1/namespaces/default/configmaps/cm": existing,
+		},
+	}
+
+	var logs bytes.Buffer
+	kclient := newDryRunTestClient(t, f, &logs)
+	ctx := context.Background()
+
+	t.Run("read requests are forwarded", func(t *testing.T) {
+		logs.Reset()
+
+		_, err := kclient.CoreV1().ConfigMaps("default").Get(ctx, "cm", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Empty(t, f.lastRequest().URL.Query().Get("dryRun"))
+		require.Empty(t, logs.String())
+	})
+
+	t.Run("update is executed in dry-run mode", func(t *testing.T) {
+		logs.Reset()
+
+		_, err := kclient.CoreV1().ConfigMaps("default").Update(ctx, &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "cm",
+				Namespace:       "default",
+				ResourceVersion: "1",
+			},
+			Data: map[string]string{"key": "new"},
+		}, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		req := f.lastRequest()
+		require.Equal(t, http.MethodPut, req.Method)
+		require.Equal(t, metav1.DryRunAll, req.URL.Query().Get("dryRun"))
+		require.Equal(t, "application/json", req.Header.Get("Accept"))
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		require.Equal(t, "dry-run: change not persisted", entry["msg"])
+		require.Equal(t, "update", entry["verb"])
+		require.Equal(t, "configmaps", entry["resource"])
+		require.Equal(t, "default", entry["namespace"])
+		require.Equal(t, "cm", entry["name"])
+		require.Contains(t, entry["diff"], `"old"`)
+		require.Contains(t, entry["diff"], `"new"`)
+	})
+
+	t.Run("update without changes", func(t *testing.T) {
+		logs.Reset()
+
+		_, err := kclient.CoreV1().ConfigMaps("default").Update(ctx, &v1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cm",
+				Namespace: "default",
+			},
+			Data: map[string]string{"key": "old"},
+		}, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		require.Equal(t, "dry-run: no change", entry["msg"])
+		require.Equal(t, "DEBUG", entry["level"])
+	})
+
+	t.Run("create is executed in dry-run mode", func(t *testing.T) {
+		logs.Reset()
+
+		_, err := kclient.CoreV1().Secrets("default").Create(ctx, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "default",
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		require.Equal(t, metav1.DryRunAll, f.lastRequest().URL.Query().Get("dryRun"))
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		require.Equal(t, "create", entry["verb"])
+		require.Equal(t, "secret", entry["name"])
+	})
+
+	t.Run("delete is executed in dry-run mode", func(t *testing.T) {
+		logs.Reset()
+
+		err := kclient.CoreV1().ConfigMaps("default").Delete(ctx, "cm", metav1.DeleteOptions{})
+		require.NoError(t, err)
+		require.Equal(t, metav1.DryRunAll, f.lastRequest().URL.Query().Get("dryRun"))
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		require.Equal(t, "delete", entry["verb"])
+	})
+
+	t.Run("access reviews are forwarded", func(t *testing.T) {
+		logs.Reset()
+
+		_, err := kclient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authv1.SelfSubjectAccessReview{}, metav1.CreateOptions{})
+		require.NoError(t, err)
+		require.Empty(t, f.lastRequest().URL.Query().Get("dryRun"))
+		require.Empty(t, logs.String())
+	})
+}
+
+func TestDryRunRoundTripperRedactsSecrets(t *testing.T) {
+	gzipped := func(s string) []byte {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write([]byte(s))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	existing, err := json.Marshal(&v1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"password":       []byte("old-password"),
+			"username":       []byte("admin"),
+			"removed":        []byte("removed-value"),
+			"config.yaml.gz": gzipped("password: old-password\ninterval: 30s\n"),
+		},
+	})
+	require.NoError(t, err)
+
+	f := &fakeAPIServer{
+		objects: map[string][]byte{
+			"/api/v1/namespaces/default/secrets/secret": existing,
+		},
+	}
+
+	var logs bytes.Buffer
+	kclient := newDryRunTestClient(t, f, &logs, WithDryRunCompressedSecretKey("config.yaml.gz", func(b []byte) ([]byte, error) {
+		return []byte(strings.ReplaceAll(strings.ReplaceAll(string(b), "old-password", "<secret>"), "new-password", "<secret>")), nil
+	}))
+
+	_, err = kclient.CoreV1().Secrets("default").Update(context.Background(), &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"password":       []byte("new-password"),
+			"username":       []byte("admin"),
+			"added":          []byte("added-value"),
+			"config.yaml.gz": gzipped("password: new-password\ninterval: 1m\n"),
+		},
+		StringData: map[string]string{
+			"token": "plain-token",
+		},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	require.Equal(t, "dry-run: change not persisted", entry["msg"])
+
+	diff, ok := entry["diff"].(string)
+	require.True(t, ok)
+
+	for _, s := range []string{"old-password", "new-password", "admin", "added-value", "removed-value", "plain-token"} {
+		require.NotContains(t, diff, s)
+	}
+
+	require.Contains(t, diff, `"<redacted (modified)>"`)
+	require.Contains(t, diff, `"added"`)
+	require.Contains(t, diff, `"removed"`)
+	require.Contains(t, diff, `"token"`)
+	require.Contains(t, diff, "interval: 30s")
+	require.Contains(t, diff, "interval: 1m")
+}
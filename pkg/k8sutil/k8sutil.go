@@ -351,6 +351,24 @@ func UpdateDaemonSet(ctx context.Context, dmsClient clientappsv1.DaemonSetInterf
 	})
 }
 
+// UpdateDeployment merges metadata of existing Deployment with new one and updates it.
+func UpdateDeployment(ctx context.Context, dClient clientappsv1.DeploymentInterface, d *appsv1.Deployment) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existingDeployment, err := dClient.Get(ctx, d.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		mergeMetadata(&d.ObjectMeta, existingDeployment.ObjectMeta)
+		// Propagate annotations set by kubectl on spec.template.annotations. e.g performing a rolling restart.
+		mergeKubectlAnnotations(&existingDeployment.Spec.Template.ObjectMeta, d.Spec.Template.ObjectMeta)
+
+		_, err = dClient.Update(ctx, d, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // CreateOrUpdateSecret merges metadata of existing Secret with new one and updates it.
 func CreateOrUpdateSecret(ctx context.Context, secretClient clientv1.SecretInterface, desired *v1.Secret) error {
 	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
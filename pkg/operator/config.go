@@ -109,6 +109,10 @@ func DefaultConfig(cpu, memory string) Config {
 				description: "Updates the status subresource for configuration resources",
 				enabled:     false,
 			},
+			TargetDiscoveryStatusFeature: FeatureGate{
+				description: "Reports a target discovery preview in the status of ServiceMonitor and PodMonitor objects (requires StatusForConfigurationResources)",
+				enabled:     false,
+			},
 		},
 	}
 }
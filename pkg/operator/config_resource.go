@@ -20,7 +20,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -46,15 +48,16 @@ const (
 // ConfigurationResource is a type constraint that permits only the specific pointer types for configuration resources
 // selectable by Prometheus, PrometheusAgent, Alertmanager or ThanosRuler.
 type ConfigurationResource interface {
-	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1.PrometheusRule
+	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1.PrometheusRule | *monitoringv1alpha1.ScrapeClassDefinition | *monitoringv1alpha1.AlertmanagerConfig
 }
 
 // TypedConfigurationResource is a generic type that holds a configuration resource with its validation status.
 type TypedConfigurationResource[T ConfigurationResource] struct {
 	resource   T
-	err        error  // Error encountered during selection or validation (nil if valid).
-	reason     string // Reason for rejection; empty if accepted.
-	generation int64  // Generation of the desired state (spec).
+	err        error    // Error encountered during selection or validation (nil if valid).
+	reason     string   // Reason for rejection or warnings; empty if accepted without warnings.
+	warnings   []string // Non-fatal issues found in a valid resource.
+	generation int64    // Generation of the desired state (spec).
 }
 
 // TypedResourcesSelection represents a map of configuration resources selected by Prometheus or PrometheusAgent.
@@ -69,6 +72,17 @@ func NewTypedConfigurationResource[T ConfigurationResource](res T, err error, re
 	}
 }
 
+// NewTypedConfigurationResourceWithWarnings returns a valid configuration
+// resource with non-fatal issues reported in its Accepted condition.
+func NewTypedConfigurationResourceWithWarnings[T ConfigurationResource](res T, reason string, warnings []string, generation int64) TypedConfigurationResource[T] {
+	return TypedConfigurationResource[T]{
+		resource:   res,
+		reason:     reason,
+		warnings:   warnings,
+		generation: generation,
+	}
+}
+
 func (r *TypedConfigurationResource[T]) Resource() T {
 	return r.resource
 }
@@ -83,9 +97,12 @@ func (r *TypedConfigurationResource[T]) Conditions() []monitoringv1.ConfigResour
 		ObservedGeneration: r.generation,
 	}
 
-	if r.err != nil {
+	switch {
+	case r.err != nil:
 		condition.Status = monitoringv1.ConditionFalse
 		condition.Message = r.err.Error()
+	case len(r.warnings) > 0:
+		condition.Message = strings.Join(r.warnings, "; ")
 	}
 
 	return []monitoringv1.ConfigResourceCondition{condition}
@@ -103,6 +120,32 @@ func (resources TypedResourcesSelection[T]) ValidResources() map[string]T {
 	return validRes
 }
 
+// ResourceSelectionStatuses returns the statuses of the selected and rejected
+// resources. The kind argument is the Kubernetes kind of the resources (e.g.
+// "ServiceMonitor").
+func (resources TypedResourcesSelection[T]) ResourceSelectionStatuses(kind string) ([]ResourceSelectionStatus, []ResourceSelectionStatus) {
+	var selected, rejected []ResourceSelectionStatus
+	for k, res := range resources {
+		ns, name, _ := strings.Cut(k, "/")
+		status := ResourceSelectionStatus{
+			Kind:      kind,
+			Namespace: ns,
+			Name:      name,
+		}
+
+		if res.err == nil {
+			selected = append(selected, status)
+			continue
+		}
+
+		status.Reason = res.reason
+		status.Message = res.err.Error()
+		rejected = append(rejected, status)
+	}
+
+	return selected, rejected
+}
+
 // ConfigResourceSyncer patches the status of configuration resources.
 type ConfigResourceSyncer struct {
 	client   dynamic.Interface
@@ -145,18 +188,19 @@ func (crs *ConfigResourceSyncer) GetBindingIndex(bindings []monitoringv1.Workloa
 	return -1
 }
 
-func (crs *ConfigResourceSyncer) newBinding(conditions []monitoringv1.ConfigResourceCondition) monitoringv1.WorkloadBinding {
+func (crs *ConfigResourceSyncer) newBinding(conditions []monitoringv1.ConfigResourceCondition, td *monitoringv1.TargetDiscoveryStatus) monitoringv1.WorkloadBinding {
 	return monitoringv1.WorkloadBinding{
-		Namespace:  crs.workload.GetNamespace(),
-		Name:       crs.workload.GetName(),
-		Resource:   crs.gvr.Resource,
-		Group:      crs.gvr.Group,
-		Conditions: conditions,
+		Namespace:       crs.workload.GetNamespace(),
+		Name:            crs.workload.GetName(),
+		Resource:        crs.gvr.Resource,
+		Group:           crs.gvr.Group,
+		Conditions:      conditions,
+		TargetDiscovery: td,
 	}
 }
 
-func (crs *ConfigResourceSyncer) newUnstructuredBinding(conditions []monitoringv1.ConfigResourceCondition) (map[string]any, error) {
-	b, err := json.Marshal(crs.newBinding(conditions))
+func (crs *ConfigResourceSyncer) newUnstructuredBinding(binding monitoringv1.WorkloadBinding) (map[string]any, error) {
+	b, err := json.Marshal(binding)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +225,32 @@ type ConfigurationObject interface {
 // status subresource.
 // If the binding is up-to-date, this is a no-operation.
 func (crs *ConfigResourceSyncer) UpdateBinding(ctx context.Context, configResource ConfigurationObject, conditions []monitoringv1.ConfigResourceCondition) error {
+	return crs.updateBinding(ctx, configResource, crs.newBinding(conditions, nil), false)
+}
+
+// UpdateBindingWithTargetDiscovery is similar to UpdateBinding but it also
+// updates the target discovery preview of the binding. A nil value removes
+// the preview from the binding.
+func (crs *ConfigResourceSyncer) UpdateBindingWithTargetDiscovery(ctx context.Context, configResource ConfigurationObject, conditions []monitoringv1.ConfigResourceCondition, td *monitoringv1.TargetDiscoveryStatus) error {
+	return crs.updateBinding(ctx, configResource, crs.newBinding(conditions, td), true)
+}
+
+// UpdateTargetDiscovery updates the target discovery preview of the
+// workload's binding without modifying its conditions.
+// If the resource has no binding for the workload (it is added by the next
+// reconciliation) or if the preview is up-to-date, this is a no-operation.
+func (crs *ConfigResourceSyncer) UpdateTargetDiscovery(ctx context.Context, configResource ConfigurationObject, td *monitoringv1.TargetDiscoveryStatus) error {
+	bindings := configResource.Bindings()
+
+	i := crs.GetBindingIndex(bindings)
+	if i < 0 {
+		return nil
+	}
+
+	return crs.updateBinding(ctx, configResource, crs.newBinding(bindings[i].Conditions, td), true)
+}
+
+func (crs *ConfigResourceSyncer) updateBinding(ctx context.Context, configResource ConfigurationObject, binding monitoringv1.WorkloadBinding, withTargetDiscovery bool) error {
 	bindings := configResource.Bindings()
 
 	if len(bindings) == 0 {
@@ -195,12 +265,12 @@ func (crs *ConfigResourceSyncer) UpdateBinding(ctx context.Context, configResour
 			Object: map[string]any{},
 		}
 
-		binding, err := crs.newUnstructuredBinding(conditions)
+		content, err := crs.newUnstructuredBinding(binding)
 		if err != nil {
 			return err
 		}
 
-		if err := unstructured.SetNestedSlice(obj.Object, []any{binding}, "status", "bindings"); err != nil {
+		if err := unstructured.SetNestedSlice(obj.Object, []any{content}, "status", "bindings"); err != nil {
 			return err
 		}
 
@@ -223,7 +293,7 @@ func (crs *ConfigResourceSyncer) UpdateBinding(ctx context.Context, configResour
 		return nil
 	}
 
-	patch, err := crs.updateBindingPatch(bindings, conditions)
+	patch, err := crs.updateBindingPatch(bindings, binding, withTargetDiscovery)
 	if err != nil {
 		return fmt.Errorf("failed to build patch status: %w", err)
 	}
@@ -335,9 +405,11 @@ func toGroupVersionResource(o runtime.Object) schema.GroupVersionResource {
 
 // updateBindingPatch returns a RFC-6902 JSON patch which updates the
 // conditions of the resource's status.
+// When withTargetDiscovery is true, the patch also updates the target
+// discovery preview.
 // If the binding doesn't exist, the patch adds it to the status.
 // If the binding is already up-to-date, the return value is empty.
-func (crs *ConfigResourceSyncer) updateBindingPatch(bindings []monitoringv1.WorkloadBinding, conditions []monitoringv1.ConfigResourceCondition) ([]byte, error) {
+func (crs *ConfigResourceSyncer) updateBindingPatch(bindings []monitoringv1.WorkloadBinding, binding monitoringv1.WorkloadBinding, withTargetDiscovery bool) ([]byte, error) {
 	i := crs.GetBindingIndex(bindings)
 	if i < 0 {
 		// Append the workload binding to the slice.
@@ -345,26 +417,44 @@ func (crs *ConfigResourceSyncer) updateBindingPatch(bindings []monitoringv1.Work
 			patchOperation{
 				Op:    "add",
 				Path:  "/status/bindings/-",
-				Value: crs.newBinding(conditions),
+				Value: binding,
 			},
 		})
 	}
 
-	// No need to update the binding if the conditions haven't changed
-	if equalConfigResourceConditions(bindings[i].Conditions, conditions) {
+	var ops patch
+
+	// No need to update the conditions if they haven't changed.
+	if !equalConfigResourceConditions(bindings[i].Conditions, binding.Conditions) {
+		ops = append(ops, patchOperation{
+			Op:    "replace",
+			Path:  fmt.Sprintf("/status/bindings/%d/conditions", i),
+			Value: binding.Conditions,
+		})
+	}
+
+	if withTargetDiscovery && !equality.Semantic.DeepEqual(bindings[i].TargetDiscovery, binding.TargetDiscovery) {
+		op := patchOperation{
+			Op:    "add",
+			Path:  fmt.Sprintf("/status/bindings/%d/targetDiscovery", i),
+			Value: binding.TargetDiscovery,
+		}
+
+		if binding.TargetDiscovery == nil {
+			op = patchOperation{
+				Op:   "remove",
+				Path: op.Path,
+			}
+		}
+
+		ops = append(ops, op)
+	}
+
+	if len(ops) == 0 {
 		return nil, nil
 	}
 
-	return json.Marshal(
-		append(
-			crs.testBindingExists(i),
-			patchOperation{
-				Op:    "replace",
-				Path:  fmt.Sprintf("/status/bindings/%d/conditions", i),
-				Value: conditions,
-			},
-		),
-	)
+	return json.Marshal(append(crs.testBindingExists(i), ops...))
 }
 
 // removeBindingPatch returns a RFC-6902 JSON patch which removes the
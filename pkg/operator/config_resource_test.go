@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
@@ -207,3 +208,82 @@ func TestConfigResStatusConditionsEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBindingPatchWithTargetDiscovery(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PrometheusesKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+	}
+	crs := NewConfigResourceSyncer(p, nil, nil)
+
+	conditions := []monitoringv1.ConfigResourceCondition{
+		{
+			Type:   monitoringv1.Accepted,
+			Status: monitoringv1.ConditionTrue,
+		},
+	}
+	td := &monitoringv1.TargetDiscoveryStatus{
+		Services: ptr.To(int32(1)),
+		Endpoints: []monitoringv1.EndpointDiscoveryStatus{
+			{Index: 0, MatchedPorts: []string{"web"}, Targets: 2},
+		},
+	}
+
+	bindings := []monitoringv1.WorkloadBinding{crs.newBinding(conditions, td)}
+
+	// Same conditions and target discovery.
+	b, err := crs.updateBindingPatch(bindings, crs.newBinding(conditions, td), true)
+	require.NoError(t, err)
+	require.Empty(t, b)
+
+	// The target discovery is ignored.
+	b, err = crs.updateBindingPatch(bindings, crs.newBinding(conditions, nil), false)
+	require.NoError(t, err)
+	require.Empty(t, b)
+
+	// The target discovery has changed.
+	b, err = crs.updateBindingPatch(bindings, crs.newBinding(conditions, &monitoringv1.TargetDiscoveryStatus{Services: ptr.To(int32(0))}), true)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `{"op":"add","path":"/status/bindings/0/targetDiscovery","value":{"services":0}}`)
+	require.NotContains(t, string(b), "/status/bindings/0/conditions")
+
+	// The target discovery is removed.
+	b, err = crs.updateBindingPatch(bindings, crs.newBinding(conditions, nil), true)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `{"op":"remove","path":"/status/bindings/0/targetDiscovery"}`)
+}
+
+func TestUpdateTargetDiscovery(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PrometheusesKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+	}
+	// The client is nil: the calls below must not send any request.
+	crs := NewConfigResourceSyncer(p, nil, nil)
+
+	td := &monitoringv1.TargetDiscoveryStatus{Services: ptr.To(int32(1))}
+
+	// No binding for the workload.
+	smon := &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "smon", Namespace: "default"},
+	}
+	require.NoError(t, crs.UpdateTargetDiscovery(t.Context(), smon, td))
+
+	// The binding is up-to-date.
+	smon.Status.Bindings = []monitoringv1.WorkloadBinding{
+		crs.newBinding([]monitoringv1.ConfigResourceCondition{{Type: monitoringv1.Accepted, Status: monitoringv1.ConditionTrue}}, td),
+	}
+	require.NoError(t, crs.UpdateTargetDiscovery(t.Context(), smon, &monitoringv1.TargetDiscoveryStatus{Services: ptr.To(int32(1))}))
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const debugPathPrefix = "/debug/"
+
+// ResourceSelectionStatus describes the outcome of the selection of a
+// configuration resource (ServiceMonitor, PodMonitor, Probe, ScrapeConfig or
+// PrometheusRule) by a workload.
+type ResourceSelectionStatus struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Reason and Message explain why the resource has been rejected. They are
+	// empty for selected resources.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func sortResourceSelectionStatuses(statuses []ResourceSelectionStatus) {
+	slices.SortFunc(statuses, func(a, b ResourceSelectionStatus) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+}
+
+type debugInfo struct {
+	config           []byte
+	configUpdateTime time.Time
+
+	selected           []ResourceSelectionStatus
+	rejected           []ResourceSelectionStatus
+	resourceUpdateTime time.Time
+}
+
+// DebugStore records the outcome of the last reconciliation of workload
+// resources (e.g. Prometheus) and exposes it over HTTP.
+//
+// The following endpoints are available where `<resource>` is one of the
+// resources passed to Register() (e.g. "prometheus" or "prometheusagent"):
+//   - /debug/<resource>/<namespace>/<name>/config returns the last generated
+//     configuration (with secrets redacted).
+//   - /debug/<resource>/<namespace>/<name>/selected-resources returns the
+//     configuration resources selected by the workload.
+//   - /debug/<resource>/<namespace>/<name>/rejected-resources returns the
+//     configuration resources rejected by the workload and the reason.
+//
+// A nil DebugStore is valid and discards all the information.
+type DebugStore struct {
+	mtx     sync.RWMutex
+	objects map[string]*debugInfo
+}
+
+// NewDebugStore returns an empty DebugStore.
+func NewDebugStore() *DebugStore {
+	return &DebugStore{
+		objects: map[string]*debugInfo{},
+	}
+}
+
+// debugKey returns the key identifying the given workload. The key argument
+// uses the `<namespace>/<name>` format.
+func debugKey(resource, key string) string {
+	return resource + "/" + key
+}
+
+func (ds *DebugStore) getOrCreate(k string) *debugInfo {
+	di, found := ds.objects[k]
+	if !found {
+		di = &debugInfo{}
+		ds.objects[k] = di
+	}
+
+	return di
+}
+
+// SetConfiguration records the last configuration generated for the workload
+// identified by resource and key (`<namespace>/<name>`).
+// The caller is responsible for redacting the secrets.
+func (ds *DebugStore) SetConfiguration(resource, key string, config []byte) {
+	if ds == nil {
+		return
+	}
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	di := ds.getOrCreate(debugKey(resource, key))
+	di.config = config
+	di.configUpdateTime = time.Now().UTC()
+}
+
+// SetResourceSelection records the configuration resources selected and
+// rejected by the workload identified by resource and key (`<namespace>/<name>`).
+func (ds *DebugStore) SetResourceSelection(resource, key string, selected, rejected []ResourceSelectionStatus) {
+	if ds == nil {
+		return
+	}
+
+	sortResourceSelectionStatuses(selected)
+	sortResourceSelectionStatuses(rejected)
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	di := ds.getOrCreate(debugKey(resource, key))
+	di.selected = selected
+	di.rejected = rejected
+	di.resourceUpdateTime = time.Now().UTC()
+}
+
+// ForgetObject removes the information about the workload identified by
+// resource and key (`<namespace>/<name>`).
+// It should be called when the controller detects that the object has been deleted.
+func (ds *DebugStore) ForgetObject(resource, key string) {
+	if ds == nil {
+		return
+	}
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	delete(ds.objects, debugKey(resource, key))
+}
+
+// Register registers the HTTP handlers of the debug API for the given
+// workload resources (e.g. "prometheus").
+func (ds *DebugStore) Register(mux *http.ServeMux, resources ...string) {
+	for _, resource := range resources {
+		prefix := "GET " + debugPathPrefix + resource + "/{namespace}/{name}/"
+		mux.HandleFunc(prefix+"config", ds.serveConfig(resource))
+		mux.HandleFunc(prefix+"selected-resources", ds.serveResourceSelection(resource, func(di debugInfo) []ResourceSelectionStatus { return di.selected }))
+		mux.HandleFunc(prefix+"rejected-resources", ds.serveResourceSelection(resource, func(di debugInfo) []ResourceSelectionStatus { return di.rejected }))
+	}
+}
+
+// get returns a copy of the debug information for the workload targeted by
+// the request. The second value is false if the workload is unknown.
+func (ds *DebugStore) get(resource string, req *http.Request) (debugInfo, bool) {
+	k := debugKey(resource, req.PathValue("namespace")+"/"+req.PathValue("name"))
+
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+
+	di, found := ds.objects[k]
+	if !found {
+		return debugInfo{}, false
+	}
+
+	return *di, true
+}
+
+func (ds *DebugStore) serveConfig(resource string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		di, found := ds.get(resource, req)
+		if !found || di.config == nil {
+			http.Error(w, "configuration not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Last-Modified", di.configUpdateTime.Format(http.TimeFormat))
+		_, _ = w.Write(di.config)
+	}
+}
+
+type resourceSelectionResponse struct {
+	LastUpdateTime time.Time                 `json:"lastUpdateTime"`
+	Resources      []ResourceSelectionStatus `json:"resources"`
+}
+
+func (ds *DebugStore) serveResourceSelection(resource string, fn func(debugInfo) []ResourceSelectionStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		di, found := ds.get(resource, req)
+		if !found || di.resourceUpdateTime.IsZero() {
+			http.Error(w, "resource selection not found", http.StatusNotFound)
+			return
+		}
+
+		resp := resourceSelectionResponse{
+			LastUpdateTime: di.resourceUpdateTime,
+			Resources:      fn(di),
+		}
+		if resp.Resources == nil {
+			resp.Resources = []ResourceSelectionStatus{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
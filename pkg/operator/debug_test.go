@@ -0,0 +1,153 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestResourceSelectionStatuses(t *testing.T) {
+	smons := TypedResourcesSelection[*monitoringv1.ServiceMonitor]{
+		"ns1/valid": NewTypedConfigurationResource(&monitoringv1.ServiceMonitor{}, nil, "", 1),
+		"ns2/invalid": NewTypedConfigurationResource(
+			&monitoringv1.ServiceMonitor{},
+			errors.New("invalid relabeling"),
+			InvalidConfiguration,
+			1,
+		),
+	}
+
+	selected, rejected := smons.ResourceSelectionStatuses(monitoringv1.ServiceMonitorsKind)
+	require.Equal(t, []ResourceSelectionStatus{{Kind: "ServiceMonitor", Namespace: "ns1", Name: "valid"}}, selected)
+	require.Equal(t, []ResourceSelectionStatus{
+		{
+			Kind:      "ServiceMonitor",
+			Namespace: "ns2",
+			Name:      "invalid",
+			Reason:    InvalidConfiguration,
+			Message:   "invalid relabeling",
+		},
+	}, rejected)
+}
+
+func TestDebugStore(t *testing.T) {
+	ds := NewDebugStore()
+
+	mux := http.NewServeMux()
+	// Ensure that the debug handlers don't conflict with the pprof handlers.
+	mux.Handle("/debug/pprof/", http.NotFoundHandler())
+	ds.Register(mux, "prometheus")
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	get := func(t *testing.T, path string) (*http.Response, []byte) {
+		t.Helper()
+
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, b
+	}
+
+	t.Run("unknown object", func(t *testing.T) {
+		for _, path := range []string{
+			"/debug/prometheus/default/k8s/config",
+			"/debug/prometheus/default/k8s/selected-resources",
+			"/debug/prometheus/default/k8s/rejected-resources",
+		} {
+			resp, _ := get(t, path)
+			require.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+		}
+	})
+
+	ds.SetConfiguration("prometheus", "default/k8s", []byte("global:\n  scrape_interval: 30s\n"))
+	ds.SetResourceSelection(
+		"prometheus",
+		"default/k8s",
+		[]ResourceSelectionStatus{
+			{Kind: "ServiceMonitor", Namespace: "default", Name: "b"},
+			{Kind: "PodMonitor", Namespace: "default", Name: "c"},
+			{Kind: "ServiceMonitor", Namespace: "default", Name: "a"},
+		},
+		nil,
+	)
+
+	t.Run("config", func(t *testing.T) {
+		resp, b := get(t, "/debug/prometheus/default/k8s/config")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/yaml", resp.Header.Get("Content-Type"))
+		require.NotEmpty(t, resp.Header.Get("Last-Modified"))
+		require.Equal(t, "global:\n  scrape_interval: 30s\n", string(b))
+	})
+
+	t.Run("selected resources", func(t *testing.T) {
+		resp, b := get(t, "/debug/prometheus/default/k8s/selected-resources")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var got resourceSelectionResponse
+		require.NoError(t, json.Unmarshal(b, &got))
+		require.False(t, got.LastUpdateTime.IsZero())
+		require.Equal(t, []ResourceSelectionStatus{
+			{Kind: "PodMonitor", Namespace: "default", Name: "c"},
+			{Kind: "ServiceMonitor", Namespace: "default", Name: "a"},
+			{Kind: "ServiceMonitor", Namespace: "default", Name: "b"},
+		}, got.Resources)
+	})
+
+	t.Run("rejected resources", func(t *testing.T) {
+		resp, b := get(t, "/debug/prometheus/default/k8s/rejected-resources")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(b, &got))
+		require.Equal(t, []any{}, got["resources"])
+	})
+
+	t.Run("unregistered resource", func(t *testing.T) {
+		resp, _ := get(t, "/debug/prometheusagent/default/k8s/config")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("forget object", func(t *testing.T) {
+		ds.ForgetObject("prometheus", "default/k8s")
+
+		resp, _ := get(t, "/debug/prometheus/default/k8s/config")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestNilDebugStore(t *testing.T) {
+	var ds *DebugStore
+
+	require.NotPanics(t, func() {
+		ds.SetConfiguration("prometheus", "default/k8s", []byte{})
+		ds.SetResourceSelection("prometheus", "default/k8s", nil, nil)
+		ds.ForgetObject("prometheus", "default/k8s")
+	})
+}
@@ -34,6 +34,9 @@ const (
 
 	// StatusForConfigurationResourcesFeature enables the status subresource for Prometheus-Operator Config Objects.
 	StatusForConfigurationResourcesFeature FeatureGateName = "StatusForConfigurationResources"
+
+	// TargetDiscoveryStatusFeature enables the target discovery preview in the status of ServiceMonitor and PodMonitor objects.
+	TargetDiscoveryStatusFeature FeatureGateName = "TargetDiscoveryStatus"
 )
 
 type FeatureGateName string
@@ -122,6 +122,21 @@ func (rt *ReconciliationTracker) HasRefTo(key string, obj runtime.Object) bool {
 	return refTracker.Has(obj)
 }
 
+// IsReferenced returns true if any tracked object has a direct or indirect
+// reference to obj (secret or configmap).
+func (rt *ReconciliationTracker) IsReferenced(obj runtime.Object) bool {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	for _, refTracker := range rt.refTracker {
+		if refTracker.Has(obj) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // UpdateReferenceTracker updates the reference tracker for the object identified by key.
 func (rt *ReconciliationTracker) UpdateReferenceTracker(key string, refTracker ReferenceTracker) {
 	rt.init()
@@ -325,6 +340,14 @@ func NewFakeRecorder(bufferSize int, related runtime.Object) *EventRecorder {
 	}
 }
 
+// NewNoopEventRecorder returns an EventRecorder which discards all events.
+func NewNoopEventRecorder(related runtime.Object) *EventRecorder {
+	return &EventRecorder{
+		related: related,
+		er:      &events.FakeRecorder{},
+	}
+}
+
 // Eventf records a Kubernetes event.
 func (er *EventRecorder) Eventf(regarding runtime.Object, eventtype, reason, action, note string, args ...any) {
 	er.er.Eventf(
@@ -405,6 +405,9 @@ func (rr *ResourceReconciler) OnAdd(obj any, _ bool) {
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetAdd(v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentAdd(v)
+		return
 	}
 
 	key, ok := rr.objectKey(obj)
@@ -436,6 +439,9 @@ func (rr *ResourceReconciler) OnUpdate(old, cur any) {
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetUpdate(old.(*appsv1.StatefulSet), v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentUpdate(old.(*appsv1.Deployment), v)
+		return
 	}
 
 	key, ok := rr.objectKey(cur)
@@ -480,6 +486,9 @@ func (rr *ResourceReconciler) OnDelete(obj any) {
 	case *appsv1.StatefulSet:
 		rr.onStatefulSetDelete(v)
 		return
+	case *appsv1.Deployment:
+		rr.onDeploymentDelete(v)
+		return
 	}
 
 	key, ok := rr.objectKey(obj)
@@ -607,6 +616,56 @@ func (rr *ResourceReconciler) onDaemonSetDelete(ds *appsv1.DaemonSet) {
 	rr.EnqueueForReconciliation(obj)
 }
 
+func (rr *ResourceReconciler) onDeploymentAdd(d *appsv1.Deployment) {
+	obj := rr.resolve(d)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment added")
+
+	rr.EnqueueForReconciliation(obj)
+}
+
+func (rr *ResourceReconciler) onDeploymentUpdate(old, cur *appsv1.Deployment) {
+	rr.logger.Debug("update handler", "resource", "deployment", "old", old.ResourceVersion, "cur", cur.ResourceVersion)
+
+	if rr.DeletionInProgress(cur) {
+		return
+	}
+
+	if !rr.hasObjectChanged(old, cur) {
+		return
+	}
+
+	obj := rr.resolve(cur)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment updated")
+	if !rr.hasStateChanged(old, cur) {
+		// If the deployment state (spec, labels or annotations) hasn't
+		// changed, the operator can only update the status subresource instead
+		// of doing a full reconciliation.
+		rr.EnqueueForStatus(obj)
+		return
+	}
+
+	rr.EnqueueForReconciliation(obj)
+}
+
+func (rr *ResourceReconciler) onDeploymentDelete(d *appsv1.Deployment) {
+	obj := rr.resolve(d)
+	if obj == nil {
+		return
+	}
+
+	rr.logger.Debug("Deployment delete")
+
+	rr.EnqueueForReconciliation(obj)
+}
+
 // EnqueueForReconciliation asks for reconciling the object.
 func (rr *ResourceReconciler) EnqueueForReconciliation(obj metav1.Object) {
 	if !rr.isManagedByController(obj) {
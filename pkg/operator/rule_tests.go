@@ -0,0 +1,751 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/template"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/prometheus/prometheus/tsdb/chunks"
+	"github.com/prometheus/prometheus/util/almost"
+	"github.com/prometheus/prometheus/util/annotations"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	defaultRuleTestInterval = time.Minute
+
+	// ruleTestTimeout is the maximum duration of all the tests of a
+	// PrometheusRule object. It is lower than the default timeout of the
+	// admission webhooks (10s) for the API server to receive the rejection.
+	ruleTestTimeout = 5 * time.Second
+
+	// maxRuleTestSeries is the maximum number of input series for all the
+	// tests of a PrometheusRule object.
+	maxRuleTestSeries = 1000
+
+	// maxRuleTestSamples is the maximum number of input samples for all the
+	// tests of a PrometheusRule object.
+	maxRuleTestSamples = 100000
+
+	// maxRuleTestEvaluations is the maximum number of rule evaluations for a
+	// single test.
+	maxRuleTestEvaluations = 10000
+
+	// epsilon is the tolerance used to compare the values of the samples
+	// (same value as promtool).
+	epsilon = 0.000001
+)
+
+// RunRuleTests evaluates the unit tests of the PrometheusRule spec and
+// returns an error for each failed test.
+// It assumes that the rules have been validated with ValidateRule().
+func RunRuleTests(promRuleSpec monitoringv1.PrometheusRuleSpec) []error {
+	if len(promRuleSpec.Tests) == 0 {
+		return nil
+	}
+
+	// Check the size of the input series before expanding them to avoid
+	// exhausting the memory.
+	if err := checkRuleTestsInputSize(promRuleSpec.Tests); err != nil {
+		return []error{err}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ruleTestTimeout)
+	defer cancel()
+
+	var errs []error
+	for i, test := range promRuleSpec.Tests {
+		name := test.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+
+		for _, err := range runRuleTest(ctx, promRuleSpec.Groups, test) {
+			errs = append(errs, fmt.Errorf("test %q: %w", name, err))
+		}
+	}
+
+	return errs
+}
+
+// checkRuleTestsInputSize returns an error if the tests define more input
+// series or samples than allowed.
+func checkRuleTestsInputSize(tests []monitoringv1.RuleTest) error {
+	var series, samples int
+	for _, test := range tests {
+		series += len(test.InputSeries)
+		if series > maxRuleTestSeries {
+			return fmt.Errorf("too many input series (max: %d)", maxRuleTestSeries)
+		}
+
+		for _, in := range test.InputSeries {
+			samples += countRuleTestSamples(in.Values, maxRuleTestSamples-samples)
+			if samples > maxRuleTestSamples {
+				return fmt.Errorf("too many input samples (max: %d)", maxRuleTestSamples)
+			}
+		}
+	}
+
+	return nil
+}
+
+// countRuleTestSamples returns the number of samples described by the values
+// of an input series (e.g. "1+1x100 _x3 {{schema:0 sum:1 count:1}}x2")
+// without expanding them. The result can be greater than the actual number of
+// samples for malformed values but it stops counting once the limit is
+// exceeded.
+func countRuleTestSamples(values string, limit int) int {
+	var (
+		n     int
+		depth int
+		start = -1
+	)
+
+	// Split the values on white spaces, except inside the histogram
+	// descriptions.
+	for i := 0; i <= len(values); i++ {
+		if i < len(values) {
+			switch values[i] {
+			case '{':
+				depth++
+			case '}':
+				depth = max(depth-1, 0)
+			}
+
+			if depth > 0 || (values[i] != ' ' && values[i] != '\t' && values[i] != '\n') {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+		}
+
+		if start < 0 {
+			continue
+		}
+
+		n += countRuleTestTokenSamples(values[start:i], limit-n)
+		if n > limit {
+			return n
+		}
+		start = -1
+	}
+
+	return n
+}
+
+func countRuleTestTokenSamples(token string, limit int) int {
+	// The repetition suffix follows the histogram description.
+	i := strings.LastIndex(token, "x")
+	if i < 0 || i < strings.LastIndex(token, "}") {
+		return 1
+	}
+
+	repeat, err := strconv.ParseUint(token[i+1:], 10, 64)
+	if err != nil {
+		return 1
+	}
+
+	if repeat >= uint64(limit) {
+		return limit + 1
+	}
+
+	// "_xN" omits N samples while "AxN" and "A+BxN" expand to N+1 samples.
+	if strings.HasPrefix(token, "_") {
+		return int(repeat)
+	}
+
+	return int(repeat) + 1
+}
+
+func parseRuleTestDuration(d *monitoringv1.Duration, defaultValue time.Duration) (time.Duration, error) {
+	if d == nil || *d == "" {
+		return defaultValue, nil
+	}
+
+	v, err := model.ParseDuration(string(*d))
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(v), nil
+}
+
+// ruleTestAlert is an alert generated by an alerting rule.
+type ruleTestAlert struct {
+	labels      labels.Labels
+	annotations labels.Labels
+	activeAt    time.Time
+	firing      bool
+	// keepFiringSince is the time at which the alert's condition stopped
+	// being true while the alert was firing.
+	keepFiringSince time.Time
+}
+
+type ruleTestEvaluator struct {
+	engine  *promql.Engine
+	storage *ruleTestStorage
+	groups  []monitoringv1.RuleGroup
+
+	// alerts holds the active alerts keyed by alerting rule (group and rule
+	// indexes) and by label set.
+	alerts map[[2]int]map[uint64]*ruleTestAlert
+}
+
+func runRuleTest(ctx context.Context, groups []monitoringv1.RuleGroup, test monitoringv1.RuleTest) []error {
+	interval, err := parseRuleTestDuration(test.Interval, defaultRuleTestInterval)
+	if err != nil {
+		return []error{fmt.Errorf("invalid interval: %w", err)}
+	}
+
+	evalInterval, err := parseRuleTestDuration(test.EvaluationInterval, defaultRuleTestInterval)
+	if err != nil {
+		return []error{fmt.Errorf("invalid evaluationInterval: %w", err)}
+	}
+
+	if interval <= 0 || evalInterval <= 0 {
+		return []error{errors.New("interval and evaluationInterval must be greater than 0")}
+	}
+
+	st := newRuleTestStorage()
+	for i, in := range test.InputSeries {
+		if err := st.addInputSeries(in, interval); err != nil {
+			return []error{fmt.Errorf("inputSeries[%d]: %w", i, err)}
+		}
+	}
+
+	// Collect the evaluation times.
+	var (
+		maxEvalTime time.Duration
+		alertTests  = make(map[time.Duration][]monitoringv1.AlertRuleTest)
+	)
+	for i, at := range test.AlertRuleTests {
+		d, err := parseRuleTestDuration(&at.EvalTime, 0)
+		if err != nil {
+			return []error{fmt.Errorf("alertRuleTests[%d]: invalid evalTime: %w", i, err)}
+		}
+
+		// Alerts are checked right after the last evaluation happening before
+		// the evaluation time.
+		d = d.Truncate(evalInterval)
+		alertTests[d] = append(alertTests[d], at)
+		maxEvalTime = max(maxEvalTime, d)
+	}
+
+	promqlTestTimes := make([]time.Duration, len(test.PromQLExprTests))
+	for i, pt := range test.PromQLExprTests {
+		d, err := parseRuleTestDuration(&pt.EvalTime, 0)
+		if err != nil {
+			return []error{fmt.Errorf("promqlExprTests[%d]: invalid evalTime: %w", i, err)}
+		}
+
+		promqlTestTimes[i] = d
+		maxEvalTime = max(maxEvalTime, d)
+	}
+
+	if maxEvalTime/evalInterval > maxRuleTestEvaluations {
+		return []error{fmt.Errorf("too many rule evaluations (max: %d), increase evaluationInterval or decrease evalTime", maxRuleTestEvaluations)}
+	}
+
+	ev := &ruleTestEvaluator{
+		engine: promql.NewEngine(promql.EngineOpts{
+			MaxSamples:           10 * maxRuleTestSamples,
+			Timeout:              ruleTestTimeout,
+			EnableAtModifier:     true,
+			EnableNegativeOffset: true,
+			NoStepSubqueryIntervalFn: func(int64) int64 {
+				return evalInterval.Milliseconds()
+			},
+		}),
+		storage: st,
+		groups:  groups,
+		alerts:  make(map[[2]int]map[uint64]*ruleTestAlert),
+	}
+
+	var errs []error
+	for d := time.Duration(0); d <= maxEvalTime; d += evalInterval {
+		ts := time.Unix(0, 0).UTC().Add(d)
+
+		if err := ev.evaluate(ctx, ts); err != nil {
+			return append(errs, fmt.Errorf("time %s: %w", model.Duration(d), err))
+		}
+
+		for _, at := range alertTests[d] {
+			if err := ev.checkAlerts(at); err != nil {
+				errs = append(errs, fmt.Errorf("alertname %q, time %s: %w", at.Alertname, at.EvalTime, err))
+			}
+		}
+	}
+
+	for i, pt := range test.PromQLExprTests {
+		ts := time.Unix(0, 0).UTC().Add(promqlTestTimes[i])
+		if err := ev.checkPromQLExpr(ctx, pt, ts); err != nil {
+			errs = append(errs, fmt.Errorf("expr %q, time %s: %w", pt.Expr, pt.EvalTime, err))
+		}
+	}
+
+	return errs
+}
+
+// query evaluates the PromQL expression at the given time.
+func (ev *ruleTestEvaluator) query(ctx context.Context, expr string, ts time.Time) (promql.Vector, error) {
+	q, err := ev.engine.NewInstantQuery(ctx, ev.storage, nil, expr, ts)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	res := q.Exec(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	switch v := res.Value.(type) {
+	case promql.Vector:
+		return v, nil
+	case promql.Scalar:
+		return promql.Vector{promql.Sample{T: v.T, F: v.V, Metric: labels.EmptyLabels()}}, nil
+	default:
+		return nil, errors.New("rule result is not a vector or scalar")
+	}
+}
+
+// evaluate evaluates all the rules sequentially at the given time.
+func (ev *ruleTestEvaluator) evaluate(ctx context.Context, ts time.Time) error {
+	for i, g := range ev.groups {
+		for j, r := range g.Rules {
+			vec, err := ev.query(ctx, r.Expr.String(), ts)
+			if err != nil {
+				return fmt.Errorf("group %q, rule %d: %w", g.Name, j, err)
+			}
+
+			ruleLabels := make(map[string]string, len(g.Labels)+len(r.Labels))
+			maps.Copy(ruleLabels, g.Labels)
+			maps.Copy(ruleLabels, r.Labels)
+
+			if r.Record != "" {
+				for _, smpl := range vec {
+					lb := labels.NewBuilder(smpl.Metric)
+					lb.Set(labels.MetricName, r.Record)
+					for k, v := range ruleLabels {
+						lb.Set(k, v)
+					}
+					ev.storage.append(lb.Labels(), ts, smpl.F, smpl.H)
+				}
+				continue
+			}
+
+			if err := ev.evaluateAlertingRule(ctx, [2]int{i, j}, r, ruleLabels, vec, ts); err != nil {
+				return fmt.Errorf("group %q, alert %q: %w", g.Name, r.Alert, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ev *ruleTestEvaluator) expandTemplate(ctx context.Context, name, text string, smpl promql.Sample, ts time.Time) (string, error) {
+	const defs = "{{$labels := .Labels}}{{$externalLabels := .ExternalLabels}}{{$externalURL := .ExternalURL}}{{$value := .Value}}"
+
+	expander := template.NewTemplateExpander(
+		ctx,
+		defs+text,
+		name,
+		template.AlertTemplateData(smpl.Metric.Map(), nil, "", smpl),
+		model.TimeFromUnixNano(ts.UnixNano()),
+		func(ctx context.Context, q string, ts time.Time) (promql.Vector, error) {
+			return ev.query(ctx, q, ts)
+		},
+		nil,
+		nil,
+	)
+
+	return expander.Expand()
+}
+
+// evaluateAlertingRule updates the state of the alerts generated by the
+// alerting rule, following the same logic as Prometheus.
+func (ev *ruleTestEvaluator) evaluateAlertingRule(ctx context.Context, key [2]int, r monitoringv1.Rule, ruleLabels map[string]string, vec promql.Vector, ts time.Time) error {
+	holdDuration, err := parseRuleTestDuration(r.For, 0)
+	if err != nil {
+		return err
+	}
+
+	var keepFiringFor time.Duration
+	if r.KeepFiringFor != nil {
+		v, err := model.ParseDuration(string(*r.KeepFiringFor))
+		if err != nil {
+			return err
+		}
+		keepFiringFor = time.Duration(v)
+	}
+
+	alerts, found := ev.alerts[key]
+	if !found {
+		alerts = make(map[uint64]*ruleTestAlert)
+		ev.alerts[key] = alerts
+	}
+
+	seen := make(map[uint64]struct{}, len(vec))
+	for _, smpl := range vec {
+		smpl.Metric = labels.NewBuilder(smpl.Metric).Del(labels.MetricName).Labels()
+
+		lb := labels.NewBuilder(smpl.Metric)
+		for k, v := range ruleLabels {
+			v, err := ev.expandTemplate(ctx, "__alert_"+r.Alert, v, smpl, ts)
+			if err != nil {
+				return fmt.Errorf("label %q: %w", k, err)
+			}
+			lb.Set(k, v)
+		}
+		lb.Set(labels.AlertName, r.Alert)
+		lset := lb.Labels()
+
+		ab := labels.NewScratchBuilder(len(r.Annotations))
+		for k, v := range r.Annotations {
+			v, err := ev.expandTemplate(ctx, "__alert_"+r.Alert, v, smpl, ts)
+			if err != nil {
+				return fmt.Errorf("annotation %q: %w", k, err)
+			}
+			ab.Add(k, v)
+		}
+		ab.Sort()
+
+		h := lset.Hash()
+		if _, dup := seen[h]; dup {
+			return errors.New("vector contains metrics with the same labelset after applying alert labels")
+		}
+		seen[h] = struct{}{}
+
+		if a, found := alerts[h]; found {
+			a.annotations = ab.Labels()
+			a.keepFiringSince = time.Time{}
+			continue
+		}
+
+		alerts[h] = &ruleTestAlert{
+			labels:      lset,
+			annotations: ab.Labels(),
+			activeAt:    ts,
+		}
+	}
+
+	for h, a := range alerts {
+		if _, found := seen[h]; !found {
+			if !a.firing || keepFiringFor == 0 {
+				delete(alerts, h)
+				continue
+			}
+
+			if a.keepFiringSince.IsZero() {
+				a.keepFiringSince = ts
+			}
+
+			if ts.Sub(a.keepFiringSince) >= keepFiringFor {
+				delete(alerts, h)
+				continue
+			}
+		}
+
+		if !a.firing && ts.Sub(a.activeAt) >= holdDuration {
+			a.firing = true
+		}
+
+		// Record the ALERTS series like Prometheus does.
+		state := "pending"
+		if a.firing {
+			state = "firing"
+		}
+		lb := labels.NewBuilder(a.labels)
+		lb.Set(labels.MetricName, "ALERTS")
+		lb.Set("alertstate", state)
+		ev.storage.append(lb.Labels(), ts, 1, nil)
+	}
+
+	return nil
+}
+
+// checkAlerts compares the firing alerts with the expected alerts.
+func (ev *ruleTestEvaluator) checkAlerts(at monitoringv1.AlertRuleTest) error {
+	var got []string
+	for i, g := range ev.groups {
+		for j, r := range g.Rules {
+			if r.Alert != at.Alertname {
+				continue
+			}
+
+			for _, a := range ev.alerts[[2]int{i, j}] {
+				if a.firing {
+					got = append(got, formatRuleTestAlert(a.labels, a.annotations))
+				}
+			}
+		}
+	}
+
+	exp := make([]string, 0, len(at.ExpAlerts))
+	for _, a := range at.ExpAlerts {
+		lset := labels.FromMap(a.ExpLabels)
+		lset = labels.NewBuilder(lset).Set(labels.AlertName, at.Alertname).Labels()
+		exp = append(exp, formatRuleTestAlert(lset, labels.FromMap(a.ExpAnnotations)))
+	}
+
+	slices.Sort(got)
+	slices.Sort(exp)
+	if !slices.Equal(got, exp) {
+		return fmt.Errorf("expected alerts [%s], got [%s]", strings.Join(exp, ", "), strings.Join(got, ", "))
+	}
+
+	return nil
+}
+
+func formatRuleTestAlert(lset, annotations labels.Labels) string {
+	return fmt.Sprintf("labels: %s, annotations: %s", lset.String(), annotations.String())
+}
+
+// checkPromQLExpr compares the result of the PromQL expression with the
+// expected samples.
+func (ev *ruleTestEvaluator) checkPromQLExpr(ctx context.Context, pt monitoringv1.PromQLExprTest, ts time.Time) error {
+	vec, err := ev.query(ctx, pt.Expr, ts)
+	if err != nil {
+		return err
+	}
+
+	type sample struct {
+		lset labels.Labels
+		v    float64
+	}
+
+	got := make([]sample, 0, len(vec))
+	for _, s := range vec {
+		if s.H != nil {
+			return errors.New("native histogram samples are not supported")
+		}
+		got = append(got, sample{lset: s.Metric, v: s.F})
+	}
+
+	exp := make([]sample, 0, len(pt.ExpSamples))
+	for i, es := range pt.ExpSamples {
+		lset := labels.EmptyLabels()
+		if es.Labels != "" {
+			lset, err = parser.ParseMetric(es.Labels)
+			if err != nil {
+				return fmt.Errorf("expSamples[%d]: invalid labels: %w", i, err)
+			}
+		}
+
+		v, err := strconv.ParseFloat(es.Value, 64)
+		if err != nil {
+			return fmt.Errorf("expSamples[%d]: invalid value: %w", i, err)
+		}
+
+		exp = append(exp, sample{lset: lset, v: v})
+	}
+
+	cmpSample := func(a, b sample) int { return labels.Compare(a.lset, b.lset) }
+	slices.SortFunc(got, cmpSample)
+	slices.SortFunc(exp, cmpSample)
+
+	format := func(samples []sample) string {
+		s := make([]string, 0, len(samples))
+		for _, smpl := range samples {
+			s = append(s, fmt.Sprintf("%s %s", smpl.lset.String(), strconv.FormatFloat(smpl.v, 'g', -1, 64)))
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	}
+
+	equal := len(got) == len(exp)
+	for i := 0; equal && i < len(got); i++ {
+		equal = labels.Equal(got[i].lset, exp[i].lset) &&
+			(almost.Equal(got[i].v, exp[i].v, epsilon) || (math.IsNaN(got[i].v) && math.IsNaN(exp[i].v)))
+	}
+
+	if !equal {
+		return fmt.Errorf("expected samples %s, got %s", format(exp), format(got))
+	}
+
+	return nil
+}
+
+// ruleTestStorage is a minimal in-memory implementation of
+// storage.Queryable.
+type ruleTestStorage struct {
+	series map[uint64]*ruleTestSeries
+}
+
+type ruleTestSeries struct {
+	lset    labels.Labels
+	samples []chunks.Sample
+}
+
+func newRuleTestStorage() *ruleTestStorage {
+	return &ruleTestStorage{series: make(map[uint64]*ruleTestSeries)}
+}
+
+func (s *ruleTestStorage) addInputSeries(in monitoringv1.RuleTestInputSeries, interval time.Duration) error {
+	lset, values, err := parser.ParseSeriesDesc(in.Series + " " + in.Values)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range values {
+		if v.Omitted {
+			continue
+		}
+
+		s.append(lset, time.Unix(0, 0).UTC().Add(time.Duration(i)*interval), v.Value, v.Histogram)
+	}
+
+	return nil
+}
+
+func (s *ruleTestStorage) append(lset labels.Labels, ts time.Time, v float64, h *histogram.FloatHistogram) {
+	k := lset.Hash()
+	series, found := s.series[k]
+	if !found {
+		series = &ruleTestSeries{lset: lset}
+		s.series[k] = series
+	}
+
+	series.samples = append(series.samples, ruleTestSample{t: ts.UnixMilli(), f: v, fh: h})
+}
+
+// Querier implements the storage.Queryable interface.
+func (s *ruleTestStorage) Querier(mint, maxt int64) (storage.Querier, error) {
+	return &ruleTestQuerier{storage: s, mint: mint, maxt: maxt}, nil
+}
+
+type ruleTestQuerier struct {
+	storage    *ruleTestStorage
+	mint, maxt int64
+}
+
+func (q *ruleTestQuerier) Select(_ context.Context, _ bool, _ *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	var series []storage.Series
+	for _, s := range q.storage.series {
+		if !matchesAll(s.lset, matchers) {
+			continue
+		}
+
+		var samples []chunks.Sample
+		for _, smpl := range s.samples {
+			if smpl.T() >= q.mint && smpl.T() <= q.maxt {
+				samples = append(samples, smpl)
+			}
+		}
+
+		if len(samples) > 0 {
+			series = append(series, storage.NewListSeries(s.lset, samples))
+		}
+	}
+
+	slices.SortFunc(series, func(a, b storage.Series) int { return labels.Compare(a.Labels(), b.Labels()) })
+
+	return &ruleTestSeriesSet{series: series, i: -1}
+}
+
+func matchesAll(lset labels.Labels, matchers []*labels.Matcher) bool {
+	for _, m := range matchers {
+		if !m.Matches(lset.Get(m.Name)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (q *ruleTestQuerier) LabelValues(_ context.Context, name string, _ *storage.LabelHints, matchers ...*labels.Matcher) ([]string, annotations.Annotations, error) {
+	values := map[string]struct{}{}
+	for _, s := range q.storage.series {
+		if v := s.lset.Get(name); v != "" && matchesAll(s.lset, matchers) {
+			values[v] = struct{}{}
+		}
+	}
+
+	return slices.Sorted(maps.Keys(values)), nil, nil
+}
+
+func (q *ruleTestQuerier) LabelNames(_ context.Context, _ *storage.LabelHints, matchers ...*labels.Matcher) ([]string, annotations.Annotations, error) {
+	names := map[string]struct{}{}
+	for _, s := range q.storage.series {
+		if !matchesAll(s.lset, matchers) {
+			continue
+		}
+		s.lset.Range(func(l labels.Label) { names[l.Name] = struct{}{} })
+	}
+
+	return slices.Sorted(maps.Keys(names)), nil, nil
+}
+
+func (q *ruleTestQuerier) Close() error { return nil }
+
+type ruleTestSeriesSet struct {
+	series []storage.Series
+	i      int
+}
+
+func (ss *ruleTestSeriesSet) Next() bool {
+	ss.i++
+	return ss.i < len(ss.series)
+}
+
+func (ss *ruleTestSeriesSet) At() storage.Series                { return ss.series[ss.i] }
+func (ss *ruleTestSeriesSet) Err() error                        { return nil }
+func (ss *ruleTestSeriesSet) Warnings() annotations.Annotations { return nil }
+
+// ruleTestSample implements the chunks.Sample interface.
+type ruleTestSample struct {
+	t  int64
+	f  float64
+	fh *histogram.FloatHistogram
+}
+
+func (s ruleTestSample) T() int64                      { return s.t }
+func (s ruleTestSample) F() float64                    { return s.f }
+func (s ruleTestSample) H() *histogram.Histogram       { return nil }
+func (s ruleTestSample) FH() *histogram.FloatHistogram { return s.fh }
+
+func (s ruleTestSample) Type() chunkenc.ValueType {
+	if s.fh != nil {
+		return chunkenc.ValFloatHistogram
+	}
+
+	return chunkenc.ValFloat
+}
+
+func (s ruleTestSample) Copy() chunks.Sample {
+	c := ruleTestSample{t: s.t, f: s.f}
+	if s.fh != nil {
+		c.fh = s.fh.Copy()
+	}
+
+	return c
+}
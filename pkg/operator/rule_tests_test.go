@@ -0,0 +1,284 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestRunRuleTests(t *testing.T) {
+	groups := []monitoringv1.RuleGroup{
+		{
+			Name: "test",
+			Rules: []monitoringv1.Rule{
+				{
+					Record: "job:up:sum",
+					Expr:   intstr.FromString("sum by (job) (up)"),
+				},
+				{
+					Alert: "InstanceDown",
+					Expr:  intstr.FromString("up == 0"),
+					For:   ptr.To(monitoringv1.Duration("5m")),
+					Labels: map[string]string{
+						"severity": "page",
+					},
+					Annotations: map[string]string{
+						"summary": "Instance {{ $labels.instance }} down",
+					},
+				},
+				{
+					Alert:         "JobDown",
+					Expr:          intstr.FromString("job:up:sum == 0"),
+					KeepFiringFor: ptr.To(monitoringv1.NonEmptyDuration("10m")),
+				},
+			},
+		},
+	}
+
+	inputSeries := []monitoringv1.RuleTestInputSeries{
+		{
+			Series: `up{job="app", instance="a"}`,
+			Values: "1 1 1 0x10",
+		},
+		{
+			Series: `up{job="app", instance="b"}`,
+			Values: "1 _ 1 1x10",
+		},
+		{
+			Series: `up{job="db", instance="c"}`,
+			Values: "1 1 1 0 0 0 1x10",
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		test   monitoringv1.RuleTest
+		errors int
+	}{
+		{
+			name: "alert firing after the for duration",
+			test: monitoringv1.RuleTest{
+				InputSeries: inputSeries,
+				AlertRuleTests: []monitoringv1.AlertRuleTest{
+					{
+						// Pending alert.
+						EvalTime:  "5m",
+						Alertname: "InstanceDown",
+					},
+					{
+						EvalTime:  "8m",
+						Alertname: "InstanceDown",
+						ExpAlerts: []monitoringv1.ExpectedAlert{
+							{
+								ExpLabels: map[string]string{
+									"severity": "page",
+									"job":      "app",
+									"instance": "a",
+								},
+								ExpAnnotations: map[string]string{
+									"summary": "Instance a down",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "alert with keep_firing_for",
+			test: monitoringv1.RuleTest{
+				InputSeries: inputSeries,
+				AlertRuleTests: []monitoringv1.AlertRuleTest{
+					{
+						EvalTime:  "4m",
+						Alertname: "JobDown",
+						ExpAlerts: []monitoringv1.ExpectedAlert{
+							{ExpLabels: map[string]string{"job": "db"}},
+						},
+					},
+					{
+						// The condition isn't true anymore but the alert keeps
+						// firing.
+						EvalTime:  "10m",
+						Alertname: "JobDown",
+						ExpAlerts: []monitoringv1.ExpectedAlert{
+							{ExpLabels: map[string]string{"job": "db"}},
+						},
+					},
+					{
+						EvalTime:  "17m",
+						Alertname: "JobDown",
+					},
+				},
+			},
+		},
+		{
+			name: "recording rule and ALERTS series",
+			test: monitoringv1.RuleTest{
+				Interval:    ptr.To(monitoringv1.Duration("1m")),
+				InputSeries: inputSeries,
+				PromQLExprTests: []monitoringv1.PromQLExprTest{
+					{
+						Expr:     "job:up:sum",
+						EvalTime: "2m",
+						ExpSamples: []monitoringv1.ExpectedSample{
+							{Labels: `job:up:sum{job="app"}`, Value: "2"},
+							{Labels: `job:up:sum{job="db"}`, Value: "1"},
+						},
+					},
+					{
+						Expr:     `ALERTS{alertname="InstanceDown"}`,
+						EvalTime: "4m",
+						ExpSamples: []monitoringv1.ExpectedSample{
+							{Labels: `ALERTS{alertname="InstanceDown", alertstate="pending", instance="a", job="app", severity="page"}`, Value: "1"},
+							{Labels: `ALERTS{alertname="InstanceDown", alertstate="pending", instance="c", job="db", severity="page"}`, Value: "1"},
+						},
+					},
+					{
+						Expr:     "scalar(count(up))",
+						EvalTime: "0s",
+						ExpSamples: []monitoringv1.ExpectedSample{
+							{Value: "3"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "wrong expectations",
+			test: monitoringv1.RuleTest{
+				InputSeries: inputSeries,
+				AlertRuleTests: []monitoringv1.AlertRuleTest{
+					{
+						EvalTime:  "10m",
+						Alertname: "InstanceDown",
+						ExpAlerts: []monitoringv1.ExpectedAlert{
+							{
+								ExpLabels: map[string]string{
+									"severity": "warning",
+									"job":      "app",
+									"instance": "a",
+								},
+							},
+						},
+					},
+				},
+				PromQLExprTests: []monitoringv1.PromQLExprTest{
+					{
+						Expr:     "job:up:sum",
+						EvalTime: "2m",
+					},
+				},
+			},
+			errors: 2,
+		},
+		{
+			name: "invalid input series",
+			test: monitoringv1.RuleTest{
+				InputSeries: []monitoringv1.RuleTestInputSeries{
+					{
+						Series: `up{job="app"`,
+						Values: "1",
+					},
+				},
+			},
+			errors: 1,
+		},
+		{
+			name: "invalid expected value",
+			test: monitoringv1.RuleTest{
+				InputSeries: inputSeries,
+				PromQLExprTests: []monitoringv1.PromQLExprTest{
+					{
+						Expr:     "up",
+						EvalTime: "0s",
+						ExpSamples: []monitoringv1.ExpectedSample{
+							{Value: "one"},
+						},
+					},
+				},
+			},
+			errors: 1,
+		},
+		{
+			name: "too many evaluations",
+			test: monitoringv1.RuleTest{
+				EvaluationInterval: ptr.To(monitoringv1.Duration("1s")),
+				AlertRuleTests: []monitoringv1.AlertRuleTest{
+					{
+						EvalTime:  "1d",
+						Alertname: "InstanceDown",
+					},
+				},
+			},
+			errors: 1,
+		},
+		{
+			name: "too many input samples",
+			test: monitoringv1.RuleTest{
+				InputSeries: []monitoringv1.RuleTestInputSeries{
+					{
+						Series: "up",
+						Values: "0+1x100000000",
+					},
+				},
+			},
+			errors: 1,
+		},
+		{
+			name: "too many input series",
+			test: monitoringv1.RuleTest{
+				InputSeries: slices.Repeat([]monitoringv1.RuleTestInputSeries{{Series: "up", Values: "1"}}, maxRuleTestSeries+1),
+			},
+			errors: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := RunRuleTests(monitoringv1.PrometheusRuleSpec{
+				Groups: groups,
+				Tests:  []monitoringv1.RuleTest{tc.test},
+			})
+
+			require.Len(t, errs, tc.errors, "%v", errs)
+		})
+	}
+}
+
+func TestCountRuleTestSamples(t *testing.T) {
+	for _, tc := range []struct {
+		values string
+		exp    int
+	}{
+		{values: "", exp: 0},
+		{values: "1 2 3", exp: 3},
+		{values: "1 _ stale", exp: 3},
+		{values: "0+1x10", exp: 11},
+		{values: "1x3  _x2\t5-1x1", exp: 8},
+		{values: "{{schema:0 sum:5 count:4 buckets:[1 2 1]}}", exp: 1},
+		{values: "{{schema:0 sum:5 count:4 buckets:[1 2 1]}}+{{sum:1 count:1 buckets:[1]}}x4 _", exp: 6},
+		{values: "0+1x100000000", exp: 101},
+	} {
+		t.Run(tc.values, func(t *testing.T) {
+			require.Equal(t, tc.exp, countRuleTestSamples(tc.values, 100))
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/prometheus/common/model"
@@ -69,6 +70,10 @@ type PrometheusRuleSelector struct {
 	nsLabeler    *namespacelabeler.Labeler
 	ruleInformer *informers.ForResource
 
+	// scrapeInterval is used to detect rate() functions over too short
+	// ranges. Zero disables the check.
+	scrapeInterval time.Duration
+
 	eventRecorder *EventRecorder
 
 	logger *slog.Logger
@@ -95,8 +100,19 @@ func (prs *PrometheusRuleSelection) RejectedLen() int {
 	return len(prs.selection) - len(prs.ruleFiles)
 }
 
+// PrometheusRuleSelectorOption configures a PrometheusRuleSelector.
+type PrometheusRuleSelectorOption func(*PrometheusRuleSelector)
+
+// WithScrapeInterval sets the scrape interval used to lint the PrometheusRule
+// expressions.
+func WithScrapeInterval(d time.Duration) PrometheusRuleSelectorOption {
+	return func(prs *PrometheusRuleSelector) {
+		prs.scrapeInterval = d
+	}
+}
+
 // NewPrometheusRuleSelector returns a PrometheusRuleSelector pointer.
-func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version string, labelSelector *metav1.LabelSelector, nsLabeler *namespacelabeler.Labeler, ruleInformer *informers.ForResource, eventRecorder *EventRecorder, logger *slog.Logger) (*PrometheusRuleSelector, error) {
+func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version string, labelSelector *metav1.LabelSelector, nsLabeler *namespacelabeler.Labeler, ruleInformer *informers.ForResource, eventRecorder *EventRecorder, logger *slog.Logger, opts ...PrometheusRuleSelectorOption) (*PrometheusRuleSelector, error) {
 	componentVersion, err := semver.ParseTolerant(version)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse version: %w", err)
@@ -107,7 +123,7 @@ func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version strin
 		return nil, fmt.Errorf("convert rule label selector to selector: %w", err)
 	}
 
-	return &PrometheusRuleSelector{
+	prs := &PrometheusRuleSelector{
 		ruleFormat:    ruleFormat,
 		version:       componentVersion,
 		ruleSelector:  ruleSelector,
@@ -115,7 +131,13 @@ func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version strin
 		ruleInformer:  ruleInformer,
 		eventRecorder: eventRecorder,
 		logger:        logger,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(prs)
+	}
+
+	return prs, nil
 }
 
 func (prs *PrometheusRuleSelector) generateRulesConfiguration(promRule *monitoringv1.PrometheusRule) (string, error) {
@@ -165,6 +187,10 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 		component = "Thanos"
 	}
 
+	// The unit tests are evaluated by the admission webhook and aren't part
+	// of the rule file format.
+	promRuleSpec.Tests = nil
+
 	for i := range promRuleSpec.Groups {
 		if promRuleSpec.Groups[i].Limit != nil && prs.version.LT(minVersionLimits) {
 			promRuleSpec.Groups[i].Limit = nil
@@ -199,6 +225,10 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 
 // ValidateRule takes PrometheusRuleSpec and validates it using the upstream prometheus rule validator.
 func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec, validationScheme model.ValidationScheme) []error {
+	// The unit tests aren't part of the upstream rule file format, they are
+	// evaluated by RunRuleTests().
+	promRuleSpec.Tests = nil
+
 	for i := range promRuleSpec.Groups {
 		// The upstream Prometheus rule validator doesn't support the
 		// partial_response_strategy field.
@@ -264,6 +294,15 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 	for ruleName, promRule := range promRules {
 		var err error
 		var content string
+
+		// The linter needs to run before the enforcement of the namespace
+		// label since it detects the label matchers being overridden.
+		linter := ruleLinter{scrapeInterval: prs.scrapeInterval}
+		if !prs.nsLabeler.IsExcluded(promRule.TypeMeta, promRule.ObjectMeta) {
+			linter.enforcedNamespaceLabel = prs.nsLabeler.GetEnforcedNamespaceLabel()
+		}
+		warnings := linter.lint(promRule)
+
 		if err := prs.nsLabeler.EnforceNamespaceLabel(promRule); err != nil {
 			continue
 		}
@@ -287,12 +326,23 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 		} else {
 			marshalRules[ruleName] = content
 			namespacedNames = append(namespacedNames, fmt.Sprintf("%s/%s", promRule.Namespace, promRule.Name))
+
+			if len(warnings) > 0 {
+				prs.logger.Debug(
+					"prometheusrule has linting warnings",
+					"warnings", strings.Join(warnings, "; "),
+					"prometheusrule", promRule.Name,
+					"namespace", promRule.Namespace,
+				)
+				reason = RuleLintWarnings
+			}
 		}
 
 		rules[k] = TypedConfigurationResource[*monitoringv1.PrometheusRule]{
 			resource:   promRule,
 			err:        err,
 			reason:     reason,
+			warnings:   warnings,
 			generation: promRule.GetGeneration(),
 		}
 	}
@@ -0,0 +1,150 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// RuleLintWarnings is the reason of the Accepted condition for
+// PrometheusRule resources which are valid but have linting warnings.
+const RuleLintWarnings = "RuleLintWarnings"
+
+// rangeFunctions lists the PromQL functions which need at least 2 samples in
+// the range to return a result.
+var rangeFunctions = map[string]struct{}{
+	"rate":     {},
+	"irate":    {},
+	"increase": {},
+	"delta":    {},
+	"idelta":   {},
+	"deriv":    {},
+}
+
+// ruleLinter detects the rules which are syntactically valid but are likely
+// to not behave as expected.
+type ruleLinter struct {
+	// enforcedNamespaceLabel is the label enforced by the operator on the
+	// rules (empty if no enforcement).
+	enforcedNamespaceLabel string
+	// scrapeInterval is the scrape interval of the workload (zero if
+	// unknown).
+	scrapeInterval time.Duration
+}
+
+// lint returns the warnings for the given PrometheusRule.
+// The namespace label shouldn't have been enforced yet.
+func (rl *ruleLinter) lint(promRule *monitoringv1.PrometheusRule) []string {
+	var warnings []string
+	for _, g := range promRule.Spec.Groups {
+		for _, r := range g.Rules {
+			name := fmt.Sprintf("record %q", r.Record)
+			if r.Alert != "" {
+				name = fmt.Sprintf("alert %q", r.Alert)
+			}
+
+			for _, w := range rl.lintRule(promRule.Namespace, r) {
+				warnings = append(warnings, fmt.Sprintf("group %q, %s: %s", g.Name, name, w))
+			}
+		}
+	}
+
+	return warnings
+}
+
+func (rl *ruleLinter) lintRule(namespace string, r monitoringv1.Rule) []string {
+	expr, err := parser.ParseExpr(r.Expr.String())
+	if err != nil {
+		// Invalid expressions are reported by the validation.
+		return nil
+	}
+
+	var warnings []string
+	if rl.enforcedNamespaceLabel != "" {
+		if v, found := r.Labels[rl.enforcedNamespaceLabel]; found && v != namespace {
+			warnings = append(warnings, fmt.Sprintf("label %s=%q is overridden by the enforced namespace label", rl.enforcedNamespaceLabel, v))
+		}
+	}
+
+	var hasComparison bool
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			if rl.enforcedNamespaceLabel == "" {
+				break
+			}
+
+			for _, m := range n.LabelMatchers {
+				if m.Name != rl.enforcedNamespaceLabel {
+					continue
+				}
+
+				if m.Type == labels.MatchEqual && m.Value == namespace {
+					continue
+				}
+
+				warnings = append(warnings, fmt.Sprintf("matcher %s is overridden by the enforced namespace label", m.String()))
+			}
+
+		case *parser.Call:
+			if rl.scrapeInterval == 0 {
+				break
+			}
+
+			if _, found := rangeFunctions[n.Func.Name]; !found {
+				break
+			}
+
+			for _, arg := range n.Args {
+				ms, ok := arg.(*parser.MatrixSelector)
+				if !ok {
+					continue
+				}
+
+				if ms.Range < 2*rl.scrapeInterval {
+					warnings = append(warnings, fmt.Sprintf(
+						"%s() over [%s] is shorter than twice the scrape interval (%s) and may return no result",
+						n.Func.Name,
+						model.Duration(ms.Range),
+						model.Duration(rl.scrapeInterval),
+					))
+				}
+			}
+
+		case *parser.BinaryExpr:
+			if n.Op.IsComparisonOperator() && !n.ReturnBool {
+				hasComparison = true
+			}
+		}
+
+		return nil
+	})
+
+	if r.Alert != "" && hasComparison {
+		holdDuration, err := parseRuleTestDuration(r.For, 0)
+		if err == nil && holdDuration == 0 {
+			warnings = append(warnings, "the alert fires as soon as the comparison is true, consider setting 'for' to avoid flapping alerts")
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestRuleLinter(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		linter   ruleLinter
+		rule     monitoringv1.Rule
+		expected []string
+	}{
+		{
+			name:   "no warnings",
+			linter: ruleLinter{enforcedNamespaceLabel: "namespace", scrapeInterval: 30 * time.Second},
+			rule: monitoringv1.Rule{
+				Alert: "HighErrorRate",
+				Expr:  intstr.FromString(`rate(errors_total{namespace="default"}[1m]) > 1`),
+				For:   ptr.To(monitoringv1.Duration("5m")),
+			},
+		},
+		{
+			name:   "invalid expression",
+			linter: ruleLinter{enforcedNamespaceLabel: "namespace", scrapeInterval: 30 * time.Second},
+			rule: monitoringv1.Rule{
+				Record: "foo",
+				Expr:   intstr.FromString(`rate(errors_total[1m]`),
+			},
+		},
+		{
+			name:   "matchers overridden by the enforced namespace label",
+			linter: ruleLinter{enforcedNamespaceLabel: "namespace"},
+			rule: monitoringv1.Rule{
+				Record: "foo",
+				Expr:   intstr.FromString(`sum(up{namespace="other"}) + sum(up{namespace=~"default|other"})`),
+				Labels: map[string]string{"namespace": "other"},
+			},
+			expected: []string{
+				`group "group", record "foo": label namespace="other" is overridden by the enforced namespace label`,
+				`group "group", record "foo": matcher namespace="other" is overridden by the enforced namespace label`,
+				`group "group", record "foo": matcher namespace=~"default|other" is overridden by the enforced namespace label`,
+			},
+		},
+		{
+			name:   "no enforced namespace label",
+			linter: ruleLinter{},
+			rule: monitoringv1.Rule{
+				Record: "foo",
+				Expr:   intstr.FromString(`sum(up{namespace="other"})`),
+				Labels: map[string]string{"namespace": "other"},
+			},
+		},
+		{
+			name:   "range shorter than twice the scrape interval",
+			linter: ruleLinter{scrapeInterval: 30 * time.Second},
+			rule: monitoringv1.Rule{
+				Record: "foo",
+				Expr:   intstr.FromString(`sum(rate(errors_total[30s])) / sum(increase(requests_total[1m])) / avg_over_time(up[10s])`),
+			},
+			expected: []string{
+				`group "group", record "foo": rate() over [30s] is shorter than twice the scrape interval (30s) and may return no result`,
+			},
+		},
+		{
+			name:   "unknown scrape interval",
+			linter: ruleLinter{},
+			rule: monitoringv1.Rule{
+				Record: "foo",
+				Expr:   intstr.FromString(`rate(errors_total[10s])`),
+			},
+		},
+		{
+			name:   "comparison without for",
+			linter: ruleLinter{},
+			rule: monitoringv1.Rule{
+				Alert: "InstanceDown",
+				Expr:  intstr.FromString(`up == 0`),
+				For:   ptr.To(monitoringv1.Duration("0s")),
+			},
+			expected: []string{
+				`group "group", alert "InstanceDown": the alert fires as soon as the comparison is true, consider setting 'for' to avoid flapping alerts`,
+			},
+		},
+		{
+			name:   "bool comparison without for",
+			linter: ruleLinter{},
+			rule: monitoringv1.Rule{
+				Alert: "Always",
+				Expr:  intstr.FromString(`vector(1) == bool 1`),
+			},
+		},
+		{
+			name:   "recording rule with comparison",
+			linter: ruleLinter{},
+			rule: monitoringv1.Rule{
+				Record: "up:down",
+				Expr:   intstr.FromString(`up == 0`),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			promRule := &monitoringv1.PrometheusRule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rules"},
+				Spec: monitoringv1.PrometheusRuleSpec{
+					Groups: []monitoringv1.RuleGroup{
+						{
+							Name:  "group",
+							Rules: []monitoringv1.Rule{tc.rule},
+						},
+					},
+				},
+			}
+
+			require.Equal(t, tc.expected, tc.linter.lint(promRule))
+		})
+	}
+}
+
+func TestConditionsWithWarnings(t *testing.T) {
+	res := TypedConfigurationResource[*monitoringv1.PrometheusRule]{
+		resource:   &monitoringv1.PrometheusRule{},
+		reason:     RuleLintWarnings,
+		warnings:   []string{"warning 1", "warning 2"},
+		generation: 2,
+	}
+
+	conditions := res.Conditions()
+	require.Len(t, conditions, 1)
+	require.Equal(t, monitoringv1.Accepted, conditions[0].Type)
+	require.Equal(t, monitoringv1.ConditionTrue, conditions[0].Status)
+	require.Equal(t, RuleLintWarnings, conditions[0].Reason)
+	require.Equal(t, "warning 1; warning 2", conditions[0].Message)
+	require.Equal(t, int64(2), conditions[0].ObservedGeneration)
+}
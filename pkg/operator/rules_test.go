@@ -39,6 +39,7 @@ func TestMakeRulesConfigMaps(t *testing.T) {
 	t.Run("shouldRejectRuleWithInvalidExpression", shouldRejectRuleWithInvalidExpression)
 	t.Run("shouldAcceptRulesWithEmptyDurations", shouldAcceptRulesWithEmptyDurations)
 	t.Run("shouldErrorOnTooLargePrometheusRule", shouldErrorOnTooLargePrometheusRule)
+	t.Run("shouldDropRuleTests", shouldDropRuleTests)
 
 	// Prometheus features
 	t.Run("shouldAcceptRuleWithLimitPrometheus", shouldAcceptRuleWithLimitPrometheus)
@@ -116,6 +117,40 @@ func shouldAcceptValidRule(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func shouldDropRuleTests(t *testing.T) {
+	rules := &monitoringv1.PrometheusRule{
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "group",
+					Rules: []monitoringv1.Rule{
+						{
+							Alert: "alert",
+							Expr:  intstr.FromString("vector(1)"),
+						},
+					},
+				},
+			},
+			Tests: []monitoringv1.RuleTest{
+				{
+					AlertRuleTests: []monitoringv1.AlertRuleTest{
+						{
+							EvalTime:  "1m",
+							Alertname: "alert",
+						},
+					},
+				},
+			},
+		},
+	}
+	promVersion, _ := semver.ParseTolerant(DefaultPrometheusVersion)
+	pr := newRuleSelectorForConfigGeneration(PrometheusFormat, promVersion)
+	content, err := pr.generateRulesConfiguration(rules)
+	require.NoError(t, err)
+	require.NotContains(t, content, "tests")
+	require.Len(t, rules.Spec.Tests, 1)
+}
+
 func shouldAcceptRulesWithEmptyDurations(t *testing.T) {
 	durationPtr := func(d string) *monitoringv1.Duration {
 		v := monitoringv1.Duration(d)
@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -50,6 +51,10 @@ const (
 	resyncPeriod              = 5 * time.Minute
 	controllerName            = "prometheusagent-controller"
 	applicationNameLabelValue = "prometheus-agent"
+
+	// DebugResource is the resource name identifying PrometheusAgent objects
+	// in the debug API.
+	DebugResource = "prometheusagent"
 )
 
 // Operator manages life cycle of Prometheus agent deployments and
@@ -73,6 +78,9 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	quotaInfs *informers.ForResource
+	scdInfs   *informers.ForResource
+	grantInfs *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
@@ -85,9 +93,12 @@ type Operator struct {
 
 	config prompkg.Config
 
-	endpointSliceSupported bool // Whether the Kubernetes API supports the EndpointSlice kind.
-	scrapeConfigSupported  bool
-	canReadStorageClass    bool
+	endpointSliceSupported         bool // Whether the Kubernetes API supports the EndpointSlice kind.
+	scrapeConfigSupported          bool
+	monitoringQuotaSupported       bool
+	scrapeClassDefinitionSupported bool
+	referenceGrantSupported        bool
+	canReadStorageClass            bool
 
 	newEventRecorder operator.NewEventRecorderFunc
 
@@ -97,6 +108,9 @@ type Operator struct {
 	configResourcesStatusEnabled bool
 
 	finalizerSyncer *operator.FinalizerSyncer
+
+	debugStore      *operator.DebugStore
+	secretProviders *assets.SecretProviders
 }
 
 type ControllerOption func(*Operator)
@@ -115,6 +129,38 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithMonitoringQuota tells that the controller enforces MonitoringQuota
+// objects.
+func WithMonitoringQuota() ControllerOption {
+	return func(o *Operator) {
+		o.monitoringQuotaSupported = true
+	}
+}
+
+// WithScrapeClassDefinition tells that the controller manages
+// ScrapeClassDefinition objects.
+func WithScrapeClassDefinition() ControllerOption {
+	return func(o *Operator) {
+		o.scrapeClassDefinitionSupported = true
+	}
+}
+
+// WithReferenceGrant tells that the controller allows cross-namespace
+// references granted by ReferenceGrant objects.
+func WithReferenceGrant() ControllerOption {
+	return func(o *Operator) {
+		o.referenceGrantSupported = true
+	}
+}
+
+// WithSecretProviders tells that the controller can resolve credentials from
+// the given secret providers.
+func WithSecretProviders(sp *assets.SecretProviders) ControllerOption {
+	return func(o *Operator) {
+		o.secretProviders = sp
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -131,6 +177,14 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithDebugStore tells that the controller should record the generated
+// configuration and the selected resources into the debug store.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -274,6 +328,54 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	if o.monitoringQuotaSupported {
+		o.quotaInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.MonitoringQuotaName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating monitoringquota informers: %w", err)
+		}
+	}
+
+	if o.referenceGrantSupported {
+		o.grantInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ReferenceGrantName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating referencegrant informers: %w", err)
+		}
+	}
+
+	if o.scrapeClassDefinitionSupported {
+		o.scdInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeClassDefinitionName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating scrapeclassdefinition informers: %w", err)
+		}
+	}
+
 	allowList := c.Namespaces.PrometheusAllowList
 	if c.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -414,6 +516,15 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.monitoringQuotaSupported {
+		go c.quotaInfs.Start(ctx.Done())
+	}
+	if c.referenceGrantSupported {
+		go c.grantInfs.Start(ctx.Done())
+	}
+	if c.scrapeClassDefinitionSupported {
+		go c.scdInfs.Start(ctx.Done())
+	}
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -468,6 +579,9 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PodMonitor", c.pmonInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"MonitoringQuota", c.quotaInfs},
+		{"ReferenceGrant", c.grantInfs},
+		{"ScrapeClassDefinition", c.scdInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
@@ -570,6 +684,53 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.quotaInfs != nil {
+		c.quotaInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.MonitoringQuotasKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
+	if c.grantInfs != nil {
+		c.grantInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ReferenceGrantsKind,
+			c.enqueueForCrossNamespaceReferences,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
+	if c.secretProviders != nil {
+		c.secretProviders.OnChange(func(s *v1.Secret) {
+			c.enqueueForReference(s)
+		})
+		c.secretProviders.AddReferenceChecker(func(s *v1.Secret) bool {
+			return c.reconciliations.IsReferenced(s)
+		})
+	}
+
+	if c.scdInfs != nil {
+		c.scdInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ScrapeClassDefinitionsKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.promInfs,
 		c.reconciliations,
@@ -621,6 +782,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(DebugResource, key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -641,6 +803,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	// Check if the Agent instance is marked for deletion.
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(DebugResource, key)
 		return nil
 	}
 
@@ -660,6 +823,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		assetStore = assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
 		opts       = []prompkg.ConfigGeneratorOption{}
 	)
+	if c.grantInfs != nil {
+		assetStore.WithReferenceGrants(c.grantInfs.ListAllByNamespace)
+	}
+	if c.secretProviders != nil {
+		assetStore.WithSecretProviders(c.secretProviders)
+	}
 	if c.endpointSliceSupported {
 		opts = append(opts, prompkg.WithEndpointSliceSupport())
 	}
@@ -696,8 +865,15 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 		err = c.syncStatefulSet(ctx, key, p, cg, tlsAssets)
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	if err := c.syncRuler(ctx, logger, p); err != nil {
+		return fmt.Errorf("synchronizing ThanosRuler failed: %w", err)
+	}
+
+	return nil
 }
 
 func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
@@ -907,11 +1083,28 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 }
 
 func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+	key := p.GetNamespace() + "/" + p.GetName()
+
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.quotaInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithMonitoringQuotas(c.quotaInfs.ListAllByNamespace))
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 	if err != nil {
 		return err
 	}
 
+	// The scrape classes need to be selected first because the other
+	// resources depend on them.
+	var scrapeClassDefinitions operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeClassDefinition]
+	if c.scdInfs != nil {
+		scrapeClassDefinitions, err = resourceSelector.SelectScrapeClassDefinitions(ctx, c.scdInfs.ListAllByNamespace)
+		if err != nil {
+			return fmt.Errorf("selecting ScrapeClassDefinitions failed: %w", err)
+		}
+	}
+
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
 		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
@@ -935,6 +1128,21 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		}
 	}
 
+	if c.debugStore != nil {
+		var selected, rejected []operator.ResourceSelectionStatus
+		add := func(s, r []operator.ResourceSelectionStatus) {
+			selected = append(selected, s...)
+			rejected = append(rejected, r...)
+		}
+
+		add(smons.ResourceSelectionStatuses(monitoringv1.ServiceMonitorsKind))
+		add(pmons.ResourceSelectionStatuses(monitoringv1.PodMonitorsKind))
+		add(bmons.ResourceSelectionStatuses(monitoringv1.ProbesKind))
+		add(scrapeConfigs.ResourceSelectionStatuses(monitoringv1alpha1.ScrapeConfigsKind))
+		add(scrapeClassDefinitions.ResourceSelectionStatuses(monitoringv1alpha1.ScrapeClassDefinitionsKind))
+		c.debugStore.SetResourceSelection(DebugResource, key, selected, rejected)
+	}
+
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
 		return err
 	}
@@ -947,6 +1155,11 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		return fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
+	cg, err = cg.WithScrapeClasses(scrapeClassDefinitions.ValidResources())
+	if err != nil {
+		return err
+	}
+
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
@@ -966,6 +1179,8 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		return fmt.Errorf("generating config failed: %w", err)
 	}
 
+	c.recordConfiguration(logger, key, conf)
+
 	// Compress config to avoid 1mb secret limit for a while
 	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
 	if err != nil {
@@ -976,6 +1191,22 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
 }
 
+// recordConfiguration records the generated configuration with redacted
+// secrets into the debug store.
+func (c *Operator) recordConfiguration(logger *slog.Logger, key string, conf []byte) {
+	if c.debugStore == nil {
+		return
+	}
+
+	redacted, err := prompkg.RedactConfiguration(conf)
+	if err != nil {
+		logger.Warn("failed to redact the configuration for the debug API", "err", err)
+		return
+	}
+
+	c.debugStore.SetConfiguration(DebugResource, key, redacted)
+}
+
 func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
 	var http2 *bool
 	if p.Spec.Web != nil && p.Spec.Web.HTTPConfig != nil {
@@ -1087,6 +1318,36 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitor
 
 func (c *Operator) enqueueForPrometheusNamespace(nsName string) {
 	c.enqueueForNamespace(c.nsPromInf.GetStore(), nsName)
+	c.enqueueForCrossNamespaceReferences(nsName)
+}
+
+// enqueueForCrossNamespaceReferences enqueues the PrometheusAgent objects
+// which reference Secrets or ConfigMaps from the given namespace via a
+// ReferenceGrant object.
+func (c *Operator) enqueueForCrossNamespaceReferences(nsName string) {
+	if c.grantInfs == nil {
+		return
+	}
+
+	c.enqueueForReference(&monitoringv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: nsName},
+	})
+}
+
+// enqueueForReference enqueues the PrometheusAgent objects which have a reference to
+// the given object.
+func (c *Operator) enqueueForReference(ref runtime.Object) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1alpha1.PrometheusAgent)
+		if c.reconciliations.HasRefTo(p.GetNamespace()+"/"+p.GetName(), ref) {
+			c.rr.EnqueueForReconciliation(p)
+		}
+	})
+	if err != nil {
+		c.logger.Error("listing all Prometheus Agent instances from cache failed",
+			"err", err,
+		)
+	}
 }
 
 func (c *Operator) enqueueForMonitorNamespace(nsName string) {
@@ -1177,6 +1438,22 @@ func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting ScrapeClassDefinitions
+		// in the NS.
+		scrapeClassNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.ScrapeClassNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert ScrapeClassNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if scrapeClassNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error("listing all Prometheus instances from cache failed",
@@ -0,0 +1,181 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mitchellh/hashstructure"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// rulerName returns the name of the ThanosRuler object evaluating the rules
+// of the PrometheusAgent object.
+func rulerName(p *monitoringv1alpha1.PrometheusAgent) string {
+	return prompkg.PrefixedName(p)
+}
+
+// makeRuler returns the ThanosRuler object evaluating the rules selected by
+// the PrometheusAgent object.
+// The ruler runs in stateless mode and writes the rule results to the same
+// remote-write endpoints as the agent.
+func makeRuler(p *monitoringv1alpha1.PrometheusAgent, config prompkg.Config) (*monitoringv1.ThanosRuler, error) {
+	ruler := p.Spec.Ruler
+	if ruler == nil {
+		return nil, fmt.Errorf("ruler must be set when ruleSelector is set")
+	}
+
+	if len(ruler.QueryEndpoints) == 0 && ruler.QueryConfig == nil {
+		return nil, fmt.Errorf("ruler: either queryEndpoints or queryConfig must be set")
+	}
+
+	if len(p.Spec.RemoteWrite) == 0 {
+		return nil, fmt.Errorf("remoteWrite must be set when ruleSelector is set")
+	}
+
+	tr := &monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rulerName(p),
+			Namespace: p.Namespace,
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			Version:                ruler.Version,
+			Replicas:               ruler.Replicas,
+			Resources:              ruler.Resources,
+			QueryEndpoints:         ruler.QueryEndpoints,
+			QueryConfig:            ruler.QueryConfig,
+			AlertManagersURL:       ruler.AlertManagersURL,
+			AlertManagersConfig:    ruler.AlertManagersConfig,
+			RuleSelector:           p.Spec.RuleSelector,
+			RuleNamespaceSelector:  p.Spec.RuleNamespaceSelector,
+			EnforcedNamespaceLabel: p.Spec.EnforcedNamespaceLabel,
+			// The labels are added to the series and alerts generated by the
+			// ruler.
+			Labels:                  p.Spec.ExternalLabels,
+			ExcludedFromEnforcement: p.Spec.ExcludedFromEnforcement,
+			RemoteWrite:             p.Spec.RemoteWrite,
+			ImagePullSecrets:        p.Spec.ImagePullSecrets,
+		},
+	}
+
+	if ruler.Image != nil {
+		tr.Spec.Image = *ruler.Image
+	}
+
+	if ruler.EvaluationInterval != nil {
+		tr.Spec.EvaluationInterval = *ruler.EvaluationInterval
+	}
+
+	// The annotations are inherited from the PrometheusAgent object to
+	// propagate the controller ID annotation (if any).
+	operator.UpdateObject(
+		tr,
+		operator.WithAnnotations(p.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(p.GetLabels()),
+		operator.WithLabels(map[string]string{
+			prompkg.PrometheusNameLabelName: p.Name,
+		}),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(p),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	hash, err := hashstructure.Hash(struct {
+		Labels      map[string]string
+		Annotations map[string]string
+		Spec        monitoringv1.ThanosRulerSpec
+	}{
+		Labels:      tr.Labels,
+		Annotations: tr.Annotations,
+		Spec:        tr.Spec,
+	},
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate the ThanosRuler hash: %w", err)
+	}
+	operator.WithInputHashAnnotation(fmt.Sprintf("%d", hash))(tr)
+
+	return tr, nil
+}
+
+// syncRuler creates, updates or deletes the ThanosRuler object evaluating the
+// rules of the PrometheusAgent object.
+func (c *Operator) syncRuler(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent) error {
+	trClient := c.mclient.MonitoringV1().ThanosRulers(p.Namespace)
+
+	if p.Spec.RuleSelector == nil {
+		existing, err := trClient.Get(ctx, rulerName(p), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get ThanosRuler: %w", err)
+		}
+
+		if !metav1.IsControlledBy(existing, p) {
+			return nil
+		}
+
+		logger.Debug("deleting the ThanosRuler evaluating the rules")
+		if err := trClient.Delete(ctx, existing.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ThanosRuler: %w", err)
+		}
+
+		return nil
+	}
+
+	tr, err := makeRuler(p, c.config)
+	if err != nil {
+		return fmt.Errorf("invalid rule evaluation configuration: %w", err)
+	}
+
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := trClient.Get(ctx, tr.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			logger.Debug("creating the ThanosRuler evaluating the rules")
+			_, err = trClient.Create(ctx, tr, metav1.CreateOptions{})
+			return err
+		}
+
+		if !metav1.IsControlledBy(existing, p) {
+			return fmt.Errorf("ThanosRuler %s/%s already exists and isn't managed by the PrometheusAgent", existing.Namespace, existing.Name)
+		}
+
+		if existing.Annotations[operator.InputHashAnnotationKey] == tr.Annotations[operator.InputHashAnnotationKey] {
+			return nil
+		}
+
+		logger.Debug("updating the ThanosRuler evaluating the rules")
+		tr.ResourceVersion = existing.ResourceVersion
+		_, err = trClient.Update(ctx, tr, metav1.UpdateOptions{})
+		return err
+	})
+}
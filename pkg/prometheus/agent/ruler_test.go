@@ -0,0 +1,186 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func makeAgentWithRules() *monitoringv1alpha1.PrometheusAgent {
+	return &monitoringv1alpha1.PrometheusAgent{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.PrometheusAgentsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			UID:       "1234",
+			Annotations: map[string]string{
+				"operator.prometheus.io/controller-id":             "foo",
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Spec: monitoringv1alpha1.PrometheusAgentSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ExternalLabels: map[string]string{"cluster": "eu1"},
+				RemoteWrite: []monitoringv1.RemoteWriteSpec{
+					{URL: "http://remote-storage:9090/api/v1/write"},
+				},
+			},
+			RuleSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "alert-rules"},
+			},
+			Ruler: &monitoringv1alpha1.PrometheusAgentRulerSpec{
+				Replicas:           ptr.To(int32(2)),
+				QueryEndpoints:     []string{"dnssrv+_http._tcp.thanos-query.monitoring.svc"},
+				AlertManagersURL:   []string{"http://alertmanager:9093"},
+				EvaluationInterval: ptr.To(monitoringv1.Duration("30s")),
+			},
+		},
+	}
+}
+
+func TestMakeRuler(t *testing.T) {
+	p := makeAgentWithRules()
+
+	tr, err := makeRuler(p, defaultTestConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, "prom-agent-test", tr.Name)
+	require.Equal(t, "default", tr.Namespace)
+	require.True(t, metav1.IsControlledBy(tr, p))
+	require.NotEmpty(t, tr.Annotations[operator.InputHashAnnotationKey])
+	require.Equal(t, "foo", tr.Annotations["operator.prometheus.io/controller-id"])
+	require.NotContains(t, tr.Annotations, "kubectl.kubernetes.io/last-applied-configuration")
+
+	require.Equal(t, p.Spec.RuleSelector, tr.Spec.RuleSelector)
+	require.Equal(t, p.Spec.RemoteWrite, tr.Spec.RemoteWrite)
+	require.Equal(t, map[string]string{"cluster": "eu1"}, tr.Spec.Labels)
+	require.Equal(t, ptr.To(int32(2)), tr.Spec.Replicas)
+	require.Equal(t, []string{"dnssrv+_http._tcp.thanos-query.monitoring.svc"}, tr.Spec.QueryEndpoints)
+	require.Equal(t, []string{"http://alertmanager:9093"}, tr.Spec.AlertManagersURL)
+	require.Equal(t, monitoringv1.Duration("30s"), tr.Spec.EvaluationInterval)
+
+	// The hash changes when the spec changes.
+	p.Spec.Ruler.Replicas = ptr.To(int32(3))
+	tr2, err := makeRuler(p, defaultTestConfig)
+	require.NoError(t, err)
+	require.NotEqual(t, tr.Annotations[operator.InputHashAnnotationKey], tr2.Annotations[operator.InputHashAnnotationKey])
+}
+
+func TestMakeRulerInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		mutate func(*monitoringv1alpha1.PrometheusAgent)
+	}{
+		{
+			name: "no ruler",
+			mutate: func(p *monitoringv1alpha1.PrometheusAgent) {
+				p.Spec.Ruler = nil
+			},
+		},
+		{
+			name: "no query endpoints",
+			mutate: func(p *monitoringv1alpha1.PrometheusAgent) {
+				p.Spec.Ruler.QueryEndpoints = nil
+			},
+		},
+		{
+			name: "no remote-write",
+			mutate: func(p *monitoringv1alpha1.PrometheusAgent) {
+				p.Spec.RemoteWrite = nil
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := makeAgentWithRules()
+			tc.mutate(p)
+
+			_, err := makeRuler(p, defaultTestConfig)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSyncRuler(t *testing.T) {
+	ctx := context.Background()
+	p := makeAgentWithRules()
+
+	c := &Operator{
+		mclient: monitoringfake.NewSimpleClientset(),
+		config:  defaultTestConfig,
+	}
+	logger := slog.New(slog.DiscardHandler)
+	trClient := c.mclient.MonitoringV1().ThanosRulers(p.Namespace)
+
+	// Creation.
+	require.NoError(t, c.syncRuler(ctx, logger, p))
+	tr, err := trClient.Get(ctx, "prom-agent-test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, ptr.To(int32(2)), tr.Spec.Replicas)
+
+	// Update.
+	p.Spec.Ruler.Replicas = ptr.To(int32(3))
+	require.NoError(t, c.syncRuler(ctx, logger, p))
+	tr, err = trClient.Get(ctx, "prom-agent-test", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, ptr.To(int32(3)), tr.Spec.Replicas)
+
+	// Deletion.
+	p.Spec.RuleSelector = nil
+	require.NoError(t, c.syncRuler(ctx, logger, p))
+	_, err = trClient.Get(ctx, "prom-agent-test", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	// Nothing to delete.
+	require.NoError(t, c.syncRuler(ctx, logger, p))
+}
+
+func TestSyncRulerNotManaged(t *testing.T) {
+	ctx := context.Background()
+	p := makeAgentWithRules()
+
+	c := &Operator{
+		mclient: monitoringfake.NewSimpleClientset(&monitoringv1.ThanosRuler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "prom-agent-test",
+				Namespace: "default",
+			},
+		}),
+		config: defaultTestConfig,
+	}
+	logger := slog.New(slog.DiscardHandler)
+
+	require.Error(t, c.syncRuler(ctx, logger, p))
+
+	// The ThanosRuler isn't deleted when it's not managed by the agent.
+	p.Spec.RuleSelector = nil
+	require.NoError(t, c.syncRuler(ctx, logger, p))
+	_, err := c.mclient.MonitoringV1().ThanosRulers(p.Namespace).Get(ctx, "prom-agent-test", metav1.GetOptions{})
+	require.NoError(t, err)
+}
@@ -73,8 +73,7 @@ type ConfigGenerator struct {
 	notCompatible              bool
 	prom                       monitoringv1.PrometheusInterface
 	endpointSliceSupported     bool // True when the cluster supports EndpointSlice.
-	scrapeClasses              map[string]monitoringv1.ScrapeClass
-	defaultScrapeClassName     string
+	scrapeClasses              *scrapeClassRegistry
 	daemonSet                  bool
 	prometheusTopologySharding bool
 	inlineTLSConfig            bool
@@ -152,12 +151,11 @@ func NewConfigGenerator(
 
 	cg.logger = logger.With("version", promVersion)
 
-	scrapeClasses, defaultScrapeClassName, err := getScrapeClassConfig(p)
+	scrapeClasses, err := getScrapeClassConfig(p, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse scrape classes: %w", err)
 	}
 	cg.scrapeClasses = scrapeClasses
-	cg.defaultScrapeClassName = defaultScrapeClassName
 
 	for _, opt := range opts {
 		opt(cg)
@@ -190,47 +188,49 @@ func (cg *ConfigGenerator) endpointRoleFlavor(sdr *monitoringv1.ServiceDiscovery
 	return kubernetesSDRoleEndpoint
 }
 
-func getScrapeClassConfig(p monitoringv1.PrometheusInterface) (map[string]monitoringv1.ScrapeClass, string, error) {
-	var (
-		cpf                = p.GetCommonPrometheusFields()
-		scrapeClasses      = make(map[string]monitoringv1.ScrapeClass, len(cpf.ScrapeClasses))
-		defaultScrapeClass string
-	)
-
-	for _, scrapeClass := range cpf.ScrapeClasses {
-		lcv, err := NewLabelConfigValidator(p)
-		if err != nil {
-			return nil, "", err
-		}
+// getScrapeClassConfig returns the scrape classes available to the scrape
+// resources: the scrape classes defined inline by the Prometheus object and
+// the ScrapeClassDefinition objects (indexed by "<namespace>/<name>")
+// selected by the Prometheus object.
+func getScrapeClassConfig(p monitoringv1.PrometheusInterface, resources map[string]*monitoringv1alpha1.ScrapeClassDefinition) (*scrapeClassRegistry, error) {
+	r := newEmptyScrapeClassRegistry(p)
 
-		if err := lcv.Validate(scrapeClass.Relabelings); err != nil {
-			return nil, "", fmt.Errorf("invalid relabelings for scrapeClass %s: %w", scrapeClass.Name, err)
+	for _, scrapeClass := range p.GetCommonPrometheusFields().ScrapeClasses {
+		if err := validateScrapeClassConfig(p, scrapeClass); err != nil {
+			return nil, err
 		}
 
-		if err := lcv.Validate(scrapeClass.MetricRelabelings); err != nil {
-			return nil, "", fmt.Errorf("invalid metric relabelings for scrapeClass %s: %w", scrapeClass.Name, err)
+		if err := r.addGlobal(scrapeClass); err != nil {
+			return nil, err
 		}
+	}
 
-		if err := scrapeClass.TLSConfig.Validate(); err != nil {
-			return nil, "", fmt.Errorf("invalid TLS config for scrapeClass %s: %w", scrapeClass.Name, err)
+	for _, k := range slices.Sorted(maps.Keys(resources)) {
+		if err := validateScrapeClassConfig(p, resources[k].ToScrapeClass()); err != nil {
+			return nil, fmt.Errorf("ScrapeClassDefinition %s: %w", k, err)
 		}
 
-		if err := scrapeClass.Authorization.Validate(); err != nil {
-			return nil, "", fmt.Errorf("invalid authorization for scrapeClass %s: %w", scrapeClass.Name, err)
+		if err := r.add(resources[k]); err != nil {
+			return nil, fmt.Errorf("ScrapeClassDefinition %s: %w", k, err)
 		}
+	}
 
-		if ptr.Deref(scrapeClass.Default, false) {
-			if defaultScrapeClass != "" {
-				return nil, "", fmt.Errorf("multiple default scrape classes defined")
-			}
-
-			defaultScrapeClass = scrapeClass.Name
-		}
+	return r, nil
+}
 
-		scrapeClasses[scrapeClass.Name] = scrapeClass
+// WithScrapeClasses returns a new ConfigGenerator with the same
+// characteristics as the current object, except that the scrape classes
+// defined by the ScrapeClassDefinition objects are available to the scrape
+// resources.
+func (cg *ConfigGenerator) WithScrapeClasses(resources map[string]*monitoringv1alpha1.ScrapeClassDefinition) (*ConfigGenerator, error) {
+	scrapeClasses, err := getScrapeClassConfig(cg.prom, resources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse scrape classes: %w", err)
 	}
 
-	return scrapeClasses, defaultScrapeClass, nil
+	ncg := *cg
+	ncg.scrapeClasses = scrapeClasses
+	return &ncg, nil
 }
 
 // Version returns the currently configured Prometheus version.
@@ -249,7 +249,6 @@ func (cg *ConfigGenerator) WithKeyVals(keyvals ...any) *ConfigGenerator {
 		prom:                       cg.prom,
 		endpointSliceSupported:     cg.endpointSliceSupported,
 		scrapeClasses:              cg.scrapeClasses,
-		defaultScrapeClassName:     cg.defaultScrapeClassName,
 		daemonSet:                  cg.daemonSet,
 		prometheusTopologySharding: cg.prometheusTopologySharding,
 		inlineTLSConfig:            cg.inlineTLSConfig,
@@ -274,7 +273,6 @@ func (cg *ConfigGenerator) WithMinimumVersion(version string) *ConfigGenerator {
 			prom:                       cg.prom,
 			endpointSliceSupported:     cg.endpointSliceSupported,
 			scrapeClasses:              cg.scrapeClasses,
-			defaultScrapeClassName:     cg.defaultScrapeClassName,
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
@@ -302,7 +300,6 @@ func (cg *ConfigGenerator) WithMaximumVersion(version string) *ConfigGenerator {
 			prom:                       cg.prom,
 			endpointSliceSupported:     cg.endpointSliceSupported,
 			scrapeClasses:              cg.scrapeClasses,
-			defaultScrapeClassName:     cg.defaultScrapeClassName,
 			daemonSet:                  cg.daemonSet,
 			prometheusTopologySharding: cg.prometheusTopologySharding,
 			inlineTLSConfig:            cg.inlineTLSConfig,
@@ -595,6 +592,67 @@ func mergeFallbackScrapeProtocolWithScrapeClass(fallbackScrapeProtocol *monitori
 	return fallbackScrapeProtocol
 }
 
+func mergeScrapeIntervalWithScrapeClass(interval monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if interval == "" && scrapeClass.Interval != nil {
+		interval = *scrapeClass.Interval
+	}
+
+	return interval
+}
+
+func mergeScrapeTimeoutWithScrapeClass(scrapeTimeout monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if scrapeTimeout == "" && scrapeClass.ScrapeTimeout != nil {
+		scrapeTimeout = *scrapeClass.ScrapeTimeout
+	}
+
+	return scrapeTimeout
+}
+
+// mergeLimitWithScrapeClass returns the scrape class limit if the scrape
+// object doesn't define the limit.
+func mergeLimitWithScrapeClass(limit *uint64, scrapeClassLimit *uint64) *uint64 {
+	if limit == nil {
+		limit = scrapeClassLimit
+	}
+
+	return limit
+}
+
+func mergeNativeHistogramConfigWithScrapeClass(nhc monitoringv1.NativeHistogramConfig, scrapeClass monitoringv1.ScrapeClass) monitoringv1.NativeHistogramConfig {
+	if scrapeClass.NativeHistogramConfig == nil {
+		return nhc
+	}
+
+	if nhc.ScrapeClassicHistograms == nil {
+		nhc.ScrapeClassicHistograms = scrapeClass.NativeHistogramConfig.ScrapeClassicHistograms
+	}
+
+	if nhc.NativeHistogramBucketLimit == nil {
+		nhc.NativeHistogramBucketLimit = scrapeClass.NativeHistogramConfig.NativeHistogramBucketLimit
+	}
+
+	if nhc.NativeHistogramMinBucketFactor == nil {
+		nhc.NativeHistogramMinBucketFactor = scrapeClass.NativeHistogramConfig.NativeHistogramMinBucketFactor
+	}
+
+	if nhc.ConvertClassicHistogramsToNHCB == nil {
+		nhc.ConvertClassicHistogramsToNHCB = scrapeClass.NativeHistogramConfig.ConvertClassicHistogramsToNHCB
+	}
+
+	return nhc
+}
+
+// mergeProxyConfigWithScrapeClass returns the scrape class proxy settings if
+// the scrape object doesn't define any proxy setting. The settings aren't
+// merged field by field because they only make sense together.
+func mergeProxyConfigWithScrapeClass(proxyConfig monitoringv1.ProxyConfig, scrapeClass monitoringv1.ScrapeClass) monitoringv1.ProxyConfig {
+	if scrapeClass.ProxyConfig == nil || !reflect.ValueOf(proxyConfig).IsZero() {
+		return proxyConfig
+	}
+
+	return *scrapeClass.ProxyConfig
+}
+
 func (cg *ConfigGenerator) addBasicAuthToYaml(
 	cfg yaml.MapSlice,
 	store assets.StoreGetter,
@@ -1335,7 +1393,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	store *assets.StoreBuilder,
 	shards int32,
 ) yaml.MapSlice {
-	scrapeClass := cg.getScrapeClassOrDefault(m.Spec.ScrapeClassName)
+	scrapeClass := cg.getScrapeClassOrDefault(m.Namespace, m.Spec.ScrapeClassName)
 
 	cfg := yaml.MapSlice{
 		{
@@ -1362,11 +1420,11 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 			attachMetaConfig,
 			cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)))
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ep.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
@@ -1395,7 +1453,7 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 	cfg = cg.addBasicAuthToYaml(cfg, s, ep.BasicAuth)
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(ep.ProxyConfig, scrapeClass))
 
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(ep.Authorization, scrapeClass))
 
@@ -1550,13 +1608,13 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -1584,7 +1642,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	store *assets.StoreBuilder,
 	shards int32,
 ) yaml.MapSlice {
-	scrapeClass := cg.getScrapeClassOrDefault(m.Spec.ScrapeClassName)
+	scrapeClass := cg.getScrapeClassOrDefault(m.Namespace, m.Spec.ScrapeClassName)
 
 	jobName := fmt.Sprintf("probe/%s/%s", m.Namespace, m.Name)
 	cfg := yaml.MapSlice{
@@ -1599,11 +1657,11 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: m.Spec.ProberSpec.Path})
 
-	if m.Spec.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: m.Spec.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(m.Spec.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if m.Spec.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: m.Spec.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(m.Spec.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 	if m.Spec.ProberSpec.Scheme != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: m.Spec.ProberSpec.Scheme.String()})
@@ -1627,13 +1685,13 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	}
 
 	cpf := cg.prom.GetCommonPrometheusFields()
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -1655,7 +1713,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	s := store.ForNamespace(m.Namespace)
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, m.Spec.ProberSpec.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(m.Spec.ProberSpec.ProxyConfig, scrapeClass))
 
 	// As stated in the CRD documentation, if both StaticConfig and Ingress are
 	// defined, the former takes precedence which is why the first case statement
@@ -1837,7 +1895,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	store *assets.StoreBuilder,
 	shards int32,
 ) yaml.MapSlice {
-	scrapeClass := cg.getScrapeClassOrDefault(m.Spec.ScrapeClassName)
+	scrapeClass := cg.getScrapeClassOrDefault(m.Namespace, m.Spec.ScrapeClassName)
 
 	cfg := yaml.MapSlice{
 		{
@@ -1869,11 +1927,11 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)),
 	)
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	if interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ep.ScrapeTimeout, scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
@@ -1891,7 +1949,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *ep.EnableHttp2)
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(ep.ProxyConfig, scrapeClass))
 
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
@@ -2094,13 +2152,13 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	relabelings = appendShardingRelabelingWithAddress(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
 	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
@@ -3189,7 +3247,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 	s assets.StoreGetter,
 	shards int32,
 ) (yaml.MapSlice, error) {
-	scrapeClass := cg.getScrapeClassOrDefault(sc.Spec.ScrapeClassName)
+	scrapeClass := cg.getScrapeClassOrDefault(sc.Namespace, sc.Spec.ScrapeClassName)
 
 	jobName := fmt.Sprintf("scrapeConfig/%s/%s", sc.Namespace, sc.Name)
 
@@ -3242,12 +3300,12 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *sc.Spec.EnableHTTP2)
 	}
 
-	if sc.Spec.ScrapeInterval != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: *sc.Spec.ScrapeInterval})
+	if interval := mergeScrapeIntervalWithScrapeClass(ptr.Deref(sc.Spec.ScrapeInterval, ""), scrapeClass); interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
 
-	if sc.Spec.ScrapeTimeout != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: *sc.Spec.ScrapeTimeout})
+	if scrapeTimeout := mergeScrapeTimeoutWithScrapeClass(ptr.Deref(sc.Spec.ScrapeTimeout, ""), scrapeClass); scrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: scrapeTimeout})
 	}
 
 	cfg = cg.addScrapeProtocols(cfg, sc.Spec.ScrapeProtocols)
@@ -3257,7 +3315,7 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: sc.Spec.Scheme.String()})
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, sc.Spec.ProxyConfig)
+	cfg = cg.addProxyConfigtoYaml(cfg, s, mergeProxyConfigWithScrapeClass(sc.Spec.ProxyConfig, scrapeClass))
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, sc.Spec.BasicAuth)
 
@@ -3267,13 +3325,13 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 
 	cfg = cg.addTLStoYaml(cfg, s, mergeSafeTLSConfigWithScrapeClass(sc.Spec.TLSConfig, scrapeClass))
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, sc.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, sc.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, sc.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, sc.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, sc.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(sc.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(sc.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, sc.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, sc.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(sc.Spec.NativeHistogramConfig, scrapeClass))
 
 	if cpf.EnforcedBodySizeLimit != "" {
 		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", cpf.EnforcedBodySizeLimit)
@@ -4788,6 +4846,257 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		})
 	}
 
+	// MarathonSDConfig
+	if len(sc.Spec.MarathonSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.MarathonSDConfigs))
+		for i, config := range sc.Spec.MarathonSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "servers",
+				Value: config.Servers,
+			})
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.AuthToken != nil {
+				value, _ := s.GetSecretKey(*config.AuthToken)
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "auth_token",
+					Value: string(value),
+				})
+			}
+
+			configs[i] = cg.addBasicAuthToYaml(configs[i], s, config.BasicAuth)
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "marathon_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// TritonSDConfig
+	if len(sc.Spec.TritonSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.TritonSDConfigs))
+		for i, config := range sc.Spec.TritonSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "account",
+				Value: config.Account,
+			})
+
+			if config.Role != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "role",
+					Value: strings.ToLower(string(*config.Role)),
+				})
+			}
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "dns_suffix",
+				Value: config.DNSSuffix,
+			})
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "endpoint",
+				Value: config.Endpoint,
+			})
+
+			if len(config.Groups) > 0 {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "groups",
+					Value: config.Groups,
+				})
+			}
+
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.Version != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "version",
+					Value: config.Version,
+				})
+			}
+
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "triton_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// UyuniSDConfig
+	if len(sc.Spec.UyuniSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.UyuniSDConfigs))
+		for i, config := range sc.Spec.UyuniSDConfigs {
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "server",
+				Value: config.Server,
+			})
+
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "username",
+				Value: config.Username,
+			})
+
+			value, _ := s.GetSecretKey(config.Password)
+			configs[i] = append(configs[i], yaml.MapItem{
+				Key:   "password",
+				Value: string(value),
+			})
+
+			if config.Entitlement != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "entitlement",
+					Value: config.Entitlement,
+				})
+			}
+
+			if config.Separator != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "separator",
+					Value: config.Separator,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "uyuni_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// VultrSDConfig
+	if len(sc.Spec.VultrSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.VultrSDConfigs))
+		for i, config := range sc.Spec.VultrSDConfigs {
+			configs[i] = cg.addSafeAuthorizationToYaml(configs[i], s, config.Authorization)
+			configs[i] = cg.addOAuth2ToYaml(configs[i], s, config.OAuth2)
+			configs[i] = cg.addProxyConfigtoYaml(configs[i], s, config.ProxyConfig)
+			configs[i] = cg.addSafeTLStoYaml(configs[i], s, config.TLSConfig)
+
+			if config.Port != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "port",
+					Value: config.Port,
+				})
+			}
+
+			if config.RefreshInterval != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "refresh_interval",
+					Value: config.RefreshInterval,
+				})
+			}
+
+			if config.FollowRedirects != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "follow_redirects",
+					Value: config.FollowRedirects,
+				})
+			}
+
+			if config.EnableHTTP2 != nil {
+				configs[i] = append(configs[i], yaml.MapItem{
+					Key:   "enable_http2",
+					Value: config.EnableHTTP2,
+				})
+			}
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "vultr_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// ServersetSDConfig
+	if len(sc.Spec.ServersetSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.ServersetSDConfigs))
+		for i, config := range sc.Spec.ServersetSDConfigs {
+			configs[i] = cg.addZookeeperSDConfigToYaml(configs[i], config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "serverset_sd_configs",
+			Value: configs,
+		})
+	}
+
+	// NerveSDConfig
+	if len(sc.Spec.NerveSDConfigs) > 0 {
+		configs := make([][]yaml.MapItem, len(sc.Spec.NerveSDConfigs))
+		for i, config := range sc.Spec.NerveSDConfigs {
+			configs[i] = cg.addZookeeperSDConfigToYaml(configs[i], config.Servers, config.Paths, config.Timeout)
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "nerve_sd_configs",
+			Value: configs,
+		})
+	}
+
 	if len(sc.Spec.RelabelConfigs) > 0 {
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(sc.TypeMeta, sc.ObjectMeta, sc.Spec.RelabelConfigs))...)
 	}
@@ -5019,20 +5328,12 @@ func (cg *ConfigGenerator) appendConvertScrapeClassicHistograms(cfg yaml.MapSlic
 	return cg.WithMinimumVersion("3.5.0").AppendMapItem(cfg, "always_scrape_classic_histograms", *cpf.ScrapeClassicHistograms)
 }
 
-func (cg *ConfigGenerator) getScrapeClassOrDefault(name *string) monitoringv1.ScrapeClass {
-	if name != nil {
-		if scrapeClass, found := cg.scrapeClasses[*name]; found {
-			return scrapeClass
-		}
-	}
-
-	if cg.defaultScrapeClassName != "" {
-		if scrapeClass, found := cg.scrapeClasses[cg.defaultScrapeClassName]; found {
-			return scrapeClass
-		}
+func (cg *ConfigGenerator) getScrapeClassOrDefault(namespace string, name *string) monitoringv1.ScrapeClass {
+	if cg.scrapeClasses == nil {
+		return monitoringv1.ScrapeClass{}
 	}
 
-	return monitoringv1.ScrapeClass{}
+	return cg.scrapeClasses.getOrDefault(namespace, name)
 }
 
 func getLowerByteSize(v *monitoringv1.ByteSize, cpf *monitoringv1.CommonPrometheusFields) *monitoringv1.ByteSize {
@@ -5058,6 +5359,21 @@ func isByteSizeEmpty(v *monitoringv1.ByteSize) bool {
 	return v == nil || *v == ""
 }
 
+// addZookeeperSDConfigToYaml appends the fields shared by the Zookeeper-based
+// service discovery mechanisms (serverset and nerve).
+func (cg *ConfigGenerator) addZookeeperSDConfigToYaml(cfg yaml.MapSlice, servers, paths []string, timeout *monitoringv1.Duration) yaml.MapSlice {
+	cfg = append(cfg,
+		yaml.MapItem{Key: "servers", Value: servers},
+		yaml.MapItem{Key: "paths", Value: paths},
+	)
+
+	if timeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: timeout})
+	}
+
+	return cfg
+}
+
 func (cg *ConfigGenerator) addFiltersToYaml(cfg yaml.MapSlice, filters []monitoringv1alpha1.Filter) yaml.MapSlice {
 	if len(filters) == 0 {
 		return cfg
@@ -12352,6 +12352,521 @@ func TestScrapeConfigSpecConfigWithIonosSD(t *testing.T) {
 	}
 }
 
+func TestScrapeConfigSpecConfigWithMarathonSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "marathon_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				MarathonSDConfigs: []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers:         []string{"https://marathon-1.example.com:8080", "https://marathon-2.example.com:8080"},
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "token",
+						},
+						ProxyConfig: monitoringv1.ProxyConfig{
+							ProxyURL:             ptr.To("http://no-proxy.com"),
+							NoProxy:              ptr.To("0.0.0.0"),
+							ProxyFromEnvironment: ptr.To(true),
+							ProxyConnectHeader: map[string][]v1.SecretKeySelector{
+								"header": {
+									{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: "secret",
+										},
+										Key: "proxy-header",
+									},
+								},
+							},
+						},
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_MarathonSD.golden",
+		},
+		{
+			name: "marathon_sd_config_oauth2",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				MarathonSDConfigs: []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						OAuth2: &monitoringv1.OAuth2{
+							ClientID: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &v1.ConfigMapKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "oauth2",
+									},
+									Key: "client_id",
+								},
+							},
+							ClientSecret: v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "oauth2",
+								},
+								Key: "client_secret",
+							},
+							TokenURL: "http://test.url",
+						},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_MarathonSD_withOAuth2.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"proxy-header": []byte("value"),
+						"token":        []byte("value"),
+						"credential":   []byte("value"),
+					},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithTritonSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "triton_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				TritonSDConfigs: []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:         "testAccount",
+						Role:            ptr.To(monitoringv1alpha1.TritonRoleContainer),
+						DNSSuffix:       "triton.example.com",
+						Endpoint:        "cmon.triton.example.com",
+						Groups:          []string{"group1", "group2"},
+						Port:            ptr.To(int32(9163)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+						Version:         ptr.To(int32(1)),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_TritonSD.golden",
+		},
+		{
+			name: "triton_sd_config_cn_role",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				TritonSDConfigs: []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "testAccount",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleCN),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_TritonSD_CNRole.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"proxy-header": []byte("value"),
+						"token":        []byte("value"),
+						"credential":   []byte("value"),
+					},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithUyuniSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "uyuni_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				UyuniSDConfigs: []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "credential",
+						},
+						Entitlement:     ptr.To("monitoring_entitled"),
+						Separator:       ptr.To(","),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("1m")),
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_UyuniSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"proxy-header": []byte("value"),
+						"token":        []byte("value"),
+						"credential":   []byte("value"),
+					},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithVultrSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "vultr_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				VultrSDConfigs: []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "credential",
+							},
+						},
+						Port:            ptr.To(int32(9100)),
+						RefreshInterval: (*monitoringv1.Duration)(ptr.To("30s")),
+						FollowRedirects: ptr.To(true),
+						EnableHTTP2:     ptr.To(true),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_VultrSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"proxy-header": []byte("value"),
+						"token":        []byte("value"),
+						"credential":   []byte("value"),
+					},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestScrapeConfigSpecConfigWithZookeeperSD(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		scSpec monitoringv1alpha1.ScrapeConfigSpec
+		golden string
+	}{
+		{
+			name: "serverset_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				ServersetSDConfigs: []monitoringv1alpha1.ServersetSDConfig{
+					{
+						Servers: []string{"zk-1.example.com:2181", "zk-2.example.com:2181"},
+						Paths:   []string{"/aurora/jobs/prod"},
+						Timeout: (*monitoringv1.Duration)(ptr.To("10s")),
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_ServersetSD.golden",
+		},
+		{
+			name: "nerve_sd_config",
+			scSpec: monitoringv1alpha1.ScrapeConfigSpec{
+				NerveSDConfigs: []monitoringv1alpha1.NerveSDConfig{
+					{
+						Servers: []string{"zk-1.example.com:2181"},
+						Paths:   []string{"/nerve/services/api", "/nerve/services/web"},
+					},
+				},
+			},
+			golden: "ScrapeConfigSpecConfig_NerveSD.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"proxy-header": []byte("value"),
+						"token":        []byte("value"),
+						"credential":   []byte("value"),
+					},
+				},
+				&v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"client_id": "client-id",
+					},
+				},
+				&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "oauth2",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"client_secret": []byte("client-secret"),
+					},
+				},
+			)
+
+			scs := map[string]*monitoringv1alpha1.ScrapeConfig{
+				"sc": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testscrapeconfig1",
+						Namespace: "default",
+					},
+					Spec: tc.scSpec,
+				},
+			}
+
+			p := defaultPrometheus()
+			cg := mustNewConfigGenerator(t, p)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				nil,
+				nil,
+				nil,
+				scs,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
 func TestServiceMonitorWithDefaultScrapeClassRelabelings(t *testing.T) {
 	p := defaultPrometheus()
 	serviceMonitor := defaultServiceMonitor()
@@ -13154,6 +13669,254 @@ func TestScrapeClassFallbackScrapeProtocol(t *testing.T) {
 	}
 }
 
+func TestScrapeClassScrapeSettings(t *testing.T) {
+	scrapeClass := monitoringv1.ScrapeClass{
+		Name:                  "test-scrape-settings-scrape-class",
+		Interval:              ptr.To(monitoringv1.Duration("10s")),
+		ScrapeTimeout:         ptr.To(monitoringv1.Duration("5s")),
+		SampleLimit:           ptr.To(uint64(1000)),
+		TargetLimit:           ptr.To(uint64(10)),
+		LabelLimit:            ptr.To(uint64(50)),
+		LabelNameLengthLimit:  ptr.To(uint64(100)),
+		LabelValueLengthLimit: ptr.To(uint64(200)),
+		NativeHistogramConfig: &monitoringv1.NativeHistogramConfig{
+			NativeHistogramBucketLimit: ptr.To(uint64(20)),
+			ScrapeClassicHistograms:    ptr.To(true),
+		},
+		ProxyConfig: &monitoringv1.ProxyConfig{
+			ProxyURL: ptr.To("http://proxy.example.com:3128"),
+			NoProxy:  ptr.To("10.0.0.0/8"),
+		},
+	}
+
+	defaultScrapeClass := scrapeClass
+	defaultScrapeClass.Name = "default"
+	defaultScrapeClass.Default = ptr.To(true)
+
+	serviceMonitorWithOverrides := defaultServiceMonitor()
+	serviceMonitorWithOverrides.Spec.SampleLimit = ptr.To(uint64(500))
+	serviceMonitorWithOverrides.Spec.ScrapeClassicHistograms = ptr.To(false)
+	serviceMonitorWithOverrides.Spec.Endpoints[0].ScrapeTimeout = "20s"
+	serviceMonitorWithOverrides.Spec.Endpoints[0].ProxyURL = ptr.To("http://other-proxy.example.com:3128")
+
+	podMonitorWithNonDefaultScrapeClass := defaultPodMonitor()
+	podMonitorWithNonDefaultScrapeClass.Spec.ScrapeClassName = ptr.To(scrapeClass.Name)
+	podMonitorWithNonDefaultScrapeClass.Spec.PodMetricsEndpoints[0].Interval = ""
+
+	probeWithNonDefaultScrapeClass := defaultProbe()
+	probeWithNonDefaultScrapeClass.Spec.ScrapeClassName = ptr.To(scrapeClass.Name)
+
+	scrapeConfigWithNonDefaultScrapeClass := defaultScrapeConfig()
+	scrapeConfigWithNonDefaultScrapeClass.Spec.ScrapeClassName = ptr.To(scrapeClass.Name)
+
+	for _, tc := range []struct {
+		name            string
+		scrapeClasses   []monitoringv1.ScrapeClass
+		serviceMonitors map[string]*monitoringv1.ServiceMonitor
+		podMonitors     map[string]*monitoringv1.PodMonitor
+		probes          map[string]*monitoringv1.Probe
+		scrapeConfigs   map[string]*monitoringv1alpha1.ScrapeConfig
+		goldenFile      string
+	}{
+		{
+			name:            "ServiceMonitor with default ScrapeClass scrape settings",
+			scrapeClasses:   []monitoringv1.ScrapeClass{defaultScrapeClass},
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": defaultServiceMonitor()},
+			goldenFile:      "serviceMonitorObjectWithDefaultScrapeClassWithScrapeSettings.golden",
+		},
+		{
+			name:            "ServiceMonitor overriding the default ScrapeClass scrape settings",
+			scrapeClasses:   []monitoringv1.ScrapeClass{defaultScrapeClass},
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitorWithOverrides},
+			goldenFile:      "serviceMonitorObjectOverridingDefaultScrapeClassScrapeSettings.golden",
+		},
+		{
+			name:          "PodMonitor with non-default ScrapeClass scrape settings",
+			scrapeClasses: []monitoringv1.ScrapeClass{scrapeClass},
+			podMonitors:   map[string]*monitoringv1.PodMonitor{"monitor": podMonitorWithNonDefaultScrapeClass},
+			goldenFile:    "podMonitorObjectWithNonDefaultScrapeClassWithScrapeSettings.golden",
+		},
+		{
+			name:          "Probe with non-default ScrapeClass scrape settings",
+			scrapeClasses: []monitoringv1.ScrapeClass{scrapeClass},
+			probes:        map[string]*monitoringv1.Probe{"monitor": probeWithNonDefaultScrapeClass},
+			goldenFile:    "probeObjectWithNonDefaultScrapeClassWithScrapeSettings.golden",
+		},
+		{
+			name:          "ScrapeConfig with non-default ScrapeClass scrape settings",
+			scrapeClasses: []monitoringv1.ScrapeClass{scrapeClass},
+			scrapeConfigs: map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": scrapeConfigWithNonDefaultScrapeClass},
+			goldenFile:    "scrapeConfigObjectWithNonDefaultScrapeClassWithScrapeSettings.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.CommonPrometheusFields.EnforcedSampleLimit = ptr.To(uint64(800))
+
+			p.Spec.ScrapeClasses = tc.scrapeClasses
+			cg := mustNewConfigGenerator(t, p)
+
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				tc.serviceMonitors,
+				tc.podMonitors,
+				tc.probes,
+				tc.scrapeConfigs,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.goldenFile)
+		})
+	}
+}
+
+func TestScrapeClassDefinitions(t *testing.T) {
+	scrapeClassWithLabel := func(name, value string) monitoringv1.ScrapeClass {
+		return monitoringv1.ScrapeClass{
+			Name: name,
+			Relabelings: []monitoringv1.RelabelConfig{
+				{
+					TargetLabel: "scrape_class",
+					Replacement: ptr.To(value),
+				},
+			},
+		}
+	}
+
+	scrapeClassDefinition := func(namespace, name string, isDefault bool) *monitoringv1alpha1.ScrapeClassDefinition {
+		sc := scrapeClassWithLabel(name, namespace+"/"+name)
+		return &monitoringv1alpha1.ScrapeClassDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: monitoringv1alpha1.ScrapeClassDefinitionSpec{
+				Default:     ptr.To(isDefault),
+				Relabelings: sc.Relabelings,
+				SampleLimit: ptr.To(uint64(100)),
+			},
+		}
+	}
+
+	serviceMonitor := func(namespace string, scrapeClassName *string) *monitoringv1.ServiceMonitor {
+		sm := defaultServiceMonitor()
+		sm.Namespace = namespace
+		sm.Spec.ScrapeClassName = scrapeClassName
+		return sm
+	}
+
+	p := defaultPrometheus()
+	inlineScrapeClass := scrapeClassWithLabel("inline", "inline")
+	inlineScrapeClass.Default = ptr.To(true)
+	p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{inlineScrapeClass}
+
+	cg, err := mustNewConfigGenerator(t, p).WithScrapeClasses(map[string]*monitoringv1alpha1.ScrapeClassDefinition{
+		"default/shared": scrapeClassDefinition("default", "shared", false),
+		"team-a/team-a":  scrapeClassDefinition("team-a", "team-a", true),
+		"team-a/shared2": scrapeClassDefinition("team-a", "shared2", false),
+	})
+	require.NoError(t, err)
+
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		map[string]*monitoringv1.ServiceMonitor{
+			// Uses the inline default scrape class.
+			"default": serviceMonitor("default", nil),
+			// Uses the namespace's default scrape class.
+			"team-a": serviceMonitor("team-a", nil),
+			// Uses the scrape class defined in the Prometheus namespace.
+			"team-b": serviceMonitor("team-b", ptr.To("shared")),
+			// Falls back to the default scrape class because the scrape
+			// class is defined in another namespace.
+			"team-c": serviceMonitor("team-c", ptr.To("shared2")),
+		},
+		nil,
+		nil,
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	golden.Assert(t, string(cfg), "scrapeClassDefinitions.golden")
+}
+
+func TestWithScrapeClassesConflict(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{{Name: "inline", Default: ptr.To(true)}}
+
+	_, err := mustNewConfigGenerator(t, p).WithScrapeClasses(map[string]*monitoringv1alpha1.ScrapeClassDefinition{
+		"team-a/inline": {
+			ObjectMeta: metav1.ObjectMeta{Name: "inline", Namespace: "team-a"},
+		},
+	})
+	require.ErrorContains(t, err, "ScrapeClassDefinition team-a/inline: scrape class \"inline\" already defined by the Prometheus resource")
+}
+
+func TestNewConfigGeneratorWithInvalidScrapeClassSettings(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		scrapeClass monitoringv1.ScrapeClass
+	}{
+		{
+			name: "scrape timeout greater than the scrape interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:          "test",
+				Interval:      ptr.To(monitoringv1.Duration("10s")),
+				ScrapeTimeout: ptr.To(monitoringv1.Duration("20s")),
+			},
+		},
+		{
+			name: "scrape timeout greater than the global scrape interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:          "test",
+				ScrapeTimeout: ptr.To(monitoringv1.Duration("1m")),
+			},
+		},
+		{
+			name: "proxy connect header",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "test",
+				ProxyConfig: &monitoringv1.ProxyConfig{
+					ProxyURL: ptr.To("http://proxy.example.com:3128"),
+					ProxyConnectHeader: map[string][]v1.SecretKeySelector{
+						"header": {
+							{
+								LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+								Key:                  "key",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid proxy config",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "test",
+				ProxyConfig: &monitoringv1.ProxyConfig{
+					NoProxy: ptr.To("10.0.0.0/8"),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{tc.scrapeClass}
+
+			_, err := NewConfigGenerator(newLogger(), p)
+			require.Error(t, err)
+		})
+	}
+}
+
 func TestGenerateAlertmanagerConfig(t *testing.T) {
 	for _, tc := range []struct {
 		alerting *monitoringv1.AlertingSpec
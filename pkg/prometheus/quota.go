@@ -0,0 +1,280 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// QuotaExceeded is the reason for scrape resources rejected because
+	// they exceed the MonitoringQuota of their namespace.
+	QuotaExceeded = "QuotaExceeded"
+
+	// QuotaClamped is the reason for scrape resources whose limits have
+	// been lowered to fit in the MonitoringQuota of their namespace.
+	QuotaClamped = "QuotaClamped"
+)
+
+// namespaceQuota tracks the scrape budget of a namespace.
+type namespaceQuota struct {
+	names       []string
+	sampleLimit *uint64
+	targetLimit *uint64
+	enforcement monitoringv1alpha1.QuotaEnforcement
+
+	usedSamples uint64
+	usedTargets uint64
+}
+
+// newNamespaceQuota merges the MonitoringQuota objects of a namespace.
+// It returns nil if the list is empty.
+func newNamespaceQuota(quotas []*monitoringv1alpha1.MonitoringQuota) *namespaceQuota {
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	q := &namespaceQuota{
+		enforcement: monitoringv1alpha1.ClampQuotaEnforcement,
+	}
+	for _, mq := range quotas {
+		q.names = append(q.names, mq.Name)
+		q.sampleLimit = minLimit(q.sampleLimit, mq.Spec.SampleLimit)
+		q.targetLimit = minLimit(q.targetLimit, mq.Spec.TargetLimit)
+
+		if ptr.Deref(mq.Spec.Enforcement, monitoringv1alpha1.RejectQuotaEnforcement) == monitoringv1alpha1.RejectQuotaEnforcement {
+			q.enforcement = monitoringv1alpha1.RejectQuotaEnforcement
+		}
+	}
+	slices.Sort(q.names)
+
+	return q
+}
+
+func minLimit(a, b *uint64) *uint64 {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case *b < *a:
+		return b
+	}
+
+	return a
+}
+
+// effectiveLimit returns the limit applying to a scrape resource given its
+// own limit (or the limit of its scrape class) and the defaults of the
+// Prometheus resource. Zero means no limit.
+func effectiveLimit(limit, defaultLimit, enforcedLimit *uint64) uint64 {
+	l := ptr.Deref(limit, 0)
+	if l == 0 {
+		l = ptr.Deref(defaultLimit, 0)
+	}
+
+	if l == 0 {
+		l = ptr.Deref(enforcedLimit, 0)
+	}
+
+	if e := ptr.Deref(enforcedLimit, 0); e > 0 && l > e {
+		l = e
+	}
+
+	return l
+}
+
+// admit consumes the budget for the given limits (zero meaning no limit).
+// It returns the limits which fit in the remaining budget: they are lower
+// than the requested values if the limits have been clamped.
+func (q *namespaceQuota) admit(sampleLimit, targetLimit uint64) (uint64, uint64, error) {
+	sampleLimit, err := q.fit("sample", q.sampleLimit, q.usedSamples, sampleLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	targetLimit, err = q.fit("target", q.targetLimit, q.usedTargets, targetLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if q.sampleLimit != nil {
+		q.usedSamples += sampleLimit
+	}
+
+	if q.targetLimit != nil {
+		q.usedTargets += targetLimit
+	}
+
+	return sampleLimit, targetLimit, nil
+}
+
+func (q *namespaceQuota) fit(name string, quota *uint64, used uint64, limit uint64) (uint64, error) {
+	if quota == nil {
+		return limit, nil
+	}
+
+	remaining := *quota - min(used, *quota)
+
+	// An object without limit is always rejected, even with the Clamp
+	// policy: granting it the whole remaining budget would starve the
+	// objects processed after it.
+	if limit == 0 {
+		return 0, fmt.Errorf(
+			"no %s limit defined while the MonitoringQuota %s requires one (remaining %s budget: %d out of %d)",
+			name,
+			strings.Join(q.names, ","),
+			name,
+			remaining,
+			*quota,
+		)
+	}
+
+	if limit <= remaining {
+		return limit, nil
+	}
+
+	if q.enforcement == monitoringv1alpha1.ClampQuotaEnforcement && remaining > 0 {
+		return remaining, nil
+	}
+
+	return 0, fmt.Errorf(
+		"%s limit %d exceeds the remaining %s budget (%d out of %d) of the MonitoringQuota %s",
+		name,
+		limit,
+		name,
+		remaining,
+		*quota,
+		strings.Join(q.names, ","),
+	)
+}
+
+// scrapeLimits returns pointers to the sample and target limit fields of the
+// scrape resource as well as its scrape class name. It returns nil values if
+// the object isn't a scrape resource.
+func scrapeLimits(obj any) (**uint64, **uint64, *string) {
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		return &o.Spec.SampleLimit, &o.Spec.TargetLimit, o.Spec.ScrapeClassName
+	case *monitoringv1.PodMonitor:
+		return &o.Spec.SampleLimit, &o.Spec.TargetLimit, o.Spec.ScrapeClassName
+	case *monitoringv1.Probe:
+		return &o.Spec.SampleLimit, &o.Spec.TargetLimit, o.Spec.ScrapeClassName
+	case *monitoringv1alpha1.ScrapeConfig:
+		return &o.Spec.SampleLimit, &o.Spec.TargetLimit, o.Spec.ScrapeClassName
+	}
+
+	return nil, nil, nil
+}
+
+// getNamespaceQuota returns the quota of the namespace (nil if none).
+func (rs *ResourceSelector) getNamespaceQuota(ns string) (*namespaceQuota, error) {
+	if q, found := rs.quotas[ns]; found {
+		return q, nil
+	}
+
+	var quotas []*monitoringv1alpha1.MonitoringQuota
+	err := rs.quotaListFn(ns, labels.Everything(), func(o any) {
+		quotas = append(quotas, o.(*monitoringv1alpha1.MonitoringQuota))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MonitoringQuota objects in namespace %s: %w", ns, err)
+	}
+
+	q := newNamespaceQuota(quotas)
+	rs.quotas[ns] = q
+
+	return q, nil
+}
+
+// enforceQuotas rejects or clamps the valid resources exceeding the quota of
+// their namespace. It returns the number of rejected resources.
+// The resources are processed in lexicographic order to ensure stable
+// results across reconciliations.
+func enforceQuotas[T operator.ConfigurationResource](logger *slog.Logger, rs *ResourceSelector, res operator.TypedResourcesSelection[T]) (int, error) {
+	var (
+		rejected int
+		cpf      = rs.p.GetCommonPrometheusFields()
+		valid    = res.ValidResources()
+	)
+
+	for _, k := range slices.Sorted(maps.Keys(valid)) {
+		obj := valid[k]
+		ns, _, _ := strings.Cut(k, "/")
+
+		sampleLimit, targetLimit, scrapeClassName := scrapeLimits(obj)
+		if sampleLimit == nil {
+			// Not a scrape resource.
+			continue
+		}
+
+		q, err := rs.getNamespaceQuota(ns)
+		if err != nil {
+			return 0, err
+		}
+
+		if q == nil {
+			continue
+		}
+
+		var (
+			scrapeClass      = rs.scrapeClasses.getOrDefault(ns, scrapeClassName)
+			requestedSamples = effectiveLimit(mergeLimitWithScrapeClass(*sampleLimit, scrapeClass.SampleLimit), cpf.SampleLimit, cpf.EnforcedSampleLimit)
+			requestedTargets = effectiveLimit(mergeLimitWithScrapeClass(*targetLimit, scrapeClass.TargetLimit), cpf.TargetLimit, cpf.EnforcedTargetLimit)
+			generation       = any(obj).(metav1.Object).GetGeneration()
+		)
+
+		samples, targets, err := q.admit(requestedSamples, requestedTargets)
+		if err != nil {
+			rejected++
+			logger.Warn("skipping object", "error", err.Error(), "object", k)
+			rs.eventRecorder.Eventf(any(obj).(runtime.Object), v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingConfigurationResourcesAction, "%q was rejected because it exceeds the quota: %v", k, err)
+			res[k] = operator.NewTypedConfigurationResource(obj, err, QuotaExceeded, generation)
+			continue
+		}
+
+		var warnings []string
+		if samples != requestedSamples {
+			*sampleLimit = ptr.To(samples)
+			warnings = append(warnings, fmt.Sprintf("sample limit clamped to %d by the MonitoringQuota %s", samples, strings.Join(q.names, ",")))
+		}
+
+		if targets != requestedTargets {
+			*targetLimit = ptr.To(targets)
+			warnings = append(warnings, fmt.Sprintf("target limit clamped to %d by the MonitoringQuota %s", targets, strings.Join(q.names, ",")))
+		}
+
+		if len(warnings) > 0 {
+			logger.Debug("object limits clamped", "object", k, "warnings", strings.Join(warnings, "; "))
+			res[k] = operator.NewTypedConfigurationResourceWithWarnings(obj, QuotaClamped, warnings, generation)
+		}
+	}
+
+	return rejected, nil
+}
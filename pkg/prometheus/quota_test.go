@@ -0,0 +1,276 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func makeMonitoringQuota(name string, sampleLimit, targetLimit *uint64, enforcement *monitoringv1alpha1.QuotaEnforcement) *monitoringv1alpha1.MonitoringQuota {
+	return &monitoringv1alpha1.MonitoringQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Spec: monitoringv1alpha1.MonitoringQuotaSpec{
+			SampleLimit: sampleLimit,
+			TargetLimit: targetLimit,
+			Enforcement: enforcement,
+		},
+	}
+}
+
+func TestNewNamespaceQuota(t *testing.T) {
+	require.Nil(t, newNamespaceQuota(nil))
+
+	q := newNamespaceQuota([]*monitoringv1alpha1.MonitoringQuota{
+		makeMonitoringQuota("b", ptr.To(uint64(1000)), nil, ptr.To(monitoringv1alpha1.ClampQuotaEnforcement)),
+		makeMonitoringQuota("a", ptr.To(uint64(500)), ptr.To(uint64(10)), ptr.To(monitoringv1alpha1.ClampQuotaEnforcement)),
+	})
+	require.Equal(t, []string{"a", "b"}, q.names)
+	require.Equal(t, ptr.To(uint64(500)), q.sampleLimit)
+	require.Equal(t, ptr.To(uint64(10)), q.targetLimit)
+	require.Equal(t, monitoringv1alpha1.ClampQuotaEnforcement, q.enforcement)
+
+	// Reject (the default) takes precedence over Clamp.
+	q = newNamespaceQuota([]*monitoringv1alpha1.MonitoringQuota{
+		makeMonitoringQuota("a", ptr.To(uint64(500)), nil, ptr.To(monitoringv1alpha1.ClampQuotaEnforcement)),
+		makeMonitoringQuota("b", nil, ptr.To(uint64(10)), nil),
+	})
+	require.Equal(t, monitoringv1alpha1.RejectQuotaEnforcement, q.enforcement)
+}
+
+func TestEffectiveLimit(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		limit    *uint64
+		def      *uint64
+		enforced *uint64
+		expected uint64
+	}{
+		{
+			name: "no limit",
+		},
+		{
+			name:     "own limit",
+			limit:    ptr.To(uint64(100)),
+			def:      ptr.To(uint64(50)),
+			expected: 100,
+		},
+		{
+			name:     "default limit",
+			def:      ptr.To(uint64(50)),
+			expected: 50,
+		},
+		{
+			name:     "enforced limit without limit",
+			enforced: ptr.To(uint64(20)),
+			expected: 20,
+		},
+		{
+			name:     "enforced limit lower than limit",
+			limit:    ptr.To(uint64(100)),
+			enforced: ptr.To(uint64(20)),
+			expected: 20,
+		},
+		{
+			name:     "enforced limit greater than limit",
+			limit:    ptr.To(uint64(10)),
+			enforced: ptr.To(uint64(20)),
+			expected: 10,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, effectiveLimit(tc.limit, tc.def, tc.enforced))
+		})
+	}
+}
+
+func TestNamespaceQuotaAdmit(t *testing.T) {
+	t.Run("reject", func(t *testing.T) {
+		q := newNamespaceQuota([]*monitoringv1alpha1.MonitoringQuota{
+			makeMonitoringQuota("quota", ptr.To(uint64(1000)), nil, nil),
+		})
+
+		samples, targets, err := q.admit(600, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(600), samples)
+		require.Equal(t, uint64(0), targets)
+
+		_, _, err = q.admit(600, 0)
+		require.ErrorContains(t, err, "sample limit 600 exceeds the remaining sample budget (400 out of 1000) of the MonitoringQuota quota")
+
+		// No sample limit means that the budget is exceeded.
+		_, _, err = q.admit(0, 0)
+		require.ErrorContains(t, err, "no sample limit defined while the MonitoringQuota quota requires one (remaining sample budget: 400 out of 1000)")
+
+		samples, _, err = q.admit(400, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(400), samples)
+	})
+
+	t.Run("clamp", func(t *testing.T) {
+		q := newNamespaceQuota([]*monitoringv1alpha1.MonitoringQuota{
+			makeMonitoringQuota("quota", ptr.To(uint64(1000)), ptr.To(uint64(10)), ptr.To(monitoringv1alpha1.ClampQuotaEnforcement)),
+		})
+
+		// Objects without limit don't get the remaining budget.
+		_, _, err := q.admit(600, 0)
+		require.ErrorContains(t, err, "no target limit defined while the MonitoringQuota quota requires one (remaining target budget: 10 out of 10)")
+
+		samples, targets, err := q.admit(600, 10)
+		require.NoError(t, err)
+		require.Equal(t, uint64(600), samples)
+		require.Equal(t, uint64(10), targets)
+
+		_, _, err = q.admit(600, 1)
+		require.ErrorContains(t, err, "target limit 1 exceeds the remaining target budget (0 out of 10)")
+
+		q.usedTargets = 0
+		samples, targets, err = q.admit(600, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(400), samples)
+		require.Equal(t, uint64(1), targets)
+
+		_, _, err = q.admit(1, 1)
+		require.ErrorContains(t, err, "sample limit 1 exceeds the remaining sample budget (0 out of 1000)")
+	})
+}
+
+func TestSelectServiceMonitorsWithQuota(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		enforcement *monitoringv1alpha1.QuotaEnforcement
+		expected    map[string]*uint64
+		rejected    []string
+	}{
+		{
+			name: "reject",
+			expected: map[string]*uint64{
+				"test/a": ptr.To(uint64(600)),
+				"test/c": ptr.To(uint64(100)),
+			},
+			rejected: []string{"test/b"},
+		},
+		{
+			name:        "clamp",
+			enforcement: ptr.To(monitoringv1alpha1.ClampQuotaEnforcement),
+			expected: map[string]*uint64{
+				"test/a": ptr.To(uint64(600)),
+				"test/b": ptr.To(uint64(400)),
+			},
+			rejected: []string{"test/c"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						// ServiceMonitors without sampleLimit inherit the
+						// default value.
+						SampleLimit: ptr.To(uint64(100)),
+					},
+				},
+			}
+
+			quota := makeMonitoringQuota("quota", ptr.To(uint64(1000)), nil, tc.enforcement)
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(10, p),
+				WithMonitoringQuotas(func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+					appendFn(quota)
+					return nil
+				}),
+			)
+			require.NoError(t, err)
+
+			sms := []*monitoringv1.ServiceMonitor{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "test"},
+					Spec:       monitoringv1.ServiceMonitorSpec{SampleLimit: ptr.To(uint64(600))},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "test"},
+					Spec:       monitoringv1.ServiceMonitorSpec{SampleLimit: ptr.To(uint64(500))},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "test"},
+				},
+			}
+
+			res, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				for _, sm := range sms {
+					appendFn(sm)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.Len(t, res, 3)
+
+			valid := res.ValidResources()
+			require.Len(t, valid, len(tc.expected))
+			for k, limit := range tc.expected {
+				require.Contains(t, valid, k)
+				require.Equal(t, limit, effectiveLimitPtr(valid[k].Spec.SampleLimit, p.Spec.SampleLimit))
+			}
+
+			for _, k := range tc.rejected {
+				r := res[k]
+				conditions := r.Conditions()
+				require.Len(t, conditions, 1)
+				require.Equal(t, monitoringv1.ConditionFalse, conditions[0].Status)
+				require.Equal(t, QuotaExceeded, conditions[0].Reason)
+			}
+
+			if tc.enforcement != nil {
+				r := res["test/b"]
+				conditions := r.Conditions()
+				require.Equal(t, monitoringv1.ConditionTrue, conditions[0].Status)
+				require.Equal(t, QuotaClamped, conditions[0].Reason)
+				require.Contains(t, conditions[0].Message, "sample limit clamped to 400")
+
+				// The listed objects aren't modified.
+				require.Equal(t, ptr.To(uint64(500)), sms[1].Spec.SampleLimit)
+			}
+		})
+	}
+}
+
+func effectiveLimitPtr(limit, defaultLimit *uint64) *uint64 {
+	return ptr.To(effectiveLimit(limit, defaultLimit, nil))
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// redactedSecret is the value replacing the secrets, it matches the value used
+// by Prometheus.
+const redactedSecret = "<secret>"
+
+// secretConfigKeys lists the keys of the Prometheus configuration holding
+// secret values.
+var secretConfigKeys = map[string]struct{}{
+	"access_key":                    {},
+	"application_credential_secret": {},
+	"application_secret":            {},
+	"auth_token":                    {},
+	"bearer_token":                  {},
+	"client_secret":                 {},
+	"consumer_key":                  {},
+	"credentials":                   {},
+	"key":                           {},
+	"password":                      {},
+	"secret":                        {},
+	"secret_key":                    {},
+	"token":                         {},
+}
+
+// secretConfigMapKeys lists the keys of the Prometheus configuration holding
+// maps of HTTP headers. All the header values are redacted because they
+// frequently carry credentials.
+var secretConfigMapKeys = map[string]struct{}{
+	"headers":              {},
+	"http_headers":         {},
+	"proxy_connect_header": {},
+}
+
+// RedactConfiguration returns the given Prometheus configuration with the
+// secret values replaced by "<secret>".
+func RedactConfiguration(b []byte) ([]byte, error) {
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the configuration: %w", err)
+	}
+
+	return yaml.Marshal(redactValue(cfg))
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for i, item := range v {
+			k, _ := item.Key.(string)
+
+			if _, found := secretConfigMapKeys[k]; found {
+				v[i].Value = redactMapValues(item.Value)
+				continue
+			}
+
+			if _, found := secretConfigKeys[k]; found {
+				if _, ok := item.Value.(string); ok {
+					v[i].Value = redactedSecret
+					continue
+				}
+			}
+
+			v[i].Value = redactValue(item.Value)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+
+	return v
+}
+
+// redactMapValues replaces all the scalar values of the map by "<secret>",
+// leaving the keys (e.g. the header names) untouched.
+func redactMapValues(v any) any {
+	m, ok := v.(yaml.MapSlice)
+	if !ok {
+		return v
+	}
+
+	for i, item := range m {
+		m[i].Value = redactAll(item.Value)
+	}
+
+	return m
+}
+
+func redactAll(v any) any {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		return redactMapValues(v)
+	case []any:
+		for i := range v {
+			v[i] = redactAll(v[i])
+		}
+		return v
+	case nil:
+		return nil
+	default:
+		return redactedSecret
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactConfiguration(t *testing.T) {
+	cfg := `global:
+  scrape_interval: 30s
+scrape_configs:
+- job_name: foo
+  basic_auth:
+    username: user
+    password: pass
+  authorization:
+    type: Bearer
+    credentials: creds
+  oauth2:
+    client_id: client
+    client_secret: s3cr3t
+  tls_config:
+    ca: ca-cert
+    cert: cert
+    key: private-key
+    key_file: /etc/prometheus/key
+  proxy_connect_header:
+    Proxy-Authorization:
+    - Basic abc
+  relabel_configs:
+  - source_labels:
+    - __meta_kubernetes_pod_label_password
+    target_label: password
+  http_headers:
+    X-Api-Key:
+      values:
+      - api-key
+      secrets:
+      - secret-key
+      files:
+      - /etc/prometheus/header
+remote_write:
+- url: http://example.com
+  headers:
+    Authorization: Bearer token
+  sigv4:
+    access_key: access
+    secret_key: secret
+`
+
+	expected := `global:
+  scrape_interval: 30s
+scrape_configs:
+- job_name: foo
+  basic_auth:
+    username: user
+    password: <secret>
+  authorization:
+    type: Bearer
+    credentials: <secret>
+  oauth2:
+    client_id: client
+    client_secret: <secret>
+  tls_config:
+    ca: ca-cert
+    cert: cert
+    key: <secret>
+    key_file: /etc/prometheus/key
+  proxy_connect_header:
+    Proxy-Authorization:
+    - <secret>
+  relabel_configs:
+  - source_labels:
+    - __meta_kubernetes_pod_label_password
+    target_label: password
+  http_headers:
+    X-Api-Key:
+      values:
+      - <secret>
+      secrets:
+      - <secret>
+      files:
+      - <secret>
+remote_write:
+- url: http://example.com
+  headers:
+    Authorization: <secret>
+  sigv4:
+    access_key: <secret>
+    secret_key: <secret>
+`
+
+	b, err := RedactConfiguration([]byte(cfg))
+	require.NoError(t, err)
+	require.Equal(t, expected, string(b))
+
+	_, err = RedactConfiguration([]byte("invalid: [yaml"))
+	require.Error(t, err)
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/relabel"
+	"gopkg.in/yaml.v2"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// RelabelingResult is the outcome of the relabeling of a discovered target
+// by a scrape job.
+type RelabelingResult struct {
+	// Job is the name of the scrape job.
+	Job string `json:"job"`
+	// DiscoveredLabels are the labels of the target before relabeling,
+	// including the default labels added by Prometheus (e.g. `job` and
+	// `__scheme__`).
+	DiscoveredLabels map[string]string `json:"discoveredLabels"`
+	// Dropped is true if the target is dropped by the relabeling rules of
+	// all the shards.
+	Dropped bool `json:"dropped"`
+	// Shard is the index of the shard scraping the target. It is nil when
+	// the target is dropped.
+	Shard *int32 `json:"shard,omitempty"`
+	// Labels are the labels of the target after relabeling. It is empty
+	// when the target is dropped.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// simulatedScrapeConfig holds the fields of a scrape configuration which are
+// relevant for the relabeling.
+type simulatedScrapeConfig struct {
+	JobName             string              `yaml:"job_name"`
+	ScrapeInterval      string              `yaml:"scrape_interval,omitempty"`
+	ScrapeTimeout       string              `yaml:"scrape_timeout,omitempty"`
+	MetricsPath         string              `yaml:"metrics_path,omitempty"`
+	Scheme              string              `yaml:"scheme,omitempty"`
+	Params              map[string][]string `yaml:"params,omitempty"`
+	KubernetesSDConfigs []struct {
+		Role string `yaml:"role"`
+	} `yaml:"kubernetes_sd_configs,omitempty"`
+	RelabelConfigs []*relabel.Config `yaml:"relabel_configs,omitempty"`
+}
+
+type simulatedConfig struct {
+	Global struct {
+		ScrapeInterval string `yaml:"scrape_interval,omitempty"`
+		ScrapeTimeout  string `yaml:"scrape_timeout,omitempty"`
+	} `yaml:"global"`
+	ScrapeConfigs []simulatedScrapeConfig `yaml:"scrape_configs"`
+}
+
+// RelabelingSimulator applies the relabeling rules of the scrape jobs
+// generated by the operator to discovered targets. It runs the same
+// relabeling engine as Prometheus and accounts for all the relabelings
+// written by the operator: the ones derived from the monitor's spec, the
+// scrape class relabelings, the sharding relabelings and the enforced
+// namespace label.
+type RelabelingSimulator struct {
+	// One configuration per shard since the sharding relabelings depend on
+	// the shard index.
+	shards []simulatedConfig
+}
+
+// NewRelabelingSimulator returns a RelabelingSimulator for the given
+// Prometheus configuration, typically generated by the operator for the
+// Prometheus object.
+func NewRelabelingSimulator(p monitoringv1.PrometheusInterface, config []byte) (*RelabelingSimulator, error) {
+	n := shardsNumber(p)
+	rs := &RelabelingSimulator{
+		shards: make([]simulatedConfig, n),
+	}
+
+	for shard := range n {
+		// The config-reloader sidecar expands the environment variable
+		// with the shard index.
+		b := strings.ReplaceAll(string(config), "$("+operator.ShardEnvVar+")", strconv.Itoa(int(shard)))
+
+		if err := yaml.Unmarshal([]byte(b), &rs.shards[shard]); err != nil {
+			return nil, fmt.Errorf("failed to parse the configuration: %w", err)
+		}
+
+		for _, sc := range rs.shards[shard].ScrapeConfigs {
+			for i, rc := range sc.RelabelConfigs {
+				if err := rc.Validate(model.UTF8Validation); err != nil {
+					return nil, fmt.Errorf("job %q: invalid relabel config at index %d: %w", sc.JobName, i, err)
+				}
+			}
+		}
+	}
+
+	return rs, nil
+}
+
+// Jobs returns the names of the scrape jobs generated for the given
+// resource. The kind argument is one of "ServiceMonitor", "PodMonitor",
+// "Probe" and "ScrapeConfig".
+func (rs *RelabelingSimulator) Jobs(kind, namespace, name string) ([]string, error) {
+	var prefix string
+	switch kind {
+	case monitoringv1.ServiceMonitorsKind:
+		prefix = "serviceMonitor"
+	case monitoringv1.PodMonitorsKind:
+		prefix = "podMonitor"
+	case monitoringv1.ProbesKind:
+		prefix = "probe"
+	case monitoringv1alpha1.ScrapeConfigsKind:
+		prefix = "scrapeConfig"
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+	prefix = strings.Join([]string{prefix, namespace, name}, "/")
+
+	var jobs []string
+	for _, sc := range rs.shards[0].ScrapeConfigs {
+		if sc.JobName == prefix || strings.HasPrefix(sc.JobName, prefix+"/") {
+			jobs = append(jobs, sc.JobName)
+		}
+	}
+
+	return jobs, nil
+}
+
+// Role returns the Kubernetes service discovery role of the scrape job or an
+// empty string if the job doesn't use the Kubernetes service discovery.
+func (rs *RelabelingSimulator) Role(job string) string {
+	sc, found := rs.scrapeConfig(0, job)
+	if !found || len(sc.KubernetesSDConfigs) == 0 {
+		return ""
+	}
+
+	return sc.KubernetesSDConfigs[0].Role
+}
+
+func (rs *RelabelingSimulator) scrapeConfig(shard int, job string) (simulatedScrapeConfig, bool) {
+	for _, sc := range rs.shards[shard].ScrapeConfigs {
+		if sc.JobName == job {
+			return sc, true
+		}
+	}
+
+	return simulatedScrapeConfig{}, false
+}
+
+// Simulate returns the labels of the target after the relabeling by the
+// scrape job.
+func (rs *RelabelingSimulator) Simulate(job string, lset labels.Labels) (RelabelingResult, error) {
+	var res RelabelingResult
+
+	for shard := range rs.shards {
+		sc, found := rs.scrapeConfig(shard, job)
+		if !found {
+			return res, fmt.Errorf("job %q not found", job)
+		}
+
+		lb := labels.NewBuilder(lset)
+		rs.addDefaultLabels(shard, sc, lb)
+
+		if shard == 0 {
+			res = RelabelingResult{
+				Job:              job,
+				DiscoveredLabels: lb.Labels().Map(),
+				Dropped:          true,
+			}
+		}
+
+		if !relabel.ProcessBuilder(lb, sc.RelabelConfigs...) {
+			continue
+		}
+
+		// Prometheus discards the targets without address.
+		if lb.Get(model.AddressLabel) == "" {
+			continue
+		}
+
+		if lb.Get(model.InstanceLabel) == "" {
+			lb.Set(model.InstanceLabel, lb.Get(model.AddressLabel))
+		}
+
+		final := map[string]string{}
+		lb.Labels().Range(func(l labels.Label) {
+			if strings.HasPrefix(l.Name, model.ReservedLabelPrefix) {
+				return
+			}
+			final[l.Name] = l.Value
+		})
+
+		res.Dropped = false
+		res.Shard = ptr.To(int32(shard))
+		res.Labels = final
+		break
+	}
+
+	return res, nil
+}
+
+// addDefaultLabels sets the labels which Prometheus adds to all the targets
+// before relabeling unless they're already defined.
+func (rs *RelabelingSimulator) addDefaultLabels(shard int, sc simulatedScrapeConfig, lb *labels.Builder) {
+	setDefault := func(name, value string) {
+		if lb.Get(name) == "" && value != "" {
+			lb.Set(name, value)
+		}
+	}
+
+	global := rs.shards[shard].Global
+
+	setDefault(model.JobLabel, sc.JobName)
+	setDefault(model.SchemeLabel, cmp.Or(sc.Scheme, "http"))
+	setDefault(model.MetricsPathLabel, cmp.Or(sc.MetricsPath, "/metrics"))
+	setDefault(model.ScrapeIntervalLabel, cmp.Or(sc.ScrapeInterval, global.ScrapeInterval, "1m"))
+	setDefault(model.ScrapeTimeoutLabel, cmp.Or(sc.ScrapeTimeout, global.ScrapeTimeout, "10s"))
+
+	for k, v := range sc.Params {
+		if len(v) > 0 {
+			setDefault(model.ParamLabelPrefix+k, v[0])
+		}
+	}
+}
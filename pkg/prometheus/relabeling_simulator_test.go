@@ -0,0 +1,266 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func TestRelabelingSimulator(t *testing.T) {
+	p := defaultPrometheus()
+	p.Spec.Shards = ptr.To(int32(2))
+	p.Spec.EnforcedNamespaceLabel = "namespace"
+	p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{
+		{
+			Name:    "default",
+			Default: ptr.To(true),
+			Relabelings: []monitoringv1.RelabelConfig{
+				{
+					TargetLabel: "cluster",
+					Replacement: ptr.To("prod"),
+				},
+			},
+		},
+	}
+
+	sm := &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port: "metrics",
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_tier"},
+							Regex:        "frontend",
+							Action:       "drop",
+						},
+						{
+							TargetLabel: "namespace",
+							Replacement: ptr.To("overridden"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.GenerateServerConfiguration(
+		p,
+		map[string]*monitoringv1.ServiceMonitor{"app": sm},
+		nil,
+		nil,
+		nil,
+		assets.NewTestStoreBuilder(),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	sim, err := NewRelabelingSimulator(p, cfg)
+	require.NoError(t, err)
+
+	jobs, err := sim.Jobs(monitoringv1.ServiceMonitorsKind, "default", "app")
+	require.NoError(t, err)
+	require.Equal(t, []string{"serviceMonitor/default/app/0"}, jobs)
+	require.Equal(t, kubernetesSDRoleEndpoint, sim.Role(jobs[0]))
+
+	// Only exact matches are returned.
+	other, err := sim.Jobs(monitoringv1.ServiceMonitorsKind, "default", "ap")
+	require.NoError(t, err)
+	require.Empty(t, other)
+
+	_, err = sim.Jobs(monitoring.PrometheusesKind, "default", "app")
+	require.Error(t, err)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-0",
+			Namespace: "default",
+			Labels:    map[string]string{"tier": "backend"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "app",
+					Ports: []v1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+				},
+			},
+		},
+		Status: v1.PodStatus{PodIP: "10.0.0.1"},
+	}
+
+	eps := makeEndpointSlice("default", "app", []string{"metrics", "web"}, "10.0.0.1")
+	eps.Ports[0].Port = ptr.To(int32(9090))
+	eps.Ports[1].Port = ptr.To(int32(8080))
+	eps.Endpoints[0].TargetRef = &v1.ObjectReference{Kind: "Pod", Name: "app-0"}
+
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "foo"},
+		},
+	}
+
+	targets := EndpointSliceDiscoveredTargets(kubernetesSDRoleEndpoint, eps, svc, map[string]*v1.Pod{pod.Name: pod})
+	require.Len(t, targets, 2)
+
+	// The target for the "metrics" port is kept.
+	res, err := sim.Simulate(jobs[0], targets[0])
+	require.NoError(t, err)
+	require.False(t, res.Dropped)
+	require.NotNil(t, res.Shard)
+	require.Equal(t, "serviceMonitor/default/app/0", res.DiscoveredLabels["job"])
+	require.Equal(t, "10.0.0.1:9090", res.DiscoveredLabels["__address__"])
+	require.Equal(t, "app-0", res.DiscoveredLabels["__meta_kubernetes_pod_name"])
+	require.Equal(t, "metrics", res.DiscoveredLabels["__meta_kubernetes_pod_container_port_name"])
+	require.Equal(t, map[string]string{
+		"cluster":   "prod",
+		"container": "app",
+		"endpoint":  "metrics",
+		"instance":  "10.0.0.1:9090",
+		"job":       "app",
+		// The enforced namespace label takes precedence over the
+		// relabelings of the ServiceMonitor.
+		"namespace": "default",
+		"pod":       "app-0",
+		"service":   "app",
+	}, res.Labels)
+
+	// The target for the "web" port is dropped.
+	res, err = sim.Simulate(jobs[0], targets[1])
+	require.NoError(t, err)
+	require.True(t, res.Dropped)
+	require.Nil(t, res.Shard)
+	require.Empty(t, res.Labels)
+
+	// The target is dropped by the relabelings of the ServiceMonitor.
+	pod.Labels["tier"] = "frontend"
+	targets = EndpointSliceDiscoveredTargets(kubernetesSDRoleEndpoint, eps, svc, map[string]*v1.Pod{pod.Name: pod})
+	res, err = sim.Simulate(jobs[0], targets[0])
+	require.NoError(t, err)
+	require.True(t, res.Dropped)
+
+	// Exactly one shard scrapes a given target.
+	shards := map[int32]struct{}{}
+	for _, addr := range []string{"10.0.0.1:9090", "10.0.0.2:9090", "10.0.0.3:9090", "10.0.0.4:9090", "10.0.0.5:9090"} {
+		res, err = sim.Simulate(jobs[0], labels.FromStrings(
+			"__address__", addr,
+			"__meta_kubernetes_endpoint_port_name", "metrics",
+			"__meta_kubernetes_service_label_app", "foo",
+			"__meta_kubernetes_service_labelpresent_app", "true",
+		))
+		require.NoError(t, err)
+		require.False(t, res.Dropped)
+		shards[*res.Shard] = struct{}{}
+	}
+	require.Len(t, shards, 2)
+
+	_, err = sim.Simulate("invalid", labels.EmptyLabels())
+	require.Error(t, err)
+}
+
+func TestPodDiscoveredTargets(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-0",
+			Namespace: "default",
+			Labels:    map[string]string{"app.kubernetes.io/name": "foo"},
+		},
+		Spec: v1.PodSpec{
+			NodeName: "node-1",
+			Containers: []v1.Container{
+				{
+					Name:  "app",
+					Image: "app:latest",
+					Ports: []v1.ContainerPort{
+						{Name: "metrics", ContainerPort: 9090, Protocol: v1.ProtocolTCP},
+						{Name: "web", ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+					},
+				},
+			},
+			InitContainers: []v1.Container{
+				{
+					Name:  "init",
+					Image: "init:latest",
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			PodIP: "10.0.0.1",
+			Phase: v1.PodRunning,
+			Conditions: []v1.PodCondition{
+				{Type: v1.PodReady, Status: v1.ConditionTrue},
+			},
+		},
+	}
+
+	targets := PodDiscoveredTargets(pod)
+	require.Len(t, targets, 3)
+
+	require.Equal(t, "10.0.0.1:9090", targets[0].Get("__address__"))
+	require.Equal(t, "metrics", targets[0].Get("__meta_kubernetes_pod_container_port_name"))
+	require.Equal(t, "foo", targets[0].Get("__meta_kubernetes_pod_label_app_kubernetes_io_name"))
+	require.Equal(t, "true", targets[0].Get("__meta_kubernetes_pod_ready"))
+	require.Equal(t, "node-1", targets[0].Get("__meta_kubernetes_pod_node_name"))
+	require.Equal(t, "false", targets[0].Get("__meta_kubernetes_pod_container_init"))
+
+	require.Equal(t, "10.0.0.1:8080", targets[1].Get("__address__"))
+
+	// Containers without ports produce a target without port.
+	require.Equal(t, "10.0.0.1", targets[2].Get("__address__"))
+	require.Equal(t, "true", targets[2].Get("__meta_kubernetes_pod_container_init"))
+
+	pod.Status.PodIP = ""
+	require.Empty(t, PodDiscoveredTargets(pod))
+}
+
+func TestEndpointSliceDiscoveredTargets(t *testing.T) {
+	eps := makeEndpointSlice("default", "app", []string{"metrics"}, "10.0.0.1", "10.0.0.2")
+	eps.Ports[0].Port = ptr.To(int32(9090))
+	eps.Endpoints[0].Conditions.Ready = ptr.To(false)
+
+	targets := EndpointSliceDiscoveredTargets(kubernetesSDRoleEndpointSlice, eps, nil, nil)
+	require.Len(t, targets, 2)
+
+	require.Equal(t, "10.0.0.1:9090", targets[0].Get("__address__"))
+	require.Equal(t, "app-abcde", targets[0].Get("__meta_kubernetes_endpointslice_name"))
+	require.Equal(t, "metrics", targets[0].Get("__meta_kubernetes_endpointslice_port_name"))
+	require.Equal(t, string(discoveryv1.AddressTypeIPv4), targets[0].Get("__meta_kubernetes_endpointslice_address_type"))
+	require.Equal(t, "false", targets[0].Get("__meta_kubernetes_endpointslice_endpoint_conditions_ready"))
+	require.Equal(t, "default", targets[0].Get("__meta_kubernetes_namespace"))
+	require.Empty(t, targets[0].Get("__meta_kubernetes_service_name"))
+
+	require.Equal(t, "10.0.0.2:9090", targets[1].Get("__address__"))
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// The functions in this file build the labels of the targets discovered by
+// the Kubernetes service discovery of Prometheus. They follow the logic of
+// the discovery/kubernetes package from Prometheus.
+
+const (
+	metaLabelPrefix    = model.MetaLabelPrefix + "kubernetes_"
+	namespaceMetaLabel = metaLabelPrefix + "namespace"
+)
+
+func boolString(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// addObjectMetaLabels adds the labels and annotations of the object with the
+// given prefix (e.g. "__meta_kubernetes_pod_").
+func addObjectMetaLabels(lb *labels.Builder, prefix string, om metav1.ObjectMeta) {
+	for k, v := range om.Labels {
+		ln := sanitizeLabelName(k)
+		lb.Set(prefix+"label_"+ln, v)
+		lb.Set(prefix+"labelpresent_"+ln, "true")
+	}
+
+	for k, v := range om.Annotations {
+		ln := sanitizeLabelName(k)
+		lb.Set(prefix+"annotation_"+ln, v)
+		lb.Set(prefix+"annotationpresent_"+ln, "true")
+	}
+}
+
+func addPodLabels(lb *labels.Builder, pod *v1.Pod) {
+	const prefix = metaLabelPrefix + "pod_"
+
+	lb.Set(namespaceMetaLabel, pod.Namespace)
+	lb.Set(prefix+"name", pod.Name)
+	lb.Set(prefix+"ip", pod.Status.PodIP)
+	lb.Set(prefix+"ready", podReady(pod))
+	lb.Set(prefix+"phase", string(pod.Status.Phase))
+	lb.Set(prefix+"node_name", pod.Spec.NodeName)
+	lb.Set(prefix+"host_ip", pod.Status.HostIP)
+	lb.Set(prefix+"uid", string(pod.UID))
+
+	if ref := metav1.GetControllerOf(pod); ref != nil {
+		lb.Set(prefix+"controller_kind", ref.Kind)
+		lb.Set(prefix+"controller_name", ref.Name)
+	}
+
+	addObjectMetaLabels(lb, prefix, pod.ObjectMeta)
+}
+
+func podReady(pod *v1.Pod) string {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return strings.ToLower(string(c.Status))
+		}
+	}
+
+	return strings.ToLower(string(v1.ConditionUnknown))
+}
+
+func addContainerLabels(lb *labels.Builder, c v1.Container, isInit bool) {
+	const prefix = metaLabelPrefix + "pod_container_"
+
+	lb.Set(prefix+"name", c.Name)
+	lb.Set(prefix+"image", c.Image)
+	lb.Set(prefix+"init", boolString(isInit))
+}
+
+func addContainerPortLabels(lb *labels.Builder, port v1.ContainerPort) {
+	const prefix = metaLabelPrefix + "pod_container_port_"
+
+	lb.Set(prefix+"name", port.Name)
+	lb.Set(prefix+"number", strconv.Itoa(int(port.ContainerPort)))
+	lb.Set(prefix+"protocol", string(port.Protocol))
+}
+
+// PodDiscoveredTargets returns the targets discovered by the Kubernetes "pod"
+// role for the Pod object.
+func PodDiscoveredTargets(pod *v1.Pod) []labels.Labels {
+	if pod.Status.PodIP == "" {
+		return nil
+	}
+
+	var targets []labels.Labels
+	addContainers := func(containers []v1.Container, isInit bool) {
+		for _, c := range containers {
+			// A target is created for containers without ports.
+			if len(c.Ports) == 0 {
+				lb := labels.NewBuilder(labels.EmptyLabels())
+				addPodLabels(lb, pod)
+				addContainerLabels(lb, c, isInit)
+				lb.Set(model.AddressLabel, pod.Status.PodIP)
+				targets = append(targets, lb.Labels())
+				continue
+			}
+
+			for _, port := range c.Ports {
+				lb := labels.NewBuilder(labels.EmptyLabels())
+				addPodLabels(lb, pod)
+				addContainerLabels(lb, c, isInit)
+				addContainerPortLabels(lb, port)
+				lb.Set(model.AddressLabel, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port.ContainerPort))))
+				targets = append(targets, lb.Labels())
+			}
+		}
+	}
+
+	addContainers(pod.Spec.Containers, false)
+	addContainers(pod.Spec.InitContainers, true)
+
+	return targets
+}
+
+// EndpointSliceDiscoveredTargets returns the targets discovered by the
+// Kubernetes "endpointslice" or "endpoints" role for the EndpointSlice
+// object.
+// The Service and the Pods referenced by the endpoints are optional: when
+// present, their metadata labels are added to the targets. The keys of the
+// pods map are the Pod names.
+func EndpointSliceDiscoveredTargets(role string, eps *discoveryv1.EndpointSlice, svc *v1.Service, pods map[string]*v1.Pod) []labels.Labels {
+	var targets []labels.Labels
+
+	for _, e := range eps.Endpoints {
+		var pod *v1.Pod
+		if e.TargetRef != nil && e.TargetRef.Kind == "Pod" {
+			pod = pods[e.TargetRef.Name]
+		}
+
+		for _, port := range eps.Ports {
+			for _, addr := range e.Addresses {
+				lb := labels.NewBuilder(labels.EmptyLabels())
+				lb.Set(namespaceMetaLabel, eps.Namespace)
+
+				if role == kubernetesSDRoleEndpoint {
+					addEndpointsLabels(lb, eps, e, port)
+				} else {
+					addEndpointSliceLabels(lb, eps, e, port)
+				}
+
+				if svc != nil {
+					lb.Set(metaLabelPrefix+"service_name", svc.Name)
+					addObjectMetaLabels(lb, metaLabelPrefix+"service_", svc.ObjectMeta)
+				}
+
+				if pod != nil {
+					addPodLabels(lb, pod)
+					addMatchingContainerLabels(lb, pod, port)
+				}
+
+				lb.Set(model.AddressLabel, net.JoinHostPort(addr, strconv.Itoa(int(ptr.Deref(port.Port, 0)))))
+				targets = append(targets, lb.Labels())
+			}
+		}
+	}
+
+	return targets
+}
+
+func addEndpointSliceLabels(lb *labels.Builder, eps *discoveryv1.EndpointSlice, e discoveryv1.Endpoint, port discoveryv1.EndpointPort) {
+	const prefix = metaLabelPrefix + "endpointslice_"
+
+	lb.Set(prefix+"name", eps.Name)
+	lb.Set(prefix+"address_type", string(eps.AddressType))
+	addObjectMetaLabels(lb, prefix, eps.ObjectMeta)
+
+	lb.Set(prefix+"port_name", ptr.Deref(port.Name, ""))
+	lb.Set(prefix+"port_protocol", string(ptr.Deref(port.Protocol, "")))
+	lb.Set(prefix+"port", strconv.Itoa(int(ptr.Deref(port.Port, 0))))
+	lb.Set(prefix+"port_app_protocol", ptr.Deref(port.AppProtocol, ""))
+
+	lb.Set(prefix+"endpoint_conditions_ready", boolStringPtr(e.Conditions.Ready))
+	lb.Set(prefix+"endpoint_conditions_serving", boolStringPtr(e.Conditions.Serving))
+	lb.Set(prefix+"endpoint_conditions_terminating", boolStringPtr(e.Conditions.Terminating))
+	lb.Set(prefix+"endpoint_hostname", ptr.Deref(e.Hostname, ""))
+	lb.Set(prefix+"endpoint_node_name", ptr.Deref(e.NodeName, ""))
+	lb.Set(prefix+"endpoint_zone", ptr.Deref(e.Zone, ""))
+
+	if e.TargetRef != nil {
+		lb.Set(prefix+"address_target_kind", e.TargetRef.Kind)
+		lb.Set(prefix+"address_target_name", e.TargetRef.Name)
+	}
+}
+
+func addEndpointsLabels(lb *labels.Builder, eps *discoveryv1.EndpointSlice, e discoveryv1.Endpoint, port discoveryv1.EndpointPort) {
+	// The Endpoints object has the same name as the Service.
+	lb.Set(metaLabelPrefix+"endpoints_name", eps.Labels[discoveryv1.LabelServiceName])
+
+	const prefix = metaLabelPrefix + "endpoint_"
+
+	lb.Set(prefix+"port_name", ptr.Deref(port.Name, ""))
+	lb.Set(prefix+"port_protocol", string(ptr.Deref(port.Protocol, "")))
+	lb.Set(prefix+"ready", boolString(ptr.Deref(e.Conditions.Ready, true)))
+	lb.Set(prefix+"hostname", ptr.Deref(e.Hostname, ""))
+	lb.Set(prefix+"node_name", ptr.Deref(e.NodeName, ""))
+
+	if e.TargetRef != nil {
+		lb.Set(prefix+"address_target_kind", e.TargetRef.Kind)
+		lb.Set(prefix+"address_target_name", e.TargetRef.Name)
+	}
+}
+
+func boolStringPtr(b *bool) string {
+	if b == nil {
+		return ""
+	}
+
+	return boolString(*b)
+}
+
+// addMatchingContainerLabels adds the labels of the container exposing the
+// endpoint's port.
+func addMatchingContainerLabels(lb *labels.Builder, pod *v1.Pod, port discoveryv1.EndpointPort) {
+	for i, c := range slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers) {
+		for _, cp := range c.Ports {
+			if cp.ContainerPort != ptr.Deref(port.Port, 0) {
+				continue
+			}
+
+			addContainerLabels(lb, c, i >= len(pod.Spec.Containers))
+			addContainerPortLabels(lb, cp)
+			return
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/url"
 	"slices"
 	"strings"
@@ -63,10 +64,47 @@ type ResourceSelector struct {
 	accessor           *operator.Accessor
 
 	eventRecorder *operator.EventRecorder
+
+	// quotaListFn lists the MonitoringQuota objects (nil if quotas aren't
+	// enforced).
+	quotaListFn ListAllByNamespaceFn
+	// quotas holds the scrape budget of the namespaces with a
+	// MonitoringQuota. The budget is shared by all the scrape resources
+	// selected by the same ResourceSelector.
+	quotas map[string]*namespaceQuota
+
+	// scrapeClasses holds the scrape classes available to the scrape
+	// resources.
+	scrapeClasses *scrapeClassRegistry
 }
 
 type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
 
+// ResourceSelectorOption configures a ResourceSelector.
+type ResourceSelectorOption func(*ResourceSelector)
+
+// WithScrapeClassDefinitions makes the scrape classes defined by the
+// ScrapeClassDefinition objects available to the scrape resources. The
+// objects conflicting with other scrape classes are ignored.
+//
+// It is meant to be used when the ScrapeClassDefinition objects aren't
+// selected by the SelectScrapeClassDefinitions() method.
+func WithScrapeClassDefinitions(scds []*monitoringv1alpha1.ScrapeClassDefinition) ResourceSelectorOption {
+	return func(rs *ResourceSelector) {
+		for _, scd := range scds {
+			_ = rs.scrapeClasses.add(scd)
+		}
+	}
+}
+
+// WithMonitoringQuotas enables the enforcement of the MonitoringQuota objects
+// returned by the given function.
+func WithMonitoringQuotas(listFn ListAllByNamespaceFn) ResourceSelectorOption {
+	return func(rs *ResourceSelector) {
+		rs.quotaListFn = listFn
+	}
+}
+
 func NewResourceSelector(
 	l *slog.Logger,
 	p monitoringv1.PrometheusInterface,
@@ -74,6 +112,7 @@ func NewResourceSelector(
 	namespaceInformers cache.SharedIndexInformer,
 	metrics *operator.Metrics,
 	eventRecorder *operator.EventRecorder,
+	opts ...ResourceSelectorOption,
 ) (*ResourceSelector, error) {
 	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
 	version, err := semver.ParseTolerant(promVersion)
@@ -81,7 +120,7 @@ func NewResourceSelector(
 		return nil, fmt.Errorf("failed to parse Prometheus version: %w", err)
 	}
 
-	return &ResourceSelector{
+	rs := &ResourceSelector{
 		l:                  l,
 		p:                  p,
 		version:            version,
@@ -90,7 +129,15 @@ func NewResourceSelector(
 		metrics:            metrics,
 		eventRecorder:      eventRecorder,
 		accessor:           operator.NewAccessor(l),
-	}, nil
+		quotas:             map[string]*namespaceQuota{},
+		scrapeClasses:      newScrapeClassRegistry(p),
+	}
+
+	for _, opt := range opts {
+		opt(rs)
+	}
+
+	return rs, nil
 }
 
 func selectObjects[T operator.ConfigurationResource](
@@ -138,7 +185,6 @@ func selectObjects[T operator.ConfigurationResource](
 
 	var (
 		rejected int
-		valid    []string
 		res      = make(operator.TypedResourcesSelection[T], len(objects))
 	)
 
@@ -151,14 +197,21 @@ func selectObjects[T operator.ConfigurationResource](
 			reason = operator.InvalidConfiguration
 			logger.Warn("skipping object", "error", err.Error(), "object", namespaceAndName)
 			rs.eventRecorder.Eventf(obj, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingConfigurationResourcesAction, "%q was rejected due to invalid configuration: %v", namespaceAndName, err)
-		} else {
-			valid = append(valid, namespaceAndName)
 		}
 
 		res[namespaceAndName] = operator.NewTypedConfigurationResource(o, err, reason, obj.(metav1.Object).GetGeneration())
 	}
 
-	logger.Debug("valid objects selected", "objects", strings.Join(valid, ","))
+	if rs.quotaListFn != nil {
+		n, err := enforceQuotas(logger, rs, res)
+		if err != nil {
+			return nil, err
+		}
+		rejected += n
+	}
+
+	// The quotas may reject more objects.
+	logger.Debug("valid objects selected", "objects", strings.Join(slices.Sorted(maps.Keys(res.ValidResources())), ","))
 
 	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
 		rs.metrics.SetSelectedResources(pKey, kind, len(res))
@@ -188,6 +241,9 @@ func (rs *ResourceSelector) SelectServiceMonitors(ctx context.Context, listFn Li
 
 // checkServiceMonitor verifies that the ServiceMonitor object is valid.
 func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitoringv1.ServiceMonitor) error {
+	// The kind is needed to verify the cross-namespace references.
+	ctx = assets.WithReferrer(ctx, monitoringv1.ServiceMonitorsKind)
+
 	cpf := rs.p.GetCommonPrometheusFields()
 
 	if _, err := metav1.LabelSelectorAsSelector(&sm.Spec.Selector); err != nil {
@@ -198,6 +254,7 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 		return err
 	}
 
+	scrapeClass := rs.scrapeClasses.getOrDefault(sm.GetNamespace(), sm.Spec.ScrapeClassName)
 	for i, endpoint := range sm.Spec.Endpoints {
 		epErr := fmt.Errorf("endpoints[%d]", i)
 
@@ -232,7 +289,11 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 			return fmt.Errorf("%w: authorization: %w", epErr, err)
 		}
 
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(
+			rs.p,
+			mergeScrapeIntervalWithScrapeClass(endpoint.Interval, scrapeClass),
+			mergeScrapeTimeoutWithScrapeClass(endpoint.ScrapeTimeout, scrapeClass),
+		); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -249,13 +310,34 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 		}
 	}
 
-	if err := validateScrapeClass(rs.p, sm.Spec.ScrapeClassName); err != nil {
+	if err := rs.validateScrapeClass(sm.GetNamespace(), sm.Spec.ScrapeClassName); err != nil {
 		return fmt.Errorf("scrapeClassName: %w", err)
 	}
 
 	return nil
 }
 
+// CheckObject verifies that the configuration resource is valid for the
+// Prometheus object. It runs the same checks as the Select*() methods for
+// ServiceMonitor, PodMonitor, Probe and ScrapeConfig objects.
+//
+// Unlike the Select*() methods, it doesn't use the namespace informer, the
+// metrics nor the event recorder.
+func (rs *ResourceSelector) CheckObject(ctx context.Context, obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *monitoringv1.ServiceMonitor:
+		return rs.checkServiceMonitor(ctx, o)
+	case *monitoringv1.PodMonitor:
+		return rs.checkPodMonitor(ctx, o)
+	case *monitoringv1.Probe:
+		return rs.checkProbe(ctx, o)
+	case *monitoringv1alpha1.ScrapeConfig:
+		return rs.checkScrapeConfig(ctx, o)
+	}
+
+	return fmt.Errorf("unsupported object type %T", obj)
+}
+
 func (rs *ResourceSelector) ValidateRelabelConfigs(rcs []monitoringv1.RelabelConfig) error {
 	lcv := &LabelConfigValidator{v: rs.version}
 	return lcv.Validate(rcs)
@@ -404,20 +486,74 @@ func (lcv *LabelConfigValidator) validate(rc monitoringv1.RelabelConfig) error {
 	return nil
 }
 
-func validateScrapeClass(p monitoringv1.PrometheusInterface, sc *string) error {
+func (rs *ResourceSelector) validateScrapeClass(namespace string, sc *string) error {
 	if ptr.Deref(sc, "") == "" {
 		return nil
 	}
 
-	for _, c := range p.GetCommonPrometheusFields().ScrapeClasses {
-		if c.Name == *sc {
-			return nil
-		}
+	if _, found := rs.scrapeClasses.get(namespace, *sc); found {
+		return nil
 	}
 
 	return fmt.Errorf("scrapeClass %q not found in Prometheus scrapeClasses", *sc)
 }
 
+// SelectScrapeClassDefinitions returns the ScrapeClassDefinition objects that
+// match the selectors in the Prometheus custom resource.
+// The scrape classes of the valid objects become available to the scrape
+// resources selected afterwards: this method should be called before
+// selecting the scrape resources.
+// The objects are processed in lexicographic order and the objects
+// conflicting with a scrape class already defined are rejected.
+func (rs *ResourceSelector) SelectScrapeClassDefinitions(ctx context.Context, listFn ListAllByNamespaceFn) (operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeClassDefinition], error) {
+	var (
+		cpf    = rs.p.GetCommonPrometheusFields()
+		logger = rs.l.With("kind", monitoringv1alpha1.ScrapeClassDefinitionsKind)
+	)
+
+	res, err := selectObjects(
+		ctx,
+		logger,
+		rs,
+		monitoringv1alpha1.ScrapeClassDefinitionsKind,
+		cpf.ScrapeClassSelector,
+		cpf.ScrapeClassNamespaceSelector,
+		listFn,
+		rs.checkScrapeClassDefinition,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := res.ValidResources()
+	for _, k := range slices.Sorted(maps.Keys(valid)) {
+		scd := valid[k]
+		if err := rs.scrapeClasses.add(scd); err != nil {
+			logger.Warn("skipping object", "error", err.Error(), "object", k)
+			rs.eventRecorder.Eventf(scd, v1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingConfigurationResourcesAction, "%q was rejected due to a conflict: %v", k, err)
+			res[k] = operator.NewTypedConfigurationResource(scd, err, ScrapeClassConflict, scd.GetGeneration())
+		}
+	}
+
+	if pKey, ok := rs.accessor.MetaNamespaceKey(rs.p); ok {
+		rs.metrics.SetRejectedResources(pKey, monitoringv1alpha1.ScrapeClassDefinitionsKind, len(res)-len(res.ValidResources()))
+	}
+
+	return res, nil
+}
+
+// checkScrapeClassDefinition verifies that the ScrapeClassDefinition object is
+// valid.
+func (rs *ResourceSelector) checkScrapeClassDefinition(ctx context.Context, scd *monitoringv1alpha1.ScrapeClassDefinition) error {
+	scrapeClass := scd.ToScrapeClass()
+
+	if err := validateScrapeClassConfig(rs.p, scrapeClass); err != nil {
+		return err
+	}
+
+	return AddScrapeClassesToStore(ctx, rs.store, scd.GetNamespace(), []monitoringv1.ScrapeClass{scrapeClass})
+}
+
 func (rs *ResourceSelector) validateMonitorSelectorMechanism(selectorMechanism *monitoringv1.SelectorMechanism) error {
 	if ptr.Deref(selectorMechanism, monitoringv1.SelectorMechanismRelabel) == monitoringv1.SelectorMechanismRole && !rs.version.GTE(semver.MustParse("2.17.0")) {
 		return fmt.Errorf("RoleSelector selectorMechanism is only supported in Prometheus 2.17.0 and newer")
@@ -446,6 +582,8 @@ func (rs *ResourceSelector) SelectPodMonitors(ctx context.Context, listFn ListAl
 
 // checkPodMonitor verifies that the PodMonitor object is valid.
 func (rs *ResourceSelector) checkPodMonitor(ctx context.Context, pm *monitoringv1.PodMonitor) error {
+	ctx = assets.WithReferrer(ctx, monitoringv1.PodMonitorsKind)
+
 	if _, err := metav1.LabelSelectorAsSelector(&pm.Spec.Selector); err != nil {
 		return fmt.Errorf("failed to parse label selector: %w", err)
 	}
@@ -454,9 +592,14 @@ func (rs *ResourceSelector) checkPodMonitor(ctx context.Context, pm *monitoringv
 		return err
 	}
 
+	scrapeClass := rs.scrapeClasses.getOrDefault(pm.GetNamespace(), pm.Spec.ScrapeClassName)
 	for i, endpoint := range pm.Spec.PodMetricsEndpoints {
 		epErr := fmt.Errorf("endpoint[%d]", i)
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(
+			rs.p,
+			mergeScrapeIntervalWithScrapeClass(endpoint.Interval, scrapeClass),
+			mergeScrapeTimeoutWithScrapeClass(endpoint.ScrapeTimeout, scrapeClass),
+		); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -473,7 +616,7 @@ func (rs *ResourceSelector) checkPodMonitor(ctx context.Context, pm *monitoringv
 		}
 	}
 
-	if err := validateScrapeClass(rs.p, pm.Spec.ScrapeClassName); err != nil {
+	if err := rs.validateScrapeClass(pm.GetNamespace(), pm.Spec.ScrapeClassName); err != nil {
 		return fmt.Errorf("scrapeClassName: %w", err)
 	}
 
@@ -538,7 +681,9 @@ func (rs *ResourceSelector) SelectProbes(ctx context.Context, listFn ListAllByNa
 
 // checkProbe verifies that the Probe object is valid.
 func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.Probe) error {
-	if err := validateScrapeClass(rs.p, probe.Spec.ScrapeClassName); err != nil {
+	ctx = assets.WithReferrer(ctx, monitoringv1.ProbesKind)
+
+	if err := rs.validateScrapeClass(probe.GetNamespace(), probe.Spec.ScrapeClassName); err != nil {
 		return fmt.Errorf("scrapeClassName: %w", err)
 	}
 
@@ -568,7 +713,12 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		return fmt.Errorf("oauth2: %w", err)
 	}
 
-	if err := validateScrapeIntervalAndTimeout(rs.p, probe.Spec.Interval, probe.Spec.ScrapeTimeout); err != nil {
+	scrapeClass := rs.scrapeClasses.getOrDefault(probe.GetNamespace(), probe.Spec.ScrapeClassName)
+	if err := validateScrapeIntervalAndTimeout(
+		rs.p,
+		mergeScrapeIntervalWithScrapeClass(probe.Spec.Interval, scrapeClass),
+		mergeScrapeTimeoutWithScrapeClass(probe.Spec.ScrapeTimeout, scrapeClass),
+	); err != nil {
 		return err
 	}
 
@@ -648,7 +798,9 @@ func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn List
 
 // checkScrapeConfig verifies that the ScrapeConfig object is valid.
 func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
-	if err := validateScrapeClass(rs.p, sc.Spec.ScrapeClassName); err != nil {
+	ctx = assets.WithReferrer(ctx, monitoringv1alpha1.ScrapeConfigsKind)
+
+	if err := rs.validateScrapeClass(sc.GetNamespace(), sc.Spec.ScrapeClassName); err != nil {
 		return err
 	}
 
@@ -672,16 +824,12 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		return fmt.Errorf("tlsConfig: %w", err)
 	}
 
-	var scrapeInterval, scrapeTimeout monitoringv1.Duration = "", ""
-	if sc.Spec.ScrapeInterval != nil {
-		scrapeInterval = *sc.Spec.ScrapeInterval
-	}
-
-	if sc.Spec.ScrapeTimeout != nil {
-		scrapeTimeout = *sc.Spec.ScrapeTimeout
-	}
-
-	if err := validateScrapeIntervalAndTimeout(rs.p, scrapeInterval, scrapeTimeout); err != nil {
+	scrapeClass := rs.scrapeClasses.getOrDefault(sc.GetNamespace(), sc.Spec.ScrapeClassName)
+	if err := validateScrapeIntervalAndTimeout(
+		rs.p,
+		mergeScrapeIntervalWithScrapeClass(ptr.Deref(sc.Spec.ScrapeInterval, ""), scrapeClass),
+		mergeScrapeTimeoutWithScrapeClass(ptr.Deref(sc.Spec.ScrapeTimeout, ""), scrapeClass),
+	); err != nil {
 		return err
 	}
 
@@ -779,6 +927,22 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		return fmt.Errorf("IonosSDConfigs: %w", err)
 	}
 
+	if err := rs.validateMarathonSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("marathonSDConfigs: %w", err)
+	}
+
+	if err := rs.validateTritonSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("tritonSDConfigs: %w", err)
+	}
+
+	if err := rs.validateUyuniSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("uyuniSDConfigs: %w", err)
+	}
+
+	if err := rs.validateVultrSDConfigs(ctx, sc); err != nil {
+		return fmt.Errorf("vultrSDConfigs: %w", err)
+	}
+
 	return nil
 }
 
@@ -1421,3 +1585,117 @@ func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *moni
 	}
 	return nil
 }
+
+func (rs *ResourceSelector) validateMarathonSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.MarathonSDConfigs {
+		if config.AuthToken != nil {
+			if config.BasicAuth != nil || config.Authorization != nil {
+				return fmt.Errorf("[%d]: at most one of authToken, basicAuth and authorization must be configured", i)
+			}
+
+			if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), *config.AuthToken); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+
+		if err := rs.store.AddBasicAuth(ctx, sc.GetNamespace(), config.BasicAuth); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateTritonSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	for i, config := range sc.Spec.TritonSDConfigs {
+		if len(config.Groups) > 0 && config.Role != nil && *config.Role == monitoringv1alpha1.TritonRoleCN {
+			return fmt.Errorf("[%d]: groups can only be used with the %q role", i, monitoringv1alpha1.TritonRoleContainer)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateUyuniSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	if len(sc.Spec.UyuniSDConfigs) == 0 {
+		return nil
+	}
+
+	if rs.version.LT(semver.MustParse("2.31.0")) {
+		return fmt.Errorf("uyuni SD configuration is only supported for Prometheus version >= 2.31.0")
+	}
+
+	for i, config := range sc.Spec.UyuniSDConfigs {
+		if _, err := rs.store.GetSecretKey(ctx, sc.GetNamespace(), config.Password); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (rs *ResourceSelector) validateVultrSDConfigs(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) error {
+	if len(sc.Spec.VultrSDConfigs) == 0 {
+		return nil
+	}
+
+	if rs.version.LT(semver.MustParse("2.36.0")) {
+		return fmt.Errorf("vultr SD configuration is only supported for Prometheus version >= 2.36.0")
+	}
+
+	for i, config := range sc.Spec.VultrSDConfigs {
+		if err := rs.store.AddSafeAuthorizationCredentials(ctx, sc.GetNamespace(), config.Authorization); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddOAuth2(ctx, sc.GetNamespace(), config.OAuth2); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := rs.store.AddSafeTLSConfig(ctx, sc.GetNamespace(), config.TLSConfig); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, config.ProxyConfig, rs.store, sc.GetNamespace()); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
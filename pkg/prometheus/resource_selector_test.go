@@ -952,6 +952,81 @@ func TestValidateScrapeIntervalAndTimeout(t *testing.T) {
 	}
 }
 
+func TestSelectServiceMonitorsWithScrapeClassScrapeTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		scenario string
+		endpoint monitoringv1.Endpoint
+		valid    bool
+	}{
+		{
+			scenario: "scrape interval and timeout inherited from the scrape class",
+			valid:    true,
+		},
+		{
+			scenario: "scrape timeout greater than the scrape class interval",
+			endpoint: monitoringv1.Endpoint{ScrapeTimeout: "15s"},
+		},
+		{
+			scenario: "scrape interval lower than the scrape class timeout",
+			endpoint: monitoringv1.Endpoint{Interval: "2s"},
+		},
+		{
+			scenario: "scrape interval and timeout overriding the scrape class",
+			endpoint: monitoringv1.Endpoint{Interval: "30s", ScrapeTimeout: "15s"},
+			valid:    true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			p := &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:          "default",
+								Default:       ptr.To(true),
+								Interval:      ptr.To(monitoringv1.Duration("10s")),
+								ScrapeTimeout: ptr.To(monitoringv1.Duration("5s")),
+							},
+						},
+					},
+				},
+			}
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(1, p),
+			)
+			require.NoError(t, err)
+
+			sm := &monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.ServiceMonitorSpec{
+					Endpoints: []monitoringv1.Endpoint{tc.endpoint},
+				},
+			}
+
+			sms, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(sm)
+				return nil
+			})
+			require.NoError(t, err)
+
+			if tc.valid {
+				require.Len(t, sms.ValidResources(), 1)
+			} else {
+				require.Empty(t, sms.ValidResources())
+			}
+		})
+	}
+}
+
 func TestSelectServiceMonitors(t *testing.T) {
 	ca, err := os.ReadFile(certsDir + "ca.crt")
 	require.NoError(t, err)
@@ -4793,6 +4868,225 @@ func TestSelectScrapeConfigs(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			scenario: "Marathon SD config with valid auth token",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Marathon SD config with invalid auth token secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "wrong",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Marathon SD config with both auth token and basic auth",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						AuthToken: &v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+						BasicAuth: &monitoringv1.BasicAuth{
+							Username: v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+							Password: v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key2",
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Marathon SD config with invalid TLS config",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.MarathonSDConfigs = []monitoringv1alpha1.MarathonSDConfig{
+					{
+						Servers: []string{"https://marathon.example.com:8080"},
+						TLSConfig: &monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								Secret: &v1.SecretKeySelector{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "secret",
+									},
+									Key: "invalid_ca",
+								},
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Triton SD config with groups and container role",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.TritonSDConfigs = []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "testAccount",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleContainer),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+						Groups:    []string{"group1"},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Triton SD config with groups and CN role",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.TritonSDConfigs = []monitoringv1alpha1.TritonSDConfig{
+					{
+						Account:   "testAccount",
+						Role:      ptr.To(monitoringv1alpha1.TritonRoleCN),
+						DNSSuffix: "triton.example.com",
+						Endpoint:  "cmon.triton.example.com",
+						Groups:    []string{"group1"},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Uyuni SD config with valid password",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Uyuni SD config with invalid password secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "wrong",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Uyuni SD config with unsupported Prometheus version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.UyuniSDConfigs = []monitoringv1alpha1.UyuniSDConfig{
+					{
+						Server:   "https://uyuni.example.com",
+						Username: "admin",
+						Password: v1.SecretKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "secret",
+							},
+							Key: "key1",
+						},
+					},
+				}
+			},
+			promVersion: "2.30.0",
+			valid:       false,
+		},
+		{
+			scenario: "Vultr SD config with valid authorization",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "secret",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "Vultr SD config with invalid authorization secret ref",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Credentials: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{
+									Name: "wrong",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "Vultr SD config with unsupported Prometheus version",
+			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
+				sc.VultrSDConfigs = []monitoringv1alpha1.VultrSDConfig{
+					{
+						Port: ptr.To(int32(9100)),
+					},
+				}
+			},
+			promVersion: "2.35.0",
+			valid:       false,
+		},
 		{
 			scenario: "Inexistent Scrape Class",
 			updateSpec: func(sc *monitoringv1alpha1.ScrapeConfigSpec) {
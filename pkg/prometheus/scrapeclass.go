@@ -0,0 +1,203 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+const (
+	// ScrapeClassConflict is the reason used when a ScrapeClassDefinition
+	// object conflicts with another scrape class.
+	ScrapeClassConflict = "ScrapeClassConflict"
+)
+
+// scrapeClassRegistry holds the scrape classes available to the scrape
+// resources of a Prometheus object.
+//
+// The scrape classes defined inline in the Prometheus object and the
+// ScrapeClassDefinition objects from the Prometheus namespace are global: they
+// apply to the scrape resources from all namespaces. The
+// ScrapeClassDefinition objects from the other namespaces only apply to the
+// scrape resources from the same namespace and take precedence over the
+// global scrape classes.
+type scrapeClassRegistry struct {
+	namespace string
+
+	global        map[string]monitoringv1.ScrapeClass
+	globalDefault string
+
+	local         map[string]map[string]monitoringv1.ScrapeClass
+	localDefaults map[string]string
+}
+
+func newEmptyScrapeClassRegistry(p monitoringv1.PrometheusInterface) *scrapeClassRegistry {
+	return &scrapeClassRegistry{
+		namespace:     p.GetObjectMeta().GetNamespace(),
+		global:        map[string]monitoringv1.ScrapeClass{},
+		local:         map[string]map[string]monitoringv1.ScrapeClass{},
+		localDefaults: map[string]string{},
+	}
+}
+
+// newScrapeClassRegistry returns a registry populated with the scrape classes
+// defined inline in the Prometheus object. Contrary to
+// getScrapeClassConfig(), it doesn't validate the scrape classes and ignores
+// the duplicate default scrape classes.
+func newScrapeClassRegistry(p monitoringv1.PrometheusInterface) *scrapeClassRegistry {
+	r := newEmptyScrapeClassRegistry(p)
+	for _, sc := range p.GetCommonPrometheusFields().ScrapeClasses {
+		_ = r.addGlobal(sc)
+	}
+
+	return r
+}
+
+func (r *scrapeClassRegistry) addGlobal(sc monitoringv1.ScrapeClass) error {
+	if _, found := r.global[sc.Name]; found {
+		return fmt.Errorf("scrape class %q already defined", sc.Name)
+	}
+
+	if ptr.Deref(sc.Default, false) {
+		if r.globalDefault != "" {
+			return errors.New("multiple default scrape classes defined")
+		}
+
+		r.globalDefault = sc.Name
+	}
+
+	r.global[sc.Name] = sc
+	return nil
+}
+
+// add registers the scrape class defined by the ScrapeClassDefinition object.
+// It returns an error if the scrape class conflicts with a scrape class already
+// registered.
+func (r *scrapeClassRegistry) add(obj *monitoringv1alpha1.ScrapeClassDefinition) error {
+	sc := obj.ToScrapeClass()
+
+	if obj.Namespace == r.namespace {
+		if ptr.Deref(sc.Default, false) && r.globalDefault != "" {
+			return fmt.Errorf("multiple default scrape classes defined (%q is already the default scrape class)", r.globalDefault)
+		}
+
+		return r.addGlobal(sc)
+	}
+
+	if _, found := r.global[sc.Name]; found {
+		return fmt.Errorf("scrape class %q already defined by the Prometheus resource", sc.Name)
+	}
+
+	classes, found := r.local[obj.Namespace]
+	if !found {
+		classes = map[string]monitoringv1.ScrapeClass{}
+		r.local[obj.Namespace] = classes
+	}
+
+	if ptr.Deref(sc.Default, false) {
+		if def, found := r.localDefaults[obj.Namespace]; found {
+			return fmt.Errorf("multiple default scrape classes defined in namespace %q (%q is already the default scrape class)", obj.Namespace, def)
+		}
+
+		r.localDefaults[obj.Namespace] = sc.Name
+	}
+
+	classes[sc.Name] = sc
+	return nil
+}
+
+// get returns the scrape class matching the name for the given namespace.
+func (r *scrapeClassRegistry) get(namespace, name string) (monitoringv1.ScrapeClass, bool) {
+	if sc, found := r.local[namespace][name]; found {
+		return sc, true
+	}
+
+	sc, found := r.global[name]
+	return sc, found
+}
+
+// getOrDefault returns the scrape class matching the name for the given
+// namespace or the default scrape class if the name is empty or doesn't
+// match. It returns an empty scrape class if none matches.
+func (r *scrapeClassRegistry) getOrDefault(namespace string, name *string) monitoringv1.ScrapeClass {
+	if name != nil {
+		if sc, found := r.get(namespace, *name); found {
+			return sc
+		}
+	}
+
+	if def, found := r.localDefaults[namespace]; found {
+		return r.local[namespace][def]
+	}
+
+	if r.globalDefault != "" {
+		return r.global[r.globalDefault]
+	}
+
+	return monitoringv1.ScrapeClass{}
+}
+
+// validateScrapeClassConfig verifies that the scrape class settings are valid
+// for the Prometheus object.
+func validateScrapeClassConfig(p monitoringv1.PrometheusInterface, scrapeClass monitoringv1.ScrapeClass) error {
+	lcv, err := NewLabelConfigValidator(p)
+	if err != nil {
+		return err
+	}
+
+	if err := lcv.Validate(scrapeClass.Relabelings); err != nil {
+		return fmt.Errorf("invalid relabelings for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	if err := lcv.Validate(scrapeClass.MetricRelabelings); err != nil {
+		return fmt.Errorf("invalid metric relabelings for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	if err := scrapeClass.TLSConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid TLS config for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	if err := scrapeClass.Authorization.Validate(); err != nil {
+		return fmt.Errorf("invalid authorization for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	if err := validateScrapeIntervalAndTimeout(p, ptr.Deref(scrapeClass.Interval, ""), ptr.Deref(scrapeClass.ScrapeTimeout, "")); err != nil {
+		return fmt.Errorf("invalid scrape interval or timeout for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	if err := validateScrapeClassProxyConfig(scrapeClass.ProxyConfig); err != nil {
+		return fmt.Errorf("invalid proxy config for scrapeClass %s: %w", scrapeClass.Name, err)
+	}
+
+	return nil
+}
+
+func validateScrapeClassProxyConfig(pc *monitoringv1.ProxyConfig) error {
+	if pc == nil {
+		return nil
+	}
+
+	if len(pc.ProxyConnectHeader) > 0 {
+		return fmt.Errorf("proxyConnectHeader isn't supported")
+	}
+
+	return pc.Validate()
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func makeScrapeClassDefinition(namespace, name string, isDefault bool) *monitoringv1alpha1.ScrapeClassDefinition {
+	return &monitoringv1alpha1.ScrapeClassDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"group": "group1",
+			},
+		},
+		Spec: monitoringv1alpha1.ScrapeClassDefinitionSpec{
+			Default: ptr.To(isDefault),
+		},
+	}
+}
+
+func TestScrapeClassRegistry(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ScrapeClasses: []monitoringv1.ScrapeClass{
+					{Name: "inline"},
+				},
+			},
+		},
+	}
+
+	r := newScrapeClassRegistry(p)
+
+	// Global scrape classes.
+	require.NoError(t, r.add(makeScrapeClassDefinition("monitoring", "global", true)))
+	require.ErrorContains(t, r.add(makeScrapeClassDefinition("monitoring", "inline", false)), `scrape class "inline" already defined`)
+	require.ErrorContains(t, r.add(makeScrapeClassDefinition("monitoring", "other-default", true)), "multiple default scrape classes defined")
+
+	// Namespaced scrape classes.
+	require.NoError(t, r.add(makeScrapeClassDefinition("team-a", "local", true)))
+	require.NoError(t, r.add(makeScrapeClassDefinition("team-b", "local", false)))
+	require.ErrorContains(t, r.add(makeScrapeClassDefinition("team-a", "global", false)), `scrape class "global" already defined by the Prometheus resource`)
+	require.ErrorContains(t, r.add(makeScrapeClassDefinition("team-a", "other-default", true)), `multiple default scrape classes defined in namespace "team-a"`)
+
+	for _, tc := range []struct {
+		namespace string
+		name      *string
+		expected  string
+	}{
+		{namespace: "team-a", expected: "local"},
+		{namespace: "team-a", name: ptr.To("local"), expected: "local"},
+		{namespace: "team-a", name: ptr.To("inline"), expected: "inline"},
+		{namespace: "team-b", expected: "global"},
+		{namespace: "team-b", name: ptr.To("local"), expected: "local"},
+		{namespace: "team-c", expected: "global"},
+		{namespace: "team-c", name: ptr.To("inline"), expected: "inline"},
+		// Scrape classes from other namespaces aren't visible.
+		{namespace: "team-c", name: ptr.To("local"), expected: "global"},
+		{namespace: "monitoring", name: ptr.To("local"), expected: "global"},
+	} {
+		t.Run(tc.namespace+"/"+ptr.Deref(tc.name, ""), func(t *testing.T) {
+			require.Equal(t, tc.expected, r.getOrDefault(tc.namespace, tc.name).Name)
+		})
+	}
+
+	_, found := r.get("team-c", "local")
+	require.False(t, found)
+}
+
+func TestSelectScrapeClassDefinitions(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ScrapeClasses: []monitoringv1.ScrapeClass{
+					{Name: "inline", Default: ptr.To(true)},
+				},
+				ScrapeClassSelector: &metav1.LabelSelector{},
+			},
+		},
+	}
+
+	invalid := makeScrapeClassDefinition("test", "invalid", false)
+	invalid.Spec.Interval = ptr.To(monitoringv1.Duration("10s"))
+	invalid.Spec.ScrapeTimeout = ptr.To(monitoringv1.Duration("20s"))
+
+	scds := []*monitoringv1alpha1.ScrapeClassDefinition{
+		makeScrapeClassDefinition("test", "a", false),
+		// Conflicts with the inline default scrape class.
+		makeScrapeClassDefinition("test", "b", true),
+		// Conflicts with the inline scrape class.
+		makeScrapeClassDefinition("test", "inline", false),
+		invalid,
+	}
+
+	rs, err := NewResourceSelector(
+		newLogger(),
+		p,
+		assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+		nil,
+		operator.NewMetrics(prometheus.NewPedanticRegistry()),
+		operator.NewFakeRecorder(10, p),
+	)
+	require.NoError(t, err)
+
+	res, err := rs.SelectScrapeClassDefinitions(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+		for _, scd := range scds {
+			appendFn(scd)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+
+	valid := res.ValidResources()
+	require.Len(t, valid, 1)
+	require.Contains(t, valid, "test/a")
+
+	for k, reason := range map[string]string{
+		"test/b":       ScrapeClassConflict,
+		"test/inline":  ScrapeClassConflict,
+		"test/invalid": operator.InvalidConfiguration,
+	} {
+		r := res[k]
+		conditions := r.Conditions()
+		require.Len(t, conditions, 1)
+		require.Equal(t, monitoringv1.ConditionFalse, conditions[0].Status)
+		require.Equal(t, reason, conditions[0].Reason, k)
+	}
+
+	// The ServiceMonitor objects can reference the selected scrape classes.
+	sms := []*monitoringv1.ServiceMonitor{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "test"},
+			Spec:       monitoringv1.ServiceMonitorSpec{ScrapeClassName: ptr.To("a")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "rejected", Namespace: "test"},
+			Spec:       monitoringv1.ServiceMonitorSpec{ScrapeClassName: ptr.To("b")},
+		},
+	}
+
+	smons, err := rs.SelectServiceMonitors(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+		for _, sm := range sms {
+			appendFn(sm)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, smons.ValidResources(), 1)
+	require.Contains(t, smons.ValidResources(), "test/valid")
+}
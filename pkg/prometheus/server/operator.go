@@ -26,15 +26,20 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	clientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -54,6 +59,10 @@ const (
 	controllerName            = "prometheus-controller"
 	applicationNameLabelValue = "prometheus"
 
+	// DebugResource is the resource name identifying Prometheus objects in
+	// the debug API.
+	DebugResource = "prometheus"
+
 	unmanagedConfigurationReason         = "ConfigurationUnmanaged"
 	unmanagedConfigurationMessage string = "the operator doesn't manage the Prometheus configuration secret because neither serviceMonitorSelector nor podMonitorSelector, nor probeSelector is specified. Unmanaged Prometheus configuration is deprecated, use additionalScrapeConfigs or the ScrapeConfig instead."
 )
@@ -80,38 +89,76 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	quotaInfs *informers.ForResource
+	scdInfs   *informers.ForResource
+	grantInfs *informers.ForResource
 	ruleInfs  *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
 
+	// Informers used to compute the target discovery preview of the
+	// ServiceMonitor and PodMonitor objects.
+	svcInfs *informers.ForResource
+	epsInfs *informers.ForResource
+	podInfs *informers.ForResource
+	// tdQueue holds the keys of the Prometheus objects for which the target
+	// discovery preview needs to be refreshed.
+	tdQueue workqueue.TypedDelayingInterface[string]
+
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
 	statusReporter  prompkg.StatusReporter
 
-	endpointSliceSupported        bool
-	scrapeConfigSupported         bool
-	canReadStorageClass           bool
-	disableUnmanagedConfiguration bool
-	retentionPoliciesEnabled      bool
-	configResourcesStatusEnabled  bool
+	endpointSliceSupported         bool
+	scrapeConfigSupported          bool
+	monitoringQuotaSupported       bool
+	scrapeClassDefinitionSupported bool
+	referenceGrantSupported        bool
+	canReadStorageClass            bool
+	disableUnmanagedConfiguration  bool
+	retentionPoliciesEnabled       bool
+	configResourcesStatusEnabled   bool
+	targetDiscoveryStatusEnabled   bool
 
 	newEventRecorder operator.NewEventRecorderFunc
 	finalizerSyncer  *operator.FinalizerSyncer
+
+	debugStore      *operator.DebugStore
+	secretProviders *assets.SecretProviders
 }
 
 type ControllerOption func(*Operator)
 
-// selectedConfigResources return the configuration resources (serviceMonitors, podMonitors, probes, prometheusRules and scrapeConfigs)
+// selectedConfigResources return the configuration resources (serviceMonitors, podMonitors, probes, prometheusRules, scrapeConfigs and scrapeClassDefinitions)
 // selected by Prometheus.
 type selectedConfigResources struct {
-	sMons         operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]
-	pMons         operator.TypedResourcesSelection[*monitoringv1.PodMonitor]
-	bMons         operator.TypedResourcesSelection[*monitoringv1.Probe]
-	scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
-	rules         operator.PrometheusRuleSelection
+	sMons                  operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]
+	pMons                  operator.TypedResourcesSelection[*monitoringv1.PodMonitor]
+	bMons                  operator.TypedResourcesSelection[*monitoringv1.Probe]
+	scrapeConfigs          operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
+	scrapeClassDefinitions operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeClassDefinition]
+	rules                  operator.PrometheusRuleSelection
+}
+
+// statuses returns the selected and rejected configuration resources.
+func (scr *selectedConfigResources) statuses() ([]operator.ResourceSelectionStatus, []operator.ResourceSelectionStatus) {
+	var selected, rejected []operator.ResourceSelectionStatus
+	add := func(s, r []operator.ResourceSelectionStatus) {
+		selected = append(selected, s...)
+		rejected = append(rejected, r...)
+	}
+
+	add(scr.sMons.ResourceSelectionStatuses(monitoringv1.ServiceMonitorsKind))
+	add(scr.pMons.ResourceSelectionStatuses(monitoringv1.PodMonitorsKind))
+	add(scr.bMons.ResourceSelectionStatuses(monitoringv1.ProbesKind))
+	add(scr.scrapeConfigs.ResourceSelectionStatuses(monitoringv1alpha1.ScrapeConfigsKind))
+	add(scr.scrapeClassDefinitions.ResourceSelectionStatuses(monitoringv1alpha1.ScrapeClassDefinitionsKind))
+	add(scr.rules.Selected().ResourceSelectionStatuses(monitoringv1.PrometheusRuleKind))
+
+	return selected, rejected
 }
 
 // WithEndpointSlice tells that the Kubernetes API supports the Endpointslice resource.
@@ -128,6 +175,38 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithMonitoringQuota tells that the controller enforces MonitoringQuota
+// objects.
+func WithMonitoringQuota() ControllerOption {
+	return func(o *Operator) {
+		o.monitoringQuotaSupported = true
+	}
+}
+
+// WithScrapeClassDefinition tells that the controller manages
+// ScrapeClassDefinition objects.
+func WithScrapeClassDefinition() ControllerOption {
+	return func(o *Operator) {
+		o.scrapeClassDefinitionSupported = true
+	}
+}
+
+// WithReferenceGrant tells that the controller allows cross-namespace
+// references granted by ReferenceGrant objects.
+func WithReferenceGrant() ControllerOption {
+	return func(o *Operator) {
+		o.referenceGrantSupported = true
+	}
+}
+
+// WithSecretProviders tells that the controller can resolve credentials from
+// the given secret providers.
+func WithSecretProviders(sp *assets.SecretProviders) ControllerOption {
+	return func(o *Operator) {
+		o.secretProviders = sp
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -152,6 +231,23 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithTargetDiscoveryStatus tells that the controller should report the
+// target discovery preview in the status of the ServiceMonitor and PodMonitor
+// objects. It has no effect unless WithConfigResourceStatus is also set.
+func WithTargetDiscoveryStatus() ControllerOption {
+	return func(o *Operator) {
+		o.targetDiscoveryStatusEnabled = true
+	}
+}
+
+// WithDebugStore tells that the controller should record the generated
+// configuration and the selected resources into the debug store.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, opts ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -209,6 +305,8 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 	if o.configResourcesStatusEnabled {
 		o.finalizerSyncer = operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName))
+	} else {
+		o.targetDiscoveryStatusEnabled = false
 	}
 
 	o.metrics.MustRegister(o.reconciliations)
@@ -302,6 +400,54 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			return nil, fmt.Errorf("error creating scrapeconfigs informers: %w", err)
 		}
 	}
+
+	if o.monitoringQuotaSupported {
+		o.quotaInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.MonitoringQuotaName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating monitoringquotas informers: %w", err)
+		}
+	}
+
+	if o.referenceGrantSupported {
+		o.grantInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ReferenceGrantName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating referencegrants informers: %w", err)
+		}
+	}
+
+	if o.scrapeClassDefinitionSupported {
+		o.scdInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeClassDefinitionName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating scrapeclassdefinitions informers: %w", err)
+		}
+	}
 	o.ruleInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
@@ -369,6 +515,36 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	if o.targetDiscoveryStatusEnabled {
+		for _, inf := range []struct {
+			infs **informers.ForResource
+			gvr  schema.GroupVersionResource
+		}{
+			{&o.svcInfs, v1.SchemeGroupVersion.WithResource(string(v1.ResourceServices))},
+			{&o.epsInfs, discoveryv1.SchemeGroupVersion.WithResource("endpointslices")},
+			{&o.podInfs, v1.SchemeGroupVersion.WithResource(string(v1.ResourcePods))},
+		} {
+			*inf.infs, err = informers.NewInformersForResourceWithTransform(
+				informers.NewKubeInformerFactories(
+					c.Namespaces.AllowList,
+					c.Namespaces.DenyList,
+					o.kclient,
+					resyncPeriod,
+					nil,
+				),
+				inf.gvr,
+				prompkg.TargetDiscoveryStrip,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error creating %s informers: %w", inf.gvr.Resource, err)
+			}
+		}
+
+		o.tdQueue = workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
+			Name: "prometheus_target_discovery",
+		})
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -426,9 +602,15 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PrometheusRule", c.ruleInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"MonitoringQuota", c.quotaInfs},
+		{"ReferenceGrant", c.grantInfs},
+		{"ScrapeClassDefinition", c.scdInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
+		{"Service", c.svcInfs},
+		{"EndpointSlice", c.epsInfs},
+		{"Pod", c.podInfs},
 	} {
 		// Skipping informers that were not started. If prerequisites for a CRD were not met, their informer will be
 		// nil. ScrapeConfig is one example.
@@ -523,6 +705,53 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.quotaInfs != nil {
+		c.quotaInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.MonitoringQuotasKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
+	if c.grantInfs != nil {
+		c.grantInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ReferenceGrantsKind,
+			c.enqueueForCrossNamespaceReferences,
+			operator.WithFilter(operator.GenerationChanged),
+		))
+	}
+
+	if c.secretProviders != nil {
+		c.secretProviders.OnChange(func(s *v1.Secret) {
+			c.enqueueForReference(s)
+		})
+		c.secretProviders.AddReferenceChecker(func(s *v1.Secret) bool {
+			return c.reconciliations.IsReferenced(s)
+		})
+	}
+
+	if c.scdInfs != nil {
+		c.scdInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.ScrapeClassDefinitionsKind,
+			c.enqueueForMonitorNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	c.ruleInfs.AddEventHandler(operator.NewEventHandler(
 		c.logger,
 		c.accessor,
@@ -561,6 +790,14 @@ func (c *Operator) addHandlers() {
 		operator.WithFilter(hasRefFunc),
 	))
 
+	// The target discovery preview of the service monitors depends on the
+	// services' labels and ports. The endpointslices and pods change too
+	// frequently to trigger a refresh: the preview is updated on the next
+	// reconciliation.
+	if c.svcInfs != nil {
+		c.svcInfs.AddEventHandler(c.serviceEventHandler())
+	}
+
 	// The controller needs to watch the namespaces in which the service/pod
 	// monitors and rules live because a label change on a namespace may
 	// trigger a configuration change.
@@ -583,10 +820,27 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.monitoringQuotaSupported {
+		go c.quotaInfs.Start(ctx.Done())
+	}
+	if c.referenceGrantSupported {
+		go c.grantInfs.Start(ctx.Done())
+	}
+	if c.scrapeClassDefinitionSupported {
+		go c.scdInfs.Start(ctx.Done())
+	}
 	go c.ruleInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	if c.targetDiscoveryStatusEnabled {
+		go c.svcInfs.Start(ctx.Done())
+		go c.epsInfs.Start(ctx.Done())
+		go c.podInfs.Start(ctx.Done())
+
+		go c.runTargetDiscoveryWorker(ctx)
+		defer c.tdQueue.ShutDown()
+	}
 	go c.nsMonInf.Run(ctx.Done())
 	if c.nsPromInf != c.nsMonInf {
 		go c.nsPromInf.Run(ctx.Done())
@@ -626,6 +880,37 @@ func (c *Operator) RefreshStatusFor(o metav1.Object) {
 
 func (c *Operator) enqueueForPrometheusNamespace(nsName string) {
 	c.enqueueForNamespace(c.nsPromInf.GetStore(), nsName)
+	c.enqueueForCrossNamespaceReferences(nsName)
+}
+
+// enqueueForCrossNamespaceReferences enqueues the Prometheus objects which
+// reference Secrets or ConfigMaps from the given namespace via a
+// ReferenceGrant object.
+func (c *Operator) enqueueForCrossNamespaceReferences(nsName string) {
+	if c.grantInfs == nil {
+		return
+	}
+
+	c.enqueueForReference(&monitoringv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: nsName},
+	})
+}
+
+// enqueueForReference enqueues the Prometheus objects which have a reference to
+// the given object.
+func (c *Operator) enqueueForReference(ref runtime.Object) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		if c.reconciliations.HasRefTo(fmt.Sprintf("%s/%s", p.Namespace, p.Name), ref) {
+			c.rr.EnqueueForReconciliation(p)
+		}
+	})
+	if err != nil {
+		c.logger.Error(
+			"listing all Prometheus instances from cache failed",
+			"err", err,
+		)
+	}
 }
 
 func (c *Operator) enqueueForMonitorNamespace(nsName string) {
@@ -735,6 +1020,22 @@ func (c *Operator) enqueueForNamespace(store cache.Store, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting ScrapeClassDefinitions
+		// in the NS.
+		scrapeClassNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.ScrapeClassNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert ScrapeClassNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if scrapeClassNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error(
@@ -815,6 +1116,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(DebugResource, key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return nil
 	}
@@ -839,6 +1141,7 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(DebugResource, key)
 		return nil
 	}
 
@@ -854,6 +1157,12 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	logger.Info("sync prometheus")
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+	if c.grantInfs != nil {
+		assetStore.WithReferenceGrants(c.grantInfs.ListAllByNamespace)
+	}
+	if c.secretProviders != nil {
+		assetStore.WithSecretProviders(c.secretProviders)
+	}
 
 	opts := []prompkg.ConfigGeneratorOption{}
 	if c.endpointSliceSupported {
@@ -868,6 +1177,8 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	if err != nil {
 		return err
 	}
+	selected, rejected := resources.statuses()
+	c.debugStore.SetResourceSelection(DebugResource, key, selected, rejected)
 
 	ruleConfigMapNames, err := c.createOrUpdateRuleConfigMaps(ctx, p, resources.rules, logger)
 	if err != nil {
@@ -1068,16 +1379,39 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 
 	var configResourceSyncer = operator.NewConfigResourceSyncer(p, c.dclient, c.accessor)
 
+	var tdp *prompkg.TargetDiscoveryPreviewer
+	if c.targetDiscoveryStatusEnabled {
+		tdp = prompkg.NewTargetDiscoveryPreviewer(p, c.svcInfs.ListAllByNamespace, c.epsInfs.ListAllByNamespace, c.podInfs.ListAllByNamespace)
+	}
+
 	// Update the status of selected serviceMonitors.
 	for key, configResource := range resources.sMons {
-		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+		var td *monitoringv1.TargetDiscoveryStatus
+		if tdp != nil {
+			var err error
+			td, err = tdp.ServiceMonitor(configResource.Resource())
+			if err != nil {
+				c.logger.Warn("failed to compute the target discovery preview", "err", err, "servicemonitor", key)
+			}
+		}
+
+		if err := configResourceSyncer.UpdateBindingWithTargetDiscovery(ctx, configResource.Resource(), configResource.Conditions(), td); err != nil {
 			return fmt.Errorf("failed to update ServiceMonitor %s status: %w", key, err)
 		}
 	}
 
 	// Update the status of selected podMonitors.
 	for key, configResource := range resources.pMons {
-		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+		var td *monitoringv1.TargetDiscoveryStatus
+		if tdp != nil {
+			var err error
+			td, err = tdp.PodMonitor(configResource.Resource())
+			if err != nil {
+				c.logger.Warn("failed to compute the target discovery preview", "err", err, "podmonitor", key)
+			}
+		}
+
+		if err := configResourceSyncer.UpdateBindingWithTargetDiscovery(ctx, configResource.Resource(), configResource.Conditions(), td); err != nil {
 			return fmt.Errorf("failed to update PodMonitor %s status: %w", key, err)
 		}
 	}
@@ -1096,6 +1430,13 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 		}
 	}
 
+	// Update the status of selected scrapeClassDefinitions.
+	for key, configResource := range resources.scrapeClassDefinitions {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update ScrapeClassDefinition %s status: %w", key, err)
+		}
+	}
+
 	// Update the status of selected prometheusRules.
 	for key, configResource := range resources.rules.Selected() {
 		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
@@ -1121,6 +1462,14 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 		return fmt.Errorf("failed to remove bindings for scrapeConfigs: %w", err)
 	}
 
+	// Remove bindings from scrapeClassDefinitions which reference the
+	// workload but aren't selected anymore.
+	if c.scdInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.scdInfs.ListAll, resources.scrapeClassDefinitions, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for scrapeClassDefinitions: %w", err)
+		}
+	}
+
 	// Remove bindings from probes which reference the
 	// workload but aren't selected anymore.
 	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, resources.bMons, configResourceSyncer); err != nil {
@@ -1158,6 +1507,13 @@ func (c *Operator) configResStatusCleanup(ctx context.Context, p *monitoringv1.P
 		return fmt.Errorf("failed to remove bindings for scrapeConfigs: %w", err)
 	}
 
+	// Remove bindings from all scrapeClassDefinitions which reference the workload.
+	if c.scdInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.scdInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeClassDefinition]{}, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for scrapeClassDefinitions: %w", err)
+		}
+	}
+
 	// Remove bindings from all probes which reference the workload.
 	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1.Probe]{}, configResourceSyncer); err != nil {
 		return fmt.Errorf("failed to remove bindings for probes: %w", err)
@@ -1327,11 +1683,27 @@ func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfig
 
 // getSeletedConfigResources returns all the configuration resources (PodMonitor, ServiceMonitor, Probes and ScrapeConfigs) selected by the Prometheus.
 func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, store *assets.StoreBuilder) (*selectedConfigResources, error) {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.quotaInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithMonitoringQuotas(c.quotaInfs.ListAllByNamespace))
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 
 	if err != nil {
 		return nil, err
 	}
+
+	// The scrape classes need to be selected first because the other
+	// resources depend on them.
+	var scrapeClassDefinitions operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeClassDefinition]
+	if c.scdInfs != nil {
+		scrapeClassDefinitions, err = resourceSelector.SelectScrapeClassDefinitions(ctx, c.scdInfs.ListAllByNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("selecting ScrapeClassDefinitions failed: %w", err)
+		}
+	}
+
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
 		return nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
@@ -1361,11 +1733,12 @@ func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.
 	}
 
 	return &selectedConfigResources{
-		sMons:         smons,
-		bMons:         bmons,
-		pMons:         pmons,
-		scrapeConfigs: scrapeConfigs,
-		rules:         rules,
+		sMons:                  smons,
+		bMons:                  bmons,
+		pMons:                  pmons,
+		scrapeConfigs:          scrapeConfigs,
+		scrapeClassDefinitions: scrapeClassDefinitions,
+		rules:                  rules,
 	}, nil
 }
 
@@ -1393,16 +1766,61 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		return err
 	}
 
-	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
+	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
+	conf, err := generateConfiguration(ctx, logger, sClient, p, cg, ruleConfigMapNames, store, resources)
+	if err != nil {
 		return err
 	}
+	c.recordConfiguration(logger, p.GetNamespace()+"/"+p.GetName(), conf)
+
+	// Compress config to avoid 1mb secret limit for a while
+	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
+	if err != nil {
+		return fmt.Errorf("creating compressed secret failed: %w", err)
+	}
+
+	logger.Debug("updating Prometheus configuration secret")
+	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+}
+
+// recordConfiguration records the generated configuration with redacted
+// secrets into the debug store.
+func (c *Operator) recordConfiguration(logger *slog.Logger, key string, conf []byte) {
+	if c.debugStore == nil {
+		return
+	}
+
+	redacted, err := prompkg.RedactConfiguration(conf)
+	if err != nil {
+		logger.Warn("failed to redact the configuration for the debug API", "err", err)
+		return
+	}
+
+	c.debugStore.SetConfiguration(DebugResource, key, redacted)
+}
+
+// generateConfiguration adds the credentials referenced by the Prometheus
+// object to the store and returns the generated Prometheus configuration.
+func generateConfiguration(
+	ctx context.Context,
+	logger *slog.Logger,
+	sClient clientv1.SecretInterface,
+	p *monitoringv1.Prometheus,
+	cg *prompkg.ConfigGenerator,
+	ruleConfigMapNames []string,
+	store *assets.StoreBuilder,
+	resources *selectedConfigResources,
+) ([]byte, error) {
+	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
+		return nil, err
+	}
 
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1410,31 +1828,35 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return nil, fmt.Errorf("alertmanager %d: %w", i, err)
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
+	}
+
+	cg, err := cg.WithScrapeClasses(resources.scrapeClassDefinitions.ValidResources())
+	if err != nil {
+		return nil, err
 	}
 
-	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 	additionalAlertRelabelConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
 	additionalAlertManagerConfigs, err := k8sutil.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
@@ -1451,17 +1873,10 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		ruleConfigMapNames,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, fmt.Errorf("generating config failed: %w", err)
 	}
 
-	// Compress config to avoid 1mb secret limit for a while
-	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
-	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
-	}
-
-	logger.Debug("updating Prometheus configuration secret")
-	return k8sutil.CreateOrUpdateSecret(ctx, sClient, s)
+	return conf, nil
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
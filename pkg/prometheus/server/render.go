@@ -0,0 +1,162 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// ConfigResources holds the configuration resources which are candidates for
+// selection when rendering a Prometheus configuration outside of the
+// controller.
+type ConfigResources struct {
+	ServiceMonitors []*monitoringv1.ServiceMonitor
+	PodMonitors     []*monitoringv1.PodMonitor
+	Probes          []*monitoringv1.Probe
+	ScrapeConfigs   []*monitoringv1alpha1.ScrapeConfig
+
+	ScrapeClassDefinitions []*monitoringv1alpha1.ScrapeClassDefinition
+}
+
+// RenderConfiguration returns the Prometheus configuration that the
+// controller would generate for the given Prometheus object.
+//
+// The configuration resources are selected and validated exactly like the
+// controller does it. Secrets and ConfigMaps are read from kclient and the
+// namespaces from nsInf which means that the function works with in-memory
+// clients and doesn't require a Kubernetes cluster. It never creates nor
+// updates any object.
+//
+// PrometheusRule objects aren't considered: the "rule_files" section refers
+// to the default number of rule ConfigMaps.
+func RenderConfiguration(
+	ctx context.Context,
+	logger *slog.Logger,
+	kclient kubernetes.Interface,
+	nsInf cache.SharedIndexInformer,
+	p *monitoringv1.Prometheus,
+	cr ConfigResources,
+	opts ...prompkg.ConfigGeneratorOption,
+) ([]byte, error) {
+	if p.Spec.ServiceMonitorSelector == nil &&
+		p.Spec.PodMonitorSelector == nil &&
+		p.Spec.ProbeSelector == nil &&
+		p.Spec.ScrapeConfigSelector == nil {
+		return nil, errors.New("the configuration is unmanaged because none of serviceMonitorSelector, podMonitorSelector, probeSelector and scrapeConfigSelector is defined")
+	}
+
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+
+	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	rs, err := prompkg.NewResourceSelector(
+		logger,
+		p,
+		store,
+		nsInf,
+		operator.NewMetrics(prometheus.NewRegistry()),
+		operator.NewNoopEventRecorder(p),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources selectedConfigResources
+
+	resources.scrapeClassDefinitions, err = rs.SelectScrapeClassDefinitions(ctx, listFromObjects(cr.ScrapeClassDefinitions))
+	if err != nil {
+		return nil, fmt.Errorf("selecting ScrapeClassDefinitions failed: %w", err)
+	}
+
+	resources.sMons, err = rs.SelectServiceMonitors(ctx, listFromObjects(cr.ServiceMonitors))
+	if err != nil {
+		return nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+	}
+
+	resources.pMons, err = rs.SelectPodMonitors(ctx, listFromObjects(cr.PodMonitors))
+	if err != nil {
+		return nil, fmt.Errorf("selecting PodMonitors failed: %w", err)
+	}
+
+	resources.bMons, err = rs.SelectProbes(ctx, listFromObjects(cr.Probes))
+	if err != nil {
+		return nil, fmt.Errorf("selecting Probes failed: %w", err)
+	}
+
+	resources.scrapeConfigs, err = rs.SelectScrapeConfigs(ctx, listFromObjects(cr.ScrapeConfigs))
+	if err != nil {
+		return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+	}
+
+	ruleConfigMapNames := operator.NewPrometheusRuleSyncer(
+		logger,
+		fmt.Sprintf("prometheus-%s", p.Name),
+		nil,
+		nil,
+		nil,
+	).AppendConfigMapNames(nil, 3)
+
+	return generateConfiguration(
+		ctx,
+		logger,
+		kclient.CoreV1().Secrets(p.Namespace),
+		p,
+		cg,
+		ruleConfigMapNames,
+		store,
+		&resources,
+	)
+}
+
+// listFromObjects returns a ListAllByNamespaceFn function which lists the
+// given objects instead of an informer's cache.
+func listFromObjects[T metav1.Object](objects []T) prompkg.ListAllByNamespaceFn {
+	return func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error {
+		for _, o := range objects {
+			if namespace != metav1.NamespaceAll && o.GetNamespace() != namespace {
+				continue
+			}
+
+			if !selector.Matches(labels.Set(o.GetLabels())) {
+				continue
+			}
+
+			appendFn(o)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+func TestRenderConfiguration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kclient := fake.NewClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "monitoring"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "team-a"},
+			Data: map[string][]byte{
+				"user":     []byte("foo"),
+				"password": []byte("bar"),
+			},
+		},
+	)
+	factory := kinformers.NewSharedInformerFactory(kclient, 0)
+	nsInf := factory.Core().V1().Namespaces().Informer()
+	factory.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), nsInf.HasSynced))
+
+	newServiceMonitor := func(ns, name string, ep monitoringv1.Endpoint) *monitoringv1.ServiceMonitor {
+		return &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{ep},
+			},
+		}
+	}
+
+	cr := ConfigResources{
+		ServiceMonitors: []*monitoringv1.ServiceMonitor{
+			newServiceMonitor("team-a", "valid", monitoringv1.Endpoint{
+				Port: "web",
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "creds"}, Key: "user"},
+					Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "creds"}, Key: "password"},
+				},
+			}),
+			newServiceMonitor("team-a", "invalid", monitoringv1.Endpoint{
+				Port:          "web",
+				Interval:      "10s",
+				ScrapeTimeout: "20s",
+			}),
+			newServiceMonitor("team-a", "missing-secret", monitoringv1.Endpoint{
+				Port: "web",
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "user"},
+					Password: v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Key: "password"},
+				},
+			}),
+			newServiceMonitor("team-b", "not-selected", monitoringv1.Endpoint{Port: "web"}),
+		},
+	}
+
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "monitoring"},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				ScrapeInterval:         "30s",
+				ServiceMonitorSelector: &metav1.LabelSelector{},
+				ServiceMonitorNamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "a"},
+				},
+			},
+			RuleSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	b, err := RenderConfiguration(ctx, nil, kclient, nsInf, p, cr, prompkg.WithEndpointSliceSupport())
+	require.NoError(t, err)
+
+	cfg := string(b)
+	require.Contains(t, cfg, "job_name: serviceMonitor/team-a/valid/0")
+	require.Contains(t, cfg, "password: bar")
+	require.Contains(t, cfg, "- /etc/prometheus/rules/prometheus-test-rulefiles-0/*.yaml")
+	require.NotContains(t, cfg, "serviceMonitor/team-a/invalid/0")
+	require.NotContains(t, cfg, "serviceMonitor/team-a/missing-secret/0")
+	require.NotContains(t, cfg, "serviceMonitor/team-b/not-selected/0")
+
+	// Without selectors, the configuration isn't managed by the operator.
+	p.Spec.ServiceMonitorSelector = nil
+	_, err = RenderConfiguration(ctx, nil, kclient, nsInf, p, cr)
+	require.Error(t, err)
+}
@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
+	"github.com/prometheus/common/model"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
@@ -63,6 +65,7 @@ func (c *Operator) selectPrometheusRules(p *monitoringv1.Prometheus, logger *slo
 		c.ruleInfs,
 		c.newEventRecorder(p),
 		logger,
+		operator.WithScrapeInterval(scrapeInterval(p)),
 	)
 	if err != nil {
 		return rules, fmt.Errorf("initializing PrometheusRules failed: %w", err)
@@ -102,3 +105,14 @@ func (c *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, p *monitori
 
 	return prs.AppendConfigMapNames(configMapNames, 3), nil
 }
+
+// scrapeInterval returns the global scrape interval of the Prometheus
+// resource or zero if it can't be determined.
+func scrapeInterval(p *monitoringv1.Prometheus) time.Duration {
+	d, err := model.ParseDuration(string(p.Spec.ScrapeInterval))
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(d)
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// targetDiscoveryRefreshDelay is the delay between a Service change and the
+// refresh of the target discovery previews. The changes happening during this
+// interval are coalesced into a single refresh.
+const targetDiscoveryRefreshDelay = 30 * time.Second
+
+// serviceEventHandler returns the event handler of the Service informers.
+//
+// Service changes don't trigger a reconciliation of the Prometheus objects:
+// only the target discovery preview of the ServiceMonitor objects is
+// refreshed, and only if the labels or the ports of the Service have changed.
+func (c *Operator) serviceEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(_ any, isInInitialList bool) {
+			if isInInitialList {
+				return
+			}
+
+			c.enqueueForTargetDiscovery()
+		},
+		UpdateFunc: func(old, cur any) {
+			oldSvc, ok := old.(*v1.Service)
+			if !ok {
+				return
+			}
+
+			curSvc, ok := cur.(*v1.Service)
+			if !ok {
+				return
+			}
+
+			if !prompkg.ServiceTargetDiscoveryChanged(oldSvc, curSvc) {
+				return
+			}
+
+			c.enqueueForTargetDiscovery()
+		},
+		DeleteFunc: func(any) {
+			c.enqueueForTargetDiscovery()
+		},
+	}
+}
+
+// enqueueForTargetDiscovery schedules the refresh of the target discovery
+// previews for the Prometheus objects selecting ServiceMonitors.
+func (c *Operator) enqueueForTargetDiscovery() {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		if p.Spec.ServiceMonitorSelector == nil {
+			return
+		}
+
+		key, ok := c.accessor.MetaNamespaceKey(p)
+		if !ok {
+			return
+		}
+
+		c.tdQueue.AddAfter(key, targetDiscoveryRefreshDelay)
+	})
+	if err != nil {
+		c.logger.Error("listing all Prometheus instances from cache failed", "err", err)
+	}
+}
+
+// runTargetDiscoveryWorker processes the target discovery queue until it is
+// shut down.
+func (c *Operator) runTargetDiscoveryWorker(ctx context.Context) {
+	for {
+		key, quit := c.tdQueue.Get()
+		if quit {
+			return
+		}
+
+		if err := c.refreshTargetDiscovery(ctx, key); err != nil {
+			c.logger.Warn("failed to refresh the target discovery preview", "err", err, "key", key)
+		}
+
+		c.tdQueue.Done(key)
+	}
+}
+
+// refreshTargetDiscovery updates the target discovery preview of the
+// ServiceMonitor objects bound to the Prometheus object identified by key.
+//
+// It only reads from the informers' caches and patches the status of the
+// ServiceMonitor objects whose preview has changed. The ServiceMonitor
+// objects which aren't bound yet are handled by the next reconciliation.
+func (c *Operator) refreshTargetDiscovery(ctx context.Context, key string) error {
+	p, err := operator.GetObjectFromKey[*monitoringv1.Prometheus](c.promInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if p == nil || c.rr.DeletionInProgress(p) {
+		return nil
+	}
+
+	var (
+		configResourceSyncer = operator.NewConfigResourceSyncer(p, c.dclient, c.accessor)
+		tdp                  = prompkg.NewTargetDiscoveryPreviewer(p, c.svcInfs.ListAllByNamespace, c.epsInfs.ListAllByNamespace, c.podInfs.ListAllByNamespace)
+		smons                []*monitoringv1.ServiceMonitor
+	)
+	err = c.smonInfs.ListAll(labels.Everything(), func(obj any) {
+		sm := obj.(*monitoringv1.ServiceMonitor)
+		if configResourceSyncer.GetBindingIndex(sm.Bindings()) < 0 {
+			return
+		}
+
+		smons = append(smons, sm.DeepCopy())
+	})
+	if err != nil {
+		return fmt.Errorf("listing all ServiceMonitors from cache failed: %w", err)
+	}
+
+	for _, sm := range smons {
+		td, err := tdp.ServiceMonitor(sm)
+		if err != nil {
+			c.logger.Warn("failed to compute the target discovery preview", "err", err, "servicemonitor", sm.Namespace+"/"+sm.Name)
+			continue
+		}
+
+		if err := k8sutil.AddTypeInformationToObject(sm); err != nil {
+			return fmt.Errorf("failed to add type information: %w", err)
+		}
+
+		if err := configResourceSyncer.UpdateTargetDiscovery(ctx, sm, td); err != nil {
+			return fmt.Errorf("failed to update ServiceMonitor %s/%s status: %w", sm.Namespace, sm.Name, err)
+		}
+	}
+
+	return nil
+}
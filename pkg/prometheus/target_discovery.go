@@ -0,0 +1,349 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// TargetDiscoveryPreviewer computes a preview of the Kubernetes objects
+// matched by ServiceMonitor and PodMonitor objects for a Prometheus object.
+//
+// The preview is an approximation of the service discovery performed by
+// Prometheus: it doesn't take into account the relabeling rules.
+type TargetDiscoveryPreviewer struct {
+	p monitoringv1.PrometheusInterface
+
+	listServices       ListAllByNamespaceFn
+	listEndpointSlices ListAllByNamespaceFn
+	listPods           ListAllByNamespaceFn
+}
+
+// NewTargetDiscoveryPreviewer returns a TargetDiscoveryPreviewer listing the
+// Services, EndpointSlices and Pods with the given functions.
+func NewTargetDiscoveryPreviewer(
+	p monitoringv1.PrometheusInterface,
+	listServices ListAllByNamespaceFn,
+	listEndpointSlices ListAllByNamespaceFn,
+	listPods ListAllByNamespaceFn,
+) *TargetDiscoveryPreviewer {
+	return &TargetDiscoveryPreviewer{
+		p:                  p,
+		listServices:       listServices,
+		listEndpointSlices: listEndpointSlices,
+		listPods:           listPods,
+	}
+}
+
+func (tdp *TargetDiscoveryPreviewer) namespaces(nsel monitoringv1.NamespaceSelector, namespace string) []string {
+	switch {
+	case tdp.p.GetCommonPrometheusFields().IgnoreNamespaceSelectors:
+		return []string{namespace}
+	case nsel.Any:
+		return []string{metav1.NamespaceAll}
+	case len(nsel.MatchNames) == 0:
+		return []string{namespace}
+	}
+
+	return nsel.MatchNames
+}
+
+// ServiceMonitor returns the target discovery preview of the ServiceMonitor
+// object.
+func (tdp *TargetDiscoveryPreviewer) ServiceMonitor(sm *monitoringv1.ServiceMonitor) (*monitoringv1.TargetDiscoveryStatus, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&sm.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	var services []*v1.Service
+	for _, ns := range tdp.namespaces(sm.Spec.NamespaceSelector, sm.Namespace) {
+		if err := tdp.listServices(ns, selector, func(o any) {
+			if svc, ok := o.(*v1.Service); ok {
+				services = append(services, svc)
+			}
+		}); err != nil {
+			return nil, fmt.Errorf("failed to list services in namespace %q: %w", ns, err)
+		}
+	}
+
+	endpoints := make([]monitoringv1.EndpointDiscoveryStatus, len(sm.Spec.Endpoints))
+	for i := range endpoints {
+		endpoints[i].Index = int32(i)
+	}
+
+	var numSlices int32
+	for _, svc := range services {
+		var endpointSlices []*discoveryv1.EndpointSlice
+		if err := tdp.listEndpointSlices(
+			svc.Namespace,
+			labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: svc.Name}),
+			func(o any) {
+				if eps, ok := o.(*discoveryv1.EndpointSlice); ok {
+					endpointSlices = append(endpointSlices, eps)
+				}
+			},
+		); err != nil {
+			return nil, fmt.Errorf("failed to list endpointslices for service %s/%s: %w", svc.Namespace, svc.Name, err)
+		}
+		numSlices += int32(len(endpointSlices))
+
+		for i, ep := range sm.Spec.Endpoints {
+			for _, sp := range svc.Spec.Ports {
+				if !matchServicePort(ep, sp) {
+					continue
+				}
+
+				endpoints[i].MatchedPorts = appendPortName(endpoints[i].MatchedPorts, sp.Name, sp.Port)
+
+				for _, eps := range endpointSlices {
+					if !hasEndpointSlicePort(eps, sp.Name) {
+						continue
+					}
+
+					for _, e := range eps.Endpoints {
+						endpoints[i].Targets += int32(len(e.Addresses))
+					}
+				}
+			}
+		}
+	}
+
+	return &monitoringv1.TargetDiscoveryStatus{
+		Services:       ptr.To(int32(len(services))),
+		EndpointSlices: ptr.To(numSlices),
+		Endpoints:      sortedEndpointPorts(endpoints),
+	}, nil
+}
+
+// PodMonitor returns the target discovery preview of the PodMonitor object.
+func (tdp *TargetDiscoveryPreviewer) PodMonitor(pm *monitoringv1.PodMonitor) (*monitoringv1.TargetDiscoveryStatus, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&pm.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	var pods []*v1.Pod
+	for _, ns := range tdp.namespaces(pm.Spec.NamespaceSelector, pm.Namespace) {
+		if err := tdp.listPods(ns, selector, func(o any) {
+			if pod, ok := o.(*v1.Pod); ok {
+				pods = append(pods, pod)
+			}
+		}); err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace %q: %w", ns, err)
+		}
+	}
+
+	endpoints := make([]monitoringv1.EndpointDiscoveryStatus, len(pm.Spec.PodMetricsEndpoints))
+	for i, ep := range pm.Spec.PodMetricsEndpoints {
+		endpoints[i].Index = int32(i)
+
+		for _, pod := range pods {
+			// Prometheus doesn't generate targets for pods without IP address.
+			if pod.Status.PodIP == "" {
+				continue
+			}
+
+			if ptr.Deref(ep.FilterRunning, true) && (pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed) {
+				continue
+			}
+
+			for _, c := range slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers) {
+				for _, cp := range c.Ports {
+					if !matchContainerPort(ep, cp) {
+						continue
+					}
+
+					endpoints[i].MatchedPorts = appendPortName(endpoints[i].MatchedPorts, cp.Name, cp.ContainerPort)
+					endpoints[i].Targets++
+				}
+			}
+		}
+	}
+
+	return &monitoringv1.TargetDiscoveryStatus{
+		Pods:      ptr.To(int32(len(pods))),
+		Endpoints: sortedEndpointPorts(endpoints),
+	}, nil
+}
+
+// matchServicePort returns true if the Service port is selected by the
+// ServiceMonitor's endpoint.
+func matchServicePort(ep monitoringv1.Endpoint, sp v1.ServicePort) bool {
+	if ep.Port != "" {
+		return sp.Name == ep.Port
+	}
+
+	if ep.TargetPort == nil {
+		return true
+	}
+
+	// An empty target port defaults to the value of the port.
+	tp := sp.TargetPort
+	if tp.Type == intstr.Int && tp.IntVal == 0 {
+		tp = intstr.FromInt32(sp.Port)
+	}
+
+	switch {
+	case ep.TargetPort.StrVal != "":
+		return tp.Type == intstr.String && tp.StrVal == ep.TargetPort.StrVal
+	case ep.TargetPort.IntVal != 0:
+		return tp.Type == intstr.Int && tp.IntVal == ep.TargetPort.IntVal
+	}
+
+	return true
+}
+
+// matchContainerPort returns true if the container port is selected by the
+// PodMonitor's endpoint.
+func matchContainerPort(ep monitoringv1.PodMetricsEndpoint, cp v1.ContainerPort) bool {
+	if ptr.Deref(ep.Port, "") != "" {
+		return cp.Name == *ep.Port
+	}
+
+	if ptr.Deref(ep.PortNumber, 0) != 0 {
+		return cp.ContainerPort == *ep.PortNumber
+	}
+
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if tp := ep.TargetPort; tp != nil {
+		switch {
+		case tp.StrVal != "":
+			return cp.Name == tp.StrVal
+		case tp.IntVal != 0:
+			return cp.ContainerPort == tp.IntVal
+		}
+	}
+
+	return true
+}
+
+func hasEndpointSlicePort(eps *discoveryv1.EndpointSlice, name string) bool {
+	for _, p := range eps.Ports {
+		if ptr.Deref(p.Name, "") == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendPortName adds the port's name (or number if the port is unnamed) to
+// the list if it isn't present yet.
+func appendPortName(ports []string, name string, number int32) []string {
+	if name == "" {
+		name = strconv.Itoa(int(number))
+	}
+
+	if slices.Contains(ports, name) {
+		return ports
+	}
+
+	return append(ports, name)
+}
+
+func sortedEndpointPorts(endpoints []monitoringv1.EndpointDiscoveryStatus) []monitoringv1.EndpointDiscoveryStatus {
+	for i := range endpoints {
+		slices.Sort(endpoints[i].MatchedPorts)
+	}
+
+	return endpoints
+}
+
+// ServiceTargetDiscoveryChanged returns true if the Service update may modify
+// the target discovery preview of the ServiceMonitor objects, that is if the
+// labels or the ports of the Service have changed.
+func ServiceTargetDiscoveryChanged(old, cur *v1.Service) bool {
+	return !maps.Equal(old.Labels, cur.Labels) || !equality.Semantic.DeepEqual(old.Spec.Ports, cur.Spec.Ports)
+}
+
+// TargetDiscoveryStrip is a transform function for the Service, EndpointSlice
+// and Pod informers which keeps only the fields needed by the
+// TargetDiscoveryPreviewer.
+func TargetDiscoveryStrip(obj any) (any, error) {
+	switch o := obj.(type) {
+	case *v1.Service:
+		return &v1.Service{
+			ObjectMeta: stripObjectMeta(o.ObjectMeta),
+			Spec: v1.ServiceSpec{
+				Ports: o.Spec.Ports,
+			},
+		}, nil
+
+	case *discoveryv1.EndpointSlice:
+		eps := &discoveryv1.EndpointSlice{
+			ObjectMeta:  stripObjectMeta(o.ObjectMeta),
+			AddressType: o.AddressType,
+			Ports:       o.Ports,
+			Endpoints:   make([]discoveryv1.Endpoint, len(o.Endpoints)),
+		}
+		for i, e := range o.Endpoints {
+			eps.Endpoints[i] = discoveryv1.Endpoint{Addresses: e.Addresses}
+		}
+
+		return eps, nil
+
+	case *v1.Pod:
+		stripContainers := func(containers []v1.Container) []v1.Container {
+			ret := make([]v1.Container, 0, len(containers))
+			for _, c := range containers {
+				if len(c.Ports) == 0 {
+					continue
+				}
+
+				ret = append(ret, v1.Container{Name: c.Name, Ports: c.Ports})
+			}
+
+			return ret
+		}
+
+		return &v1.Pod{
+			ObjectMeta: stripObjectMeta(o.ObjectMeta),
+			Spec: v1.PodSpec{
+				Containers:     stripContainers(o.Spec.Containers),
+				InitContainers: stripContainers(o.Spec.InitContainers),
+			},
+			Status: v1.PodStatus{
+				Phase: o.Status.Phase,
+				PodIP: o.Status.PodIP,
+			},
+		}, nil
+	}
+
+	return obj, nil
+}
+
+func stripObjectMeta(m metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            m.Name,
+		Namespace:       m.Namespace,
+		UID:             m.UID,
+		ResourceVersion: m.ResourceVersion,
+		Labels:          m.Labels,
+	}
+}
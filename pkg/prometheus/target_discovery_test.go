@@ -0,0 +1,297 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func newListAllByNamespaceFn(t *testing.T, objs ...any) ListAllByNamespaceFn {
+	t.Helper()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, o := range objs {
+		obj, err := TargetDiscoveryStrip(o)
+		require.NoError(t, err)
+		require.NoError(t, indexer.Add(obj))
+	}
+
+	return func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error {
+		return cache.ListAllByNamespace(indexer, namespace, selector, appendFn)
+	}
+}
+
+func makeEndpointSlice(namespace, service string, ports []string, addresses ...string) *discoveryv1.EndpointSlice {
+	eps := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service + "-abcde",
+			Namespace: namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: service,
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+
+	for _, p := range ports {
+		eps.Ports = append(eps.Ports, discoveryv1.EndpointPort{Name: ptr.To(p)})
+	}
+
+	for _, a := range addresses {
+		eps.Endpoints = append(eps.Endpoints, discoveryv1.Endpoint{Addresses: []string{a}})
+	}
+
+	return eps
+}
+
+func TestServiceMonitorTargetDiscovery(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+	}
+
+	svcs := newListAllByNamespaceFn(t,
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "foo"},
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Name: "web", Port: 8080, TargetPort: intstr.FromString("http")},
+					{Name: "metrics", Port: 9090},
+				},
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: "other",
+				Labels:    map[string]string{"app": "foo"},
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Name: "metrics", Port: 9090},
+				},
+			},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unrelated",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "bar"},
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Name: "metrics", Port: 9090},
+				},
+			},
+		},
+	)
+
+	epss := newListAllByNamespaceFn(t,
+		makeEndpointSlice("default", "app", []string{"web", "metrics"}, "10.0.0.1", "10.0.0.2"),
+		makeEndpointSlice("other", "app", []string{"metrics"}, "10.0.1.1"),
+		makeEndpointSlice("default", "unrelated", []string{"metrics"}, "10.0.0.3"),
+	)
+
+	tdp := NewTargetDiscoveryPreviewer(p, svcs, epss, newListAllByNamespaceFn(t))
+
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.ServiceMonitorSpec
+		expected *monitoringv1.TargetDiscoveryStatus
+	}{
+		{
+			name: "port name",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				Endpoints: []monitoringv1.Endpoint{
+					{Port: "metrics"},
+					{Port: "invalid"},
+				},
+			},
+			expected: &monitoringv1.TargetDiscoveryStatus{
+				Services:       ptr.To(int32(1)),
+				EndpointSlices: ptr.To(int32(1)),
+				Endpoints: []monitoringv1.EndpointDiscoveryStatus{
+					{Index: 0, MatchedPorts: []string{"metrics"}, Targets: 2},
+					{Index: 1},
+				},
+			},
+		},
+		{
+			name: "target port and any namespace",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				NamespaceSelector: monitoringv1.NamespaceSelector{Any: true},
+				Endpoints: []monitoringv1.Endpoint{
+					{TargetPort: ptr.To(intstr.FromString("http"))},
+					{TargetPort: ptr.To(intstr.FromInt32(9090))},
+					{},
+				},
+			},
+			expected: &monitoringv1.TargetDiscoveryStatus{
+				Services:       ptr.To(int32(2)),
+				EndpointSlices: ptr.To(int32(2)),
+				Endpoints: []monitoringv1.EndpointDiscoveryStatus{
+					{Index: 0, MatchedPorts: []string{"web"}, Targets: 2},
+					{Index: 1, MatchedPorts: []string{"metrics"}, Targets: 3},
+					{Index: 2, MatchedPorts: []string{"metrics", "web"}, Targets: 5},
+				},
+			},
+		},
+		{
+			name: "no match",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{"monitoring"}},
+				Endpoints: []monitoringv1.Endpoint{
+					{Port: "metrics"},
+				},
+			},
+			expected: &monitoringv1.TargetDiscoveryStatus{
+				Services:       ptr.To(int32(0)),
+				EndpointSlices: ptr.To(int32(0)),
+				Endpoints: []monitoringv1.EndpointDiscoveryStatus{
+					{Index: 0},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			td, err := tdp.ServiceMonitor(&monitoringv1.ServiceMonitor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: tc.spec,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, td)
+		})
+	}
+}
+
+func TestPodMonitorTargetDiscovery(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "monitoring",
+		},
+	}
+
+	makePod := func(name string, phase v1.PodPhase, ip string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{"app": "foo"},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Name: "app",
+						Ports: []v1.ContainerPort{
+							{Name: "metrics", ContainerPort: 9090},
+							{ContainerPort: 8080},
+						},
+					},
+				},
+			},
+			Status: v1.PodStatus{
+				Phase: phase,
+				PodIP: ip,
+			},
+		}
+	}
+
+	pods := newListAllByNamespaceFn(t,
+		makePod("running", v1.PodRunning, "10.0.0.1"),
+		makePod("pending", v1.PodPending, ""),
+		makePod("completed", v1.PodSucceeded, "10.0.0.2"),
+	)
+
+	tdp := NewTargetDiscoveryPreviewer(p, newListAllByNamespaceFn(t), newListAllByNamespaceFn(t), pods)
+
+	td, err := tdp.PodMonitor(&monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{Port: ptr.To("metrics")},
+				{PortNumber: ptr.To(int32(8080))},
+				{FilterRunning: ptr.To(false)},
+				{Port: ptr.To("invalid")},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &monitoringv1.TargetDiscoveryStatus{
+		Pods: ptr.To(int32(3)),
+		Endpoints: []monitoringv1.EndpointDiscoveryStatus{
+			{Index: 0, MatchedPorts: []string{"metrics"}, Targets: 1},
+			{Index: 1, MatchedPorts: []string{"8080"}, Targets: 1},
+			{Index: 2, MatchedPorts: []string{"8080", "metrics"}, Targets: 4},
+			{Index: 3},
+		},
+	}, td)
+}
+
+func TestServiceTargetDiscoveryChanged(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "svc",
+			Namespace:       "default",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"app": "example"},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{{Name: "web", Port: 8080}},
+		},
+	}
+
+	// Only the resource version has changed.
+	cur := svc.DeepCopy()
+	cur.ResourceVersion = "2"
+	require.False(t, ServiceTargetDiscoveryChanged(svc, cur))
+
+	// The labels have changed.
+	cur = svc.DeepCopy()
+	cur.Labels["team"] = "a"
+	require.True(t, ServiceTargetDiscoveryChanged(svc, cur))
+
+	// The ports have changed.
+	cur = svc.DeepCopy()
+	cur.Spec.Ports[0].Name = "metrics"
+	require.True(t, ServiceTargetDiscoveryChanged(svc, cur))
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/thanos/workload"
+)
+
+const applicationNameLabelValue = "thanos-compactor"
+
+// Operator manages the life cycle of the Thanos Compact statefulsets.
+type Operator = workload.Controller[*monitoringv1alpha1.ThanosCompactor]
+
+// Config defines the operator's parameters for the ThanosCompactor controller.
+type Config = workload.Config
+
+type ControllerOption = workload.ControllerOption
+
+// WithStorageClassValidation tells that the controller should verify that the
+// ThanosCompactor spec references a valid StorageClass name.
+func WithStorageClassValidation() ControllerOption {
+	return workload.WithStorageClassValidation()
+}
+
+// New creates a new controller.
+func New(restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
+	return workload.New(
+		workload.Component[*monitoringv1alpha1.ThanosCompactor]{
+			Name:            applicationNameLabelValue,
+			Kind:            monitoringv1alpha1.ThanosCompactorKind,
+			Resource:        monitoringv1alpha1.ThanosCompactorName,
+			StatefulSetName: prefixedName,
+			MakeStatefulSet: makeStatefulSet,
+			MakeService:     makeStatefulSetService,
+			ApplyStatus:     applyStatus,
+		},
+		restConfig,
+		c,
+		logger,
+		r,
+		options...,
+	)
+}
+
+func applyStatus(ctx context.Context, mclient monitoringclient.Interface, tc *monitoringv1alpha1.ThanosCompactor, status *monitoringv1alpha1ac.ThanosWorkloadStatusApplyConfiguration) error {
+	_, err := mclient.MonitoringV1alpha1().ThanosCompactors(tc.Namespace).ApplyStatus(
+		ctx,
+		monitoringv1alpha1ac.ThanosCompactor(tc.Name, tc.Namespace).WithStatus(status),
+		metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true},
+	)
+
+	return err
+}
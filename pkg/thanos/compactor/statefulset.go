@@ -0,0 +1,362 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	containerName        = "thanos-compactor"
+	configDir            = "/etc/thanos/config"
+	storageDir           = "/thanos/data"
+	governingServiceName = "thanos-compactor-operated"
+	httpPort             = 10902
+
+	defaultTerminationGracePeriodSeconds = int64(120)
+)
+
+func makeStatefulSet(tc *monitoringv1alpha1.ThanosCompactor, config Config, inputHash string) (*appsv1.StatefulSet, error) {
+	spec, err := makeStatefulSetSpec(tc, config)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulset := &appsv1.StatefulSet{Spec: *spec}
+	operator.UpdateObject(
+		statefulset,
+		operator.WithName(prefixedName(tc.Name)),
+		operator.WithAnnotations(tc.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithLabels(tc.GetLabels()),
+		operator.WithSelectorLabels(spec.Selector),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tc),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	if len(tc.Spec.ImagePullSecrets) > 0 {
+		statefulset.Spec.Template.Spec.ImagePullSecrets = tc.Spec.ImagePullSecrets
+	}
+
+	storageSpec := tc.Spec.Storage
+	switch {
+	case storageSpec == nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+
+	case storageSpec.EmptyDir != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: storageSpec.EmptyDir,
+			},
+		})
+
+	case storageSpec.Ephemeral != nil:
+		statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, v1.Volume{
+			Name: volumeName(tc.Name),
+			VolumeSource: v1.VolumeSource{
+				Ephemeral: storageSpec.Ephemeral,
+			},
+		})
+
+	default: // storageSpec.VolumeClaimTemplate
+		pvcTemplate := operator.MakeVolumeClaimTemplate(storageSpec.VolumeClaimTemplate)
+		if pvcTemplate.Name == "" {
+			pvcTemplate.Name = volumeName(tc.Name)
+		}
+		if storageSpec.VolumeClaimTemplate.Spec.AccessModes == nil {
+			pvcTemplate.Spec.AccessModes = []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+		} else {
+			pvcTemplate.Spec.AccessModes = storageSpec.VolumeClaimTemplate.Spec.AccessModes
+		}
+		pvcTemplate.Spec.Resources = storageSpec.VolumeClaimTemplate.Spec.Resources
+		pvcTemplate.Spec.Selector = storageSpec.VolumeClaimTemplate.Spec.Selector
+		statefulset.Spec.VolumeClaimTemplates = append(statefulset.Spec.VolumeClaimTemplates, *pvcTemplate)
+	}
+
+	statefulset.Spec.Template.Spec.Volumes = append(statefulset.Spec.Template.Spec.Volumes, tc.Spec.Volumes...)
+
+	return statefulset, nil
+}
+
+func makeStatefulSetSpec(tc *monitoringv1alpha1.ThanosCompactor, config Config) (*appsv1.StatefulSetSpec, error) {
+	if tc.Spec.ObjectStorageConfigFile == nil && tc.Spec.ObjectStorageConfig == nil {
+		return nil, errors.New(tc.GetName() + ": thanos compactor requires objectStorageConfig or objectStorageConfigFile to be specified")
+	}
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(tc.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		operator.StringValOrDefault(ptr.Deref(tc.Spec.Version, ""), operator.DefaultThanosVersion),
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	cliArgs := []monitoringv1.Argument{
+		{Name: "data-dir", Value: storageDir},
+		{Name: "http-address", Value: fmt.Sprintf(":%d", httpPort)},
+		// Keep the process running after the compaction iteration is done.
+		{Name: "wait"},
+	}
+
+	if tc.Spec.LogLevel != "" && tc.Spec.LogLevel != "info" {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "log.level", Value: tc.Spec.LogLevel})
+	}
+	if tc.Spec.LogFormat != "" {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "log.format", Value: tc.Spec.LogFormat})
+	}
+
+	var (
+		volumes      []v1.Volume
+		volumeMounts []v1.VolumeMount
+		fullPath     string
+	)
+	if tc.Spec.ObjectStorageConfigFile != nil {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: *tc.Spec.ObjectStorageConfigFile})
+	} else {
+		volumes, volumeMounts, fullPath = mountSecretKey(volumes, volumeMounts, tc.Spec.ObjectStorageConfig, "objstorage-config")
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "objstore.config-file", Value: fullPath})
+	}
+
+	for _, r := range []struct {
+		flag  string
+		value *monitoringv1.Duration
+	}{
+		{"retention.resolution-raw", tc.Spec.RetentionResolutionRaw},
+		{"retention.resolution-5m", tc.Spec.RetentionResolution5m},
+		{"retention.resolution-1h", tc.Spec.RetentionResolution1h},
+	} {
+		if r.value != nil {
+			cliArgs = append(cliArgs, monitoringv1.Argument{Name: r.flag, Value: string(*r.value)})
+		}
+	}
+
+	if ptr.Deref(tc.Spec.DownsamplingDisabled, false) {
+		cliArgs = append(cliArgs, monitoringv1.Argument{Name: "downsampling.disable"})
+	}
+
+	containerArgs, err := operator.BuildArgs(cliArgs, tc.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "compact" to start the compactor, e.g. "thanos compact --data-dir..."
+	containerArgs = append([]string{"compact"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if tc.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, tc.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, tc.Spec.PodMetadata.Annotations)
+	}
+	selectorLabels := makeSelectorLabels(tc.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations[operator.DefaultContainerAnnotationKey] = containerName
+
+	storageVolName := volumeName(tc.Name)
+	if tc.Spec.Storage != nil && tc.Spec.Storage.VolumeClaimTemplate.Name != "" {
+		storageVolName = tc.Spec.Storage.VolumeClaimTemplate.Name
+	}
+	volumeMounts = append(volumeMounts, v1.VolumeMount{
+		Name:      storageVolName,
+		MountPath: storageDir,
+	})
+	volumeMounts = append(volumeMounts, tc.Spec.VolumeMounts...)
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            containerName,
+			Image:           imagePath,
+			ImagePullPolicy: tc.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			VolumeMounts:    volumeMounts,
+			Resources:       tc.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          "web",
+					ContainerPort: httpPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			LivenessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{
+						Path: "/-/healthy",
+						Port: intstr.FromString("web"),
+					},
+				},
+			},
+			ReadinessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{
+						Path: "/-/ready",
+						Port: intstr.FromString("web"),
+					},
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, tc.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	spec := appsv1.StatefulSetSpec{
+		ServiceName: governingServiceName,
+		// Running more than one compactor against the same bucket leads to
+		// overlapping blocks.
+		Replicas: ptr.To(int32(1)),
+		UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		},
+		Selector: &metav1.LabelSelector{
+			MatchLabels: selectorLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:                  tc.Spec.NodeSelector,
+				PriorityClassName:             tc.Spec.PriorityClassName,
+				ServiceAccountName:            tc.Spec.ServiceAccountName,
+				TerminationGracePeriodSeconds: ptr.To(ptr.Deref(tc.Spec.TerminationGracePeriodSeconds, defaultTerminationGracePeriodSeconds)),
+				Containers:                    containers,
+				Volumes:                       volumes,
+				SecurityContext:               tc.Spec.SecurityContext,
+				Tolerations:                   tc.Spec.Tolerations,
+				Affinity:                      tc.Spec.Affinity,
+				TopologySpreadConstraints:     tc.Spec.TopologySpreadConstraints,
+			},
+		},
+	}
+
+	return &spec, nil
+}
+
+func makeStatefulSetService(tc *monitoringv1alpha1.ThanosCompactor, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []v1.ServicePort{
+				{
+					Name:       "web",
+					Port:       httpPort,
+					TargetPort: intstr.FromString("web"),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: map[string]string{
+				operator.ApplicationNameLabelKey: applicationNameLabelValue,
+			},
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(governingServiceName),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(map[string]string{"operated-thanos-compactor": "true"}),
+		operator.WithLabels(config.Labels),
+		operator.WithOwner(tc),
+	)
+
+	return svc
+}
+
+// In cases where an existing selector label is modified, or a new one is added, new sts cannot match existing pods.
+// We should try to avoid removing such immutable fields whenever possible since doing
+// so forces us to enter the 'recreate cycle' and can potentially lead to downtime.
+// The requirement to make a change here should be carefully evaluated.
+func makeSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		operator.ApplicationNameLabelKey:     applicationNameLabelValue,
+		operator.ManagedByLabelKey:           operator.ManagedByLabelValue,
+		operator.ApplicationInstanceLabelKey: name,
+		"thanos-compactor":                   name,
+	}
+}
+
+func prefixedName(name string) string {
+	return fmt.Sprintf("thanos-compactor-%s", name)
+}
+
+func volumeName(name string) string {
+	return fmt.Sprintf("%s-data", prefixedName(name))
+}
+
+// mountSecretKey adds the secret key to the mounted volumes and returns the
+// full path of the file on disk.
+func mountSecretKey(vols []v1.Volume, vmounts []v1.VolumeMount, secretSelector *v1.SecretKeySelector, volumeName string) ([]v1.Volume, []v1.VolumeMount, string) {
+	mountpath := filepath.Join(configDir, volumeName)
+
+	return append(vols, v1.Volume{
+			Name: volumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: secretSelector.Name,
+					Items: []v1.KeyToPath{
+						{
+							Key:  secretSelector.Key,
+							Path: secretSelector.Key,
+						},
+					},
+				},
+			},
+		}),
+		append(vmounts, v1.VolumeMount{
+			Name:      volumeName,
+			MountPath: mountpath,
+			ReadOnly:  true,
+		}),
+		filepath.Join(mountpath, secretSelector.Key)
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var (
+	defaultTestConfig = Config{
+		ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+	}
+
+	objStorageConfig = &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "thanos-objstore"},
+		Key:                  "config.yaml",
+	}
+)
+
+func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.ThanosCompactorKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			Labels:    map[string]string{"testlabel": "testlabelvalue"},
+			Annotations: map[string]string{
+				"testannotation": "testannotationvalue",
+				"kubectl.kubernetes.io/last-applied-configuration": "something",
+			},
+		},
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+				ObjectStorageConfig: objStorageConfig,
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(tc, defaultTestConfig, "hash")
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-compactor-test", sset.Name)
+	require.Equal(t, map[string]string{
+		"testlabel":                    "testlabelvalue",
+		"managed-by":                   "prometheus-operator",
+		"thanos-compactor":             "test",
+		"app.kubernetes.io/instance":   "test",
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/name":       "thanos-compactor",
+	}, sset.Labels)
+	require.Equal(t, map[string]string{
+		"testannotation":                "testannotationvalue",
+		operator.InputHashAnnotationKey: "hash",
+	}, sset.Annotations)
+
+	require.Equal(t, int32(1), *sset.Spec.Replicas)
+	require.Equal(t, governingServiceName, sset.Spec.ServiceName)
+
+	require.Len(t, sset.OwnerReferences, 1)
+	require.Equal(t, monitoringv1alpha1.ThanosCompactorKind, sset.OwnerReferences[0].Kind)
+}
+
+func TestStatefulSetArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1alpha1.ThanosCompactorSpec
+		expected []string
+		err      bool
+	}{
+		{
+			name: "no object storage configuration",
+			err:  true,
+		},
+		{
+			name: "object storage secret",
+			spec: monitoringv1alpha1.ThanosCompactorSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfig: objStorageConfig,
+				},
+			},
+			expected: []string{
+				"compact",
+				"--data-dir=/thanos/data",
+				"--http-address=:10902",
+				"--wait",
+				"--objstore.config-file=/etc/thanos/config/objstorage-config/config.yaml",
+			},
+		},
+		{
+			name: "object storage file takes precedence",
+			spec: monitoringv1alpha1.ThanosCompactorSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfig:     objStorageConfig,
+					ObjectStorageConfigFile: ptr.To("/etc/objstore/config.yaml"),
+					LogLevel:                "debug",
+					LogFormat:               "json",
+				},
+			},
+			expected: []string{
+				"compact",
+				"--data-dir=/thanos/data",
+				"--http-address=:10902",
+				"--wait",
+				"--log.level=debug",
+				"--log.format=json",
+				"--objstore.config-file=/etc/objstore/config.yaml",
+			},
+		},
+		{
+			name: "retention and downsampling",
+			spec: monitoringv1alpha1.ThanosCompactorSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfigFile: ptr.To("/etc/objstore/config.yaml"),
+				},
+				RetentionResolutionRaw: ptr.To(monitoringv1.Duration("30d")),
+				RetentionResolution5m:  ptr.To(monitoringv1.Duration("90d")),
+				RetentionResolution1h:  ptr.To(monitoringv1.Duration("0d")),
+				DownsamplingDisabled:   ptr.To(true),
+			},
+			expected: []string{
+				"compact",
+				"--data-dir=/thanos/data",
+				"--http-address=:10902",
+				"--wait",
+				"--objstore.config-file=/etc/objstore/config.yaml",
+				"--retention.resolution-raw=30d",
+				"--retention.resolution-5m=90d",
+				"--retention.resolution-1h=0d",
+				"--downsampling.disable",
+			},
+		},
+		{
+			name: "with conflicting additional args",
+			spec: monitoringv1alpha1.ThanosCompactorSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfigFile: ptr.To("/etc/objstore/config.yaml"),
+					AdditionalArgs: []monitoringv1.Argument{
+						{Name: "data-dir", Value: "/tmp"},
+					},
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc2 := &monitoringv1alpha1.ThanosCompactor{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				Spec:       tc.spec,
+			}
+
+			sset, err := makeStatefulSet(tc2, defaultTestConfig, "")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, sset.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestStatefulSetStorage(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+				ObjectStorageConfig: objStorageConfig,
+			},
+		},
+	}
+
+	sset, err := makeStatefulSet(tc, defaultTestConfig, "")
+	require.NoError(t, err)
+	require.Empty(t, sset.Spec.VolumeClaimTemplates)
+	require.Equal(t, []v1.Volume{
+		{
+			Name: "objstorage-config",
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: "thanos-objstore",
+					Items:      []v1.KeyToPath{{Key: "config.yaml", Path: "config.yaml"}},
+				},
+			},
+		},
+		{
+			Name: "thanos-compactor-test-data",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		},
+	}, sset.Spec.Template.Spec.Volumes)
+
+	tc.Spec.Storage = &monitoringv1.StorageSpec{
+		VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+			EmbeddedObjectMetadata: monitoringv1.EmbeddedObjectMetadata{
+				Name: "data",
+			},
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("10Gi"),
+					},
+				},
+			},
+		},
+	}
+
+	sset, err = makeStatefulSet(tc, defaultTestConfig, "")
+	require.NoError(t, err)
+	require.Len(t, sset.Spec.VolumeClaimTemplates, 1)
+	require.Equal(t, "data", sset.Spec.VolumeClaimTemplates[0].Name)
+	require.Equal(t, []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}, sset.Spec.VolumeClaimTemplates[0].Spec.AccessModes)
+	require.Contains(t, sset.Spec.Template.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+		Name:      "data",
+		MountPath: storageDir,
+	})
+}
+
+func TestStatefulSetService(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+	}
+
+	svc := makeStatefulSetService(tc, defaultTestConfig)
+	require.Equal(t, governingServiceName, svc.Name)
+	require.Equal(t, "None", svc.Spec.ClusterIP)
+	require.Len(t, svc.Spec.Ports, 1)
+	require.Equal(t, "web", svc.Spec.Ports[0].Name)
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"fmt"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	containerName = "thanos-querier"
+	grpcPortName  = "grpc"
+	grpcPort      = 10901
+	httpPortName  = "web"
+	httpPort      = 10902
+)
+
+var defaultReplicaLabels = []string{"prometheus_replica", "thanos_ruler_replica"}
+
+func makeDeployment(tq *monitoringv1alpha1.ThanosQuerier, config Config, endpoints []string, inputHash string) (*appsv1.Deployment, error) {
+	spec, err := makeDeploymentSpec(tq, config, endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &appsv1.Deployment{Spec: *spec}
+	operator.UpdateObject(
+		deployment,
+		operator.WithName(prefixedName(tq.Name)),
+		operator.WithAnnotations(tq.GetAnnotations()),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithInputHashAnnotation(inputHash),
+		operator.WithLabels(tq.GetLabels()),
+		operator.WithSelectorLabels(spec.Selector),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tq),
+		operator.WithoutKubectlAnnotations(),
+	)
+
+	if len(tq.Spec.ImagePullSecrets) > 0 {
+		deployment.Spec.Template.Spec.ImagePullSecrets = tq.Spec.ImagePullSecrets
+	}
+
+	return deployment, nil
+}
+
+func makeDeploymentSpec(tq *monitoringv1alpha1.ThanosQuerier, config Config, endpoints []string) (*appsv1.DeploymentSpec, error) {
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tq.Spec.Version, ""), operator.DefaultThanosVersion)
+
+	imagePath, err := operator.BuildImagePath(
+		ptr.Deref(tq.Spec.Image, ""),
+		operator.StringValOrDefault(config.ThanosDefaultBaseImage, operator.DefaultThanosBaseImage),
+		thanosVersion,
+		"",
+		"",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image path: %w", err)
+	}
+
+	containerArgs, err := operator.BuildArgs(makeArgs(tq, endpoints), tq.Spec.AdditionalArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first argument to thanos must be "query" to start thanos query, e.g. "thanos query --grpc-address..."
+	containerArgs = append([]string{"query"}, containerArgs...)
+
+	podAnnotations := map[string]string{}
+	podLabels := map[string]string{}
+	if tq.Spec.PodMetadata != nil {
+		maps.Copy(podLabels, tq.Spec.PodMetadata.Labels)
+		maps.Copy(podAnnotations, tq.Spec.PodMetadata.Annotations)
+	}
+
+	selectorLabels := makeSelectorLabels(tq.Name)
+
+	finalLabels := config.Labels.Merge(podLabels)
+	maps.Copy(finalLabels, selectorLabels)
+
+	podAnnotations[operator.DefaultContainerAnnotationKey] = containerName
+
+	operatorContainers := []v1.Container{
+		{
+			Name:            containerName,
+			Image:           imagePath,
+			ImagePullPolicy: tq.Spec.ImagePullPolicy,
+			Args:            containerArgs,
+			Resources:       tq.Spec.Resources,
+			Ports: []v1.ContainerPort{
+				{
+					Name:          grpcPortName,
+					ContainerPort: grpcPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+				{
+					Name:          httpPortName,
+					ContainerPort: httpPort,
+					Protocol:      v1.ProtocolTCP,
+				},
+			},
+			ReadinessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{
+						Path: "/-/ready",
+						Port: intstr.FromString(httpPortName),
+					},
+				},
+			},
+			LivenessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{
+						Path: "/-/healthy",
+						Port: intstr.FromString(httpPortName),
+					},
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			SecurityContext: &v1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				Capabilities: &v1.Capabilities{
+					Drop: []v1.Capability{"ALL"},
+				},
+			},
+		},
+	}
+
+	containers, err := k8sutil.MergePatchContainers(operatorContainers, tq.Spec.Containers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec: %w", err)
+	}
+
+	return &appsv1.DeploymentSpec{
+		Replicas:        tq.Spec.Replicas,
+		MinReadySeconds: ptr.Deref(tq.Spec.MinReadySeconds, 0),
+		Selector: &metav1.LabelSelector{
+			MatchLabels: selectorLabels,
+		},
+		Template: v1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      finalLabels,
+				Annotations: podAnnotations,
+			},
+			Spec: v1.PodSpec{
+				NodeSelector:              tq.Spec.NodeSelector,
+				PriorityClassName:         tq.Spec.PriorityClassName,
+				ServiceAccountName:        tq.Spec.ServiceAccountName,
+				Containers:                containers,
+				SecurityContext:           tq.Spec.SecurityContext,
+				Tolerations:               tq.Spec.Tolerations,
+				Affinity:                  tq.Spec.Affinity,
+				TopologySpreadConstraints: tq.Spec.TopologySpreadConstraints,
+			},
+		},
+	}, nil
+}
+
+func makeArgs(tq *monitoringv1alpha1.ThanosQuerier, endpoints []string) []monitoringv1.Argument {
+	args := []monitoringv1.Argument{
+		{Name: "grpc-address", Value: fmt.Sprintf(":%d", grpcPort)},
+		{Name: "http-address", Value: fmt.Sprintf(":%d", httpPort)},
+	}
+
+	if tq.Spec.LogLevel != "" && tq.Spec.LogLevel != "info" {
+		args = append(args, monitoringv1.Argument{Name: "log.level", Value: tq.Spec.LogLevel})
+	}
+	if tq.Spec.LogFormat != "" {
+		args = append(args, monitoringv1.Argument{Name: "log.format", Value: tq.Spec.LogFormat})
+	}
+
+	replicaLabels := tq.Spec.ReplicaLabels
+	if len(replicaLabels) == 0 {
+		replicaLabels = defaultReplicaLabels
+	}
+	for _, l := range replicaLabels {
+		args = append(args, monitoringv1.Argument{Name: "query.replica-label", Value: l})
+	}
+
+	for _, ep := range endpoints {
+		args = append(args, monitoringv1.Argument{Name: "endpoint", Value: ep})
+	}
+
+	return args
+}
+
+func makeService(tq *monitoringv1alpha1.ThanosQuerier, config Config) *v1.Service {
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name:       grpcPortName,
+					Port:       grpcPort,
+					TargetPort: intstr.FromString(grpcPortName),
+					Protocol:   v1.ProtocolTCP,
+				},
+				{
+					Name:       httpPortName,
+					Port:       httpPort,
+					TargetPort: intstr.FromString(httpPortName),
+					Protocol:   v1.ProtocolTCP,
+				},
+			},
+			Selector: makeSelectorLabels(tq.Name),
+		},
+	}
+
+	operator.UpdateObject(
+		svc,
+		operator.WithName(prefixedName(tq.Name)),
+		operator.WithAnnotations(config.Annotations),
+		operator.WithLabels(makeSelectorLabels(tq.Name)),
+		operator.WithLabels(config.Labels),
+		operator.WithManagingOwner(tq),
+	)
+
+	return svc
+}
+
+// makeSelectorLabels returns the labels used to select the ThanosQuerier pods.
+// Changing them would require to recreate the deployment since the selector
+// is immutable.
+func makeSelectorLabels(name string) map[string]string {
+	return map[string]string{
+		operator.ApplicationNameLabelKey:     applicationNameLabelValue,
+		operator.ManagedByLabelKey:           operator.ManagedByLabelValue,
+		operator.ApplicationInstanceLabelKey: name,
+		"thanos-querier":                     name,
+	}
+}
+
+func prefixedName(name string) string {
+	return fmt.Sprintf("thanos-querier-%s", name)
+}
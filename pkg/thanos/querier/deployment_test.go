@@ -0,0 +1,223 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var defaultTestConfig = Config{
+	ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+}
+
+func TestDeploymentLabelingAndAnnotations(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuerier{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.ThanosQuerierKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			Labels:    map[string]string{"testlabel": "testlabelvalue"},
+			Annotations: map[string]string{
+				"testannotation": "testannotationvalue",
+				"kubectl.kubernetes.io/last-applied-configuration": "something",
+			},
+		},
+		Spec: monitoringv1alpha1.ThanosQuerierSpec{
+			PodMetadata: &monitoringv1.EmbeddedObjectMetadata{
+				Labels: map[string]string{"podlabel": "value"},
+			},
+		},
+	}
+
+	d, err := makeDeployment(tq, defaultTestConfig, nil, "hash")
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-querier-test", d.Name)
+	require.Equal(t, map[string]string{
+		"testlabel":                    "testlabelvalue",
+		"managed-by":                   "prometheus-operator",
+		"thanos-querier":               "test",
+		"app.kubernetes.io/instance":   "test",
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/name":       "thanos-querier",
+	}, d.Labels)
+	require.Equal(t, map[string]string{
+		"testannotation":                "testannotationvalue",
+		operator.InputHashAnnotationKey: "hash",
+	}, d.Annotations)
+
+	require.Equal(t, "value", d.Spec.Template.Labels["podlabel"])
+	require.Equal(t, "thanos-querier", d.Spec.Template.Annotations[operator.DefaultContainerAnnotationKey])
+	require.NotContains(t, d.Spec.Selector.MatchLabels, "podlabel")
+
+	require.Len(t, d.OwnerReferences, 1)
+	require.Equal(t, monitoringv1alpha1.ThanosQuerierKind, d.OwnerReferences[0].Kind)
+	require.True(t, ptr.Deref(d.OwnerReferences[0].Controller, false))
+}
+
+func TestDeploymentArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		spec      monitoringv1alpha1.ThanosQuerierSpec
+		endpoints []string
+		expected  []string
+		err       bool
+	}{
+		{
+			name: "default",
+			expected: []string{
+				"query",
+				"--grpc-address=:10901",
+				"--http-address=:10902",
+				"--query.replica-label=prometheus_replica",
+				"--query.replica-label=thanos_ruler_replica",
+			},
+		},
+		{
+			name: "with endpoints and custom replica labels",
+			spec: monitoringv1alpha1.ThanosQuerierSpec{
+				ReplicaLabels: []string{"replica"},
+				LogLevel:      "debug",
+				LogFormat:     "json",
+			},
+			endpoints: []string{
+				"dnssrv+_grpc._tcp.prometheus-operated.default.svc",
+				"thanos-store:10901",
+			},
+			expected: []string{
+				"query",
+				"--grpc-address=:10901",
+				"--http-address=:10902",
+				"--log.level=debug",
+				"--log.format=json",
+				"--query.replica-label=replica",
+				"--endpoint=dnssrv+_grpc._tcp.prometheus-operated.default.svc",
+				"--endpoint=thanos-store:10901",
+			},
+		},
+		{
+			name: "with additional args",
+			spec: monitoringv1alpha1.ThanosQuerierSpec{
+				AdditionalArgs: []monitoringv1.Argument{
+					{Name: "query.auto-downsampling"},
+				},
+			},
+			expected: []string{
+				"query",
+				"--grpc-address=:10901",
+				"--http-address=:10902",
+				"--query.replica-label=prometheus_replica",
+				"--query.replica-label=thanos_ruler_replica",
+				"--query.auto-downsampling",
+			},
+		},
+		{
+			name: "with conflicting additional args",
+			spec: monitoringv1alpha1.ThanosQuerierSpec{
+				AdditionalArgs: []monitoringv1.Argument{
+					{Name: "grpc-address", Value: ":9090"},
+				},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tq := &monitoringv1alpha1.ThanosQuerier{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				Spec:       tc.spec,
+			}
+
+			d, err := makeDeployment(tq, defaultTestConfig, tc.endpoints, "")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, d.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, d.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestDeploymentImage(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuerier{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Spec: monitoringv1alpha1.ThanosQuerierSpec{
+			Version: ptr.To("v0.39.0"),
+		},
+	}
+
+	d, err := makeDeployment(tq, Config{ThanosDefaultBaseImage: "nondefaultuseflag/quay.io/thanos/thanos"}, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, "nondefaultuseflag/quay.io/thanos/thanos:v0.39.0", d.Spec.Template.Spec.Containers[0].Image)
+
+	tq.Spec.Image = ptr.To("quay.io/thanos/thanos:main")
+	d, err = makeDeployment(tq, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, "quay.io/thanos/thanos:main", d.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestDeploymentAdditionalContainers(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuerier{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Spec: monitoringv1alpha1.ThanosQuerierSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "thanos-querier",
+					Image: "custom-image",
+				},
+				{
+					Name: "oauth-proxy",
+				},
+			},
+		},
+	}
+
+	d, err := makeDeployment(tq, defaultTestConfig, nil, "")
+	require.NoError(t, err)
+
+	containers := d.Spec.Template.Spec.Containers
+	require.Len(t, containers, 2)
+	require.Equal(t, "custom-image", containers[0].Image)
+	require.Equal(t, "oauth-proxy", containers[1].Name)
+}
+
+func TestService(t *testing.T) {
+	tq := &monitoringv1alpha1.ThanosQuerier{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+	}
+
+	svc := makeService(tq, defaultTestConfig)
+	require.Equal(t, "thanos-querier-test", svc.Name)
+	require.Equal(t, makeSelectorLabels("test"), svc.Spec.Selector)
+	require.Len(t, svc.Spec.Ports, 2)
+	require.Equal(t, "grpc", svc.Spec.Ports[0].Name)
+	require.Equal(t, int32(10901), svc.Spec.Ports[0].Port)
+	require.Equal(t, "web", svc.Spec.Ports[1].Name)
+	require.Equal(t, int32(10902), svc.Spec.Ports[1].Port)
+}
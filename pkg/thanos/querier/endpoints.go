@@ -0,0 +1,118 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	prometheusGoverningServiceName  = "prometheus-operated"
+	thanosRulerGoverningServiceName = "thanos-ruler-operated"
+)
+
+// selectEndpoints returns the sorted list of StoreAPI endpoints for the
+// ThanosQuerier object.
+func (o *Operator) selectEndpoints(tq *monitoringv1alpha1.ThanosQuerier) ([]string, error) {
+	var (
+		prometheuses []*monitoringv1.Prometheus
+		thanosRulers []*monitoringv1.ThanosRuler
+	)
+
+	err := selectObjects(tq, tq.Spec.PrometheusSelector, tq.Spec.PrometheusNamespaceSelector, o.nsPromInf, o.promInfs, func(obj any) {
+		prometheuses = append(prometheuses, obj.(*monitoringv1.Prometheus))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to select Prometheus objects: %w", err)
+	}
+
+	err = selectObjects(tq, tq.Spec.ThanosRulerSelector, tq.Spec.ThanosRulerNamespaceSelector, o.nsThanosRulerInf, o.thanosRulerInfs, func(obj any) {
+		thanosRulers = append(thanosRulers, obj.(*monitoringv1.ThanosRuler))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to select ThanosRuler objects: %w", err)
+	}
+
+	return makeEndpoints(prometheuses, thanosRulers, tq.Spec.Endpoints, o.config.ClusterDomain), nil
+}
+
+// selectObjects calls appendFn for every object matching the label selector
+// in the namespaces matching the namespace selector. A nil label selector
+// selects no object.
+func selectObjects(tq *monitoringv1alpha1.ThanosQuerier, sel, nsSel *metav1.LabelSelector, nsInf cache.SharedIndexInformer, infs *informers.ForResource, appendFn cache.AppendFunc) error {
+	if sel == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return fmt.Errorf("failed to convert label selector: %w", err)
+	}
+
+	namespaces, err := operator.SelectNamespacesFromCache(tq, nsSel, nsInf)
+	if err != nil {
+		return err
+	}
+
+	for _, ns := range namespaces {
+		if err := infs.ListAllByNamespace(ns, selector, appendFn); err != nil {
+			return fmt.Errorf("failed to list objects in namespace %s: %w", ns, err)
+		}
+	}
+
+	return nil
+}
+
+// makeEndpoints returns the deduplicated and sorted list of StoreAPI
+// endpoints. The Prometheus and ThanosRuler endpoints use DNS SRV discovery
+// on the gRPC port of their governing service.
+func makeEndpoints(prometheuses []*monitoringv1.Prometheus, thanosRulers []*monitoringv1.ThanosRuler, static []string, clusterDomain string) []string {
+	endpoints := slices.Clone(static)
+
+	for _, p := range prometheuses {
+		// Only Prometheus instances running the Thanos sidecar expose the StoreAPI.
+		if p.Spec.Thanos == nil {
+			continue
+		}
+
+		endpoints = append(endpoints, dnsSRVEndpoint(ptr.Deref(p.Spec.ServiceName, prometheusGoverningServiceName), p.Namespace, clusterDomain))
+	}
+
+	for _, tr := range thanosRulers {
+		endpoints = append(endpoints, dnsSRVEndpoint(ptr.Deref(tr.Spec.ServiceName, thanosRulerGoverningServiceName), tr.Namespace, clusterDomain))
+	}
+
+	slices.Sort(endpoints)
+	return slices.Compact(endpoints)
+}
+
+func dnsSRVEndpoint(svc, ns, clusterDomain string) string {
+	host := fmt.Sprintf("%s.%s.svc", svc, ns)
+	if clusterDomain != "" {
+		host = fmt.Sprintf("%s.%s.", host, clusterDomain)
+	}
+
+	return fmt.Sprintf("dnssrv+_%s._tcp.%s", grpcPortName, host)
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestMakeEndpoints(t *testing.T) {
+	prometheus := func(ns, name string, thanos bool, svc *string) *monitoringv1.Prometheus {
+		p := &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		}
+		p.Spec.ServiceName = svc
+		if thanos {
+			p.Spec.Thanos = &monitoringv1.ThanosSpec{}
+		}
+		return p
+	}
+
+	thanosRuler := func(ns, name string, svc *string) *monitoringv1.ThanosRuler {
+		return &monitoringv1.ThanosRuler{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       monitoringv1.ThanosRulerSpec{ServiceName: svc},
+		}
+	}
+
+	for _, tc := range []struct {
+		name          string
+		prometheuses  []*monitoringv1.Prometheus
+		thanosRulers  []*monitoringv1.ThanosRuler
+		static        []string
+		clusterDomain string
+		expected      []string
+	}{
+		{
+			name: "no objects",
+		},
+		{
+			name: "static endpoints only",
+			static: []string{
+				"thanos-store.monitoring.svc:10901",
+				"thanos-store.monitoring.svc:10901",
+				"dnssrv+_grpc._tcp.thanos-receive.monitoring.svc",
+			},
+			expected: []string{
+				"dnssrv+_grpc._tcp.thanos-receive.monitoring.svc",
+				"thanos-store.monitoring.svc:10901",
+			},
+		},
+		{
+			name: "Prometheus without Thanos sidecar is ignored",
+			prometheuses: []*monitoringv1.Prometheus{
+				prometheus("default", "k8s", false, nil),
+			},
+		},
+		{
+			name: "Prometheus and ThanosRuler objects",
+			prometheuses: []*monitoringv1.Prometheus{
+				prometheus("monitoring", "k8s", true, nil),
+				prometheus("monitoring", "other", true, nil),
+				prometheus("team-a", "custom", true, ptr.To("prometheus-custom")),
+			},
+			thanosRulers: []*monitoringv1.ThanosRuler{
+				thanosRuler("monitoring", "rules", nil),
+				thanosRuler("team-a", "rules", ptr.To("thanos-ruler-custom")),
+			},
+			static: []string{"thanos-store.monitoring.svc:10901"},
+			expected: []string{
+				"dnssrv+_grpc._tcp.prometheus-custom.team-a.svc",
+				"dnssrv+_grpc._tcp.prometheus-operated.monitoring.svc",
+				"dnssrv+_grpc._tcp.thanos-ruler-custom.team-a.svc",
+				"dnssrv+_grpc._tcp.thanos-ruler-operated.monitoring.svc",
+				"thanos-store.monitoring.svc:10901",
+			},
+		},
+		{
+			name: "with cluster domain",
+			prometheuses: []*monitoringv1.Prometheus{
+				prometheus("monitoring", "k8s", true, nil),
+			},
+			clusterDomain: "cluster.local",
+			expected: []string{
+				"dnssrv+_grpc._tcp.prometheus-operated.monitoring.svc.cluster.local.",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoints := makeEndpoints(tc.prometheuses, tc.thanosRulers, tc.static, tc.clusterDomain)
+			if len(tc.expected) == 0 {
+				require.Empty(t, endpoints)
+				return
+			}
+			require.Equal(t, tc.expected, endpoints)
+		})
+	}
+}
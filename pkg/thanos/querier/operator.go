@@ -0,0 +1,695 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/listwatch"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	resyncPeriod              = 5 * time.Minute
+	applicationNameLabelValue = "thanos-querier"
+	controllerName            = "thanos-querier-controller"
+)
+
+// Operator manages the life cycle of the Thanos Query deployments.
+type Operator struct {
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger   *slog.Logger
+	accessor *operator.Accessor
+
+	controllerID string
+
+	querierInfs     *informers.ForResource
+	promInfs        *informers.ForResource
+	thanosRulerInfs *informers.ForResource
+	deplInfs        *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	nsPromInf        cache.SharedIndexInformer
+	nsThanosRulerInf cache.SharedIndexInformer
+
+	metrics         *operator.Metrics
+	reconciliations *operator.ReconciliationTracker
+
+	config Config
+}
+
+// Config defines the operator's parameters for the ThanosQuerier controller.
+// Whenever the value of one of these parameters is changed, it triggers an
+// update of the managed deployments.
+type Config struct {
+	ClusterDomain          string
+	ThanosDefaultBaseImage string
+	Annotations            operator.Map
+	Labels                 operator.Map
+}
+
+// New creates a new controller.
+func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer) (*Operator, error) {
+	logger = logger.With("component", controllerName)
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="thanos-querier" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": "thanos-querier"}, r)
+
+	o := &Operator{
+		kclient:         client,
+		mclient:         mclient,
+		logger:          logger,
+		accessor:        operator.NewAccessor(logger),
+		metrics:         operator.NewMetrics(r),
+		reconciliations: &operator.ReconciliationTracker{},
+		controllerID:    c.ControllerID,
+		config: Config{
+			ClusterDomain:          c.ClusterDomain,
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+		},
+	}
+
+	o.querierInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ThanosQuerierName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosquerier informers: %w", err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.querierInfs,
+		o.metrics,
+		monitoringv1alpha1.ThanosQuerierKind,
+		r,
+		o.controllerID,
+	)
+
+	o.promInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.PrometheusAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.PromSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus informers: %w", err)
+	}
+
+	o.thanosRulerInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = c.ThanosRulerSelector.String()
+			},
+		),
+		monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ThanosRulerName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating thanosruler informers: %w", err)
+	}
+
+	o.deplInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = fmt.Sprintf("%s,%s=%s", operator.ManagedByOperatorLabelSelector(), operator.ApplicationNameLabelKey, applicationNameLabelValue)
+			},
+		),
+		appsv1.SchemeGroupVersion.WithResource("deployments"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deployment informers: %w", err)
+	}
+
+	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
+		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
+			ctx,
+			o.logger,
+			c.KubernetesVersion,
+			o.kclient.CoreV1(),
+			o.kclient.AuthorizationV1().SelfSubjectAccessReviews(),
+			allowList,
+			c.Namespaces.DenyList)
+		if err != nil {
+			return nil, err
+		}
+
+		o.logger.Debug("creating namespace informer", "privileged", privileged)
+		return cache.NewSharedIndexInformer(
+			o.metrics.NewInstrumentedListerWatcher(lw),
+			&v1.Namespace{},
+			resyncPeriod,
+			cache.Indexers{},
+		), nil
+	}
+
+	o.nsPromInf, err = newNamespaceInformer(o, c.Namespaces.PrometheusAllowList)
+	if err != nil {
+		return nil, err
+	}
+
+	if listwatch.IdenticalNamespaces(c.Namespaces.PrometheusAllowList, c.Namespaces.ThanosRulerAllowList) {
+		o.nsThanosRulerInf = o.nsPromInf
+	} else {
+		o.nsThanosRulerInf, err = newNamespaceInformer(o, c.Namespaces.ThanosRulerAllowList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Operator) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{"ThanosQuerier", o.querierInfs},
+		{"Prometheus", o.promInfs},
+		{"ThanosRuler", o.thanosRulerInfs},
+		{"Deployment", o.deplInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, "thanos-querier", o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	for _, inf := range []struct {
+		name     string
+		informer cache.SharedIndexInformer
+	}{
+		{"PrometheusNamespace", o.nsPromInf},
+		{"ThanosRulerNamespace", o.nsThanosRulerInf},
+	} {
+		if !operator.WaitForNamedCacheSync(ctx, "thanos-querier", o.logger.With("informer", inf.name), inf.informer) {
+			return fmt.Errorf("failed to sync cache for %s informer", inf.name)
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *Operator) addHandlers() {
+	o.querierInfs.AddEventHandler(o.rr)
+	o.deplInfs.AddEventHandler(o.rr)
+
+	o.promInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.PrometheusesKind,
+		o.enqueueForPrometheusNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+			),
+		),
+	))
+
+	o.thanosRulerInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		monitoringv1.ThanosRulerKind,
+		o.enqueueForThanosRulerNamespace,
+		operator.WithFilter(
+			operator.AnyFilter(
+				operator.GenerationChanged,
+				operator.LabelsChanged,
+			),
+		),
+	))
+
+	// The controller needs to watch the namespaces in which the Prometheus
+	// and ThanosRuler objects live because a label change on a namespace may
+	// trigger a configuration change.
+	_, _ = o.nsPromInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: o.handleNamespaceUpdate,
+	})
+	if o.nsThanosRulerInf != o.nsPromInf {
+		_, _ = o.nsThanosRulerInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: o.handleNamespaceUpdate,
+		})
+	}
+}
+
+// Run the controller.
+func (o *Operator) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.querierInfs.Start(ctx.Done())
+	go o.promInfs.Start(ctx.Done())
+	go o.thanosRulerInfs.Start(ctx.Done())
+	go o.deplInfs.Start(ctx.Done())
+	go o.nsPromInf.Run(ctx.Done())
+	if o.nsPromInf != o.nsThanosRulerInf {
+		go o.nsThanosRulerInf.Run(ctx.Done())
+	}
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing ThanosQuerier objects.
+	_ = o.querierInfs.ListAll(labels.Everything(), func(obj any) {
+		o.rr.EnqueueForStatus(obj.(*monitoringv1alpha1.ThanosQuerier))
+	})
+
+	o.addHandlers()
+
+	go operator.StatusPoller(ctx, o)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *Operator) Iterate(processFn func(operator.StatusGetter)) {
+	if err := o.querierInfs.ListAll(labels.Everything(), func(o any) {
+		processFn(o.(*monitoringv1alpha1.ThanosQuerier))
+	}); err != nil {
+		o.logger.Error("failed to list ThanosQuerier objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *Operator) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+func (o *Operator) handleNamespaceUpdate(oldo, curo any) {
+	old := oldo.(*v1.Namespace)
+	cur := curo.(*v1.Namespace)
+
+	o.logger.Debug("update handler", "namespace", cur.GetName(), "old", old.ResourceVersion, "cur", cur.ResourceVersion)
+
+	// Periodic resync may resend the Namespace without changes in-between.
+	if old.ResourceVersion == cur.ResourceVersion {
+		return
+	}
+
+	o.logger.Debug("Namespace updated", "namespace", cur.GetName())
+	o.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
+
+	// Check for ThanosQuerier instances selecting Prometheus or ThanosRuler
+	// objects in the namespace.
+	err := o.querierInfs.ListAll(labels.Everything(), func(obj any) {
+		tq := obj.(*monitoringv1alpha1.ThanosQuerier)
+
+		for _, sel := range []*metav1.LabelSelector{
+			tq.Spec.PrometheusNamespaceSelector,
+			tq.Spec.ThanosRulerNamespaceSelector,
+		} {
+			sync, err := k8sutil.LabelSelectionHasChanged(old.Labels, cur.Labels, sel)
+			if err != nil {
+				o.logger.Error(
+					"failed to detect label selection change",
+					"err", err,
+					"name", tq.Name,
+					"namespace", tq.Namespace,
+				)
+				return
+			}
+
+			if sync {
+				o.rr.EnqueueForReconciliation(tq)
+				return
+			}
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuerier instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *Operator) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *Operator) sync(ctx context.Context, key string) error {
+	tq, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosQuerier](o.querierInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil {
+		o.reconciliations.ForgetObject(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if tq.Spec.Paused {
+		return nil
+	}
+
+	// Check if the ThanosQuerier instance is marked for deletion.
+	if o.rr.DeletionInProgress(tq) {
+		o.reconciliations.ForgetObject(key)
+		return nil
+	}
+
+	logger := o.logger.With("key", key)
+	logger.Info("sync thanos-querier")
+
+	endpoints, err := o.selectEndpoints(tq)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k8sutil.CreateOrUpdateService(ctx, o.kclient.CoreV1().Services(tq.Namespace), makeService(tq, o.config)); err != nil {
+		return fmt.Errorf("synchronizing service failed: %w", err)
+	}
+
+	existingDeployment, err := o.getDeploymentFromThanosQuerierKey(key)
+	if err != nil {
+		return err
+	}
+
+	deplClient := o.kclient.AppsV1().Deployments(tq.Namespace)
+	if existingDeployment == nil {
+		deployment, err := makeDeployment(tq, o.config, endpoints, "")
+		if err != nil {
+			return fmt.Errorf("making thanos-querier deployment failed: %w", err)
+		}
+
+		if _, err := deplClient.Create(ctx, deployment, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating thanos-querier deployment failed: %w", err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingDeployment) {
+		return nil
+	}
+
+	newInputHash, err := createInputHash(*tq, o.config, endpoints, existingDeployment.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newInputHash == existingDeployment.Annotations[operator.InputHashAnnotationKey] {
+		logger.Debug("new deployment generation inputs match current, skipping any actions", "hash", newInputHash)
+		return nil
+	}
+
+	deployment, err := makeDeployment(tq, o.config, endpoints, newInputHash)
+	if err != nil {
+		return fmt.Errorf("failed to generate deployment: %w", err)
+	}
+
+	logger.Debug("new hash differs from the existing value", "new", newInputHash, "existing", existingDeployment.Annotations[operator.InputHashAnnotationKey])
+	err = k8sutil.UpdateDeployment(ctx, deplClient, deployment)
+	sErr, ok := err.(*apierrors.StatusError)
+
+	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+		// Gather only reason for failed update
+		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+		for i, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg[i] = cause.Message
+		}
+
+		logger.Info("recreating ThanosQuerier Deployment because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := deplClient.Delete(ctx, deployment.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+			return fmt.Errorf("failed to delete Deployment to avoid forbidden action: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating Deployment failed: %w", err)
+	}
+
+	return nil
+}
+
+// getDeploymentFromThanosQuerierKey returns a copy of the Deployment object
+// corresponding to the ThanosQuerier object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *Operator) getDeploymentFromThanosQuerierKey(key string) (*appsv1.Deployment, error) {
+	keyParts := strings.Split(key, "/")
+	deplName := keyParts[0] + "/" + prefixedName(keyParts[1])
+
+	obj, err := o.deplInfs.Get(deplName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("Deployment not found", "key", deplName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve Deployment from informer: %w", err)
+	}
+
+	return obj.(*appsv1.Deployment).DeepCopy(), nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
+	tq, err := operator.GetObjectFromKey[*monitoringv1alpha1.ThanosQuerier](o.querierInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if tq == nil {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(tq) {
+		return nil
+	}
+
+	deployment, err := o.getDeploymentFromThanosQuerierKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get Deployment: %w", err)
+	}
+
+	if deployment != nil && o.rr.DeletionInProgress(deployment) {
+		return nil
+	}
+
+	reporter, err := operator.NewDeploymentReporter(ctx, o.kclient, deployment)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve deployment state: %w", err)
+	}
+
+	availableCondition := reporter.Update(tq)
+	reconciledCondition := o.reconciliations.GetCondition(key, tq.Generation)
+	tq.Status.Conditions = operator.UpdateConditions(tq.Status.Conditions, availableCondition, reconciledCondition)
+	tq.Status.Paused = tq.Spec.Paused
+
+	endpoints, err := o.selectEndpoints(tq)
+	if err != nil {
+		return err
+	}
+	tq.Status.Endpoints = endpoints
+
+	if _, err = o.mclient.MonitoringV1alpha1().ThanosQueriers(tq.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosQuerier(tq), metav1.ApplyOptions{FieldManager: operator.PrometheusOperatorFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+func createInputHash(tq monitoringv1alpha1.ThanosQuerier, c Config, endpoints []string, ds appsv1.DeploymentSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	ds.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		ThanosQuerierLabels      map[string]string
+		ThanosQuerierAnnotations map[string]string
+		ThanosQuerierGeneration  int64
+		Config                   Config
+		DeploymentSpec           appsv1.DeploymentSpec
+		Endpoints                []string
+	}{
+		ThanosQuerierLabels:      tq.Labels,
+		ThanosQuerierAnnotations: tq.Annotations,
+		ThanosQuerierGeneration:  tq.Generation,
+		Config:                   c,
+		DeploymentSpec:           ds,
+		Endpoints:                endpoints,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+func (o *Operator) enqueueForPrometheusNamespace(nsName string) {
+	o.enqueueForNamespace(o.nsPromInf.GetStore(), nsName, func(tq *monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector {
+		return tq.Spec.PrometheusNamespaceSelector
+	})
+}
+
+func (o *Operator) enqueueForThanosRulerNamespace(nsName string) {
+	o.enqueueForNamespace(o.nsThanosRulerInf.GetStore(), nsName, func(tq *monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector {
+		return tq.Spec.ThanosRulerNamespaceSelector
+	})
+}
+
+// enqueueForNamespace enqueues all ThanosQuerier object keys that belong to
+// the given namespace or select objects in the given namespace.
+func (o *Operator) enqueueForNamespace(store cache.Store, nsName string, nsSelector func(*monitoringv1alpha1.ThanosQuerier) *metav1.LabelSelector) {
+	nsObject, exists, err := store.GetByKey(nsName)
+	if err != nil {
+		o.logger.Error("get namespace to enqueue ThanosQuerier instances failed",
+			"err", err,
+		)
+		return
+	}
+	if !exists {
+		o.logger.Error("get namespace to enqueue ThanosQuerier instances failed: namespace does not exist",
+			"namespace", nsName,
+		)
+		return
+	}
+	ns := nsObject.(*v1.Namespace)
+
+	err = o.querierInfs.ListAll(labels.Everything(), func(obj any) {
+		// Check for ThanosQuerier instances in the namespace.
+		tq := obj.(*monitoringv1alpha1.ThanosQuerier)
+		if tq.Namespace == nsName {
+			o.rr.EnqueueForReconciliation(tq)
+			return
+		}
+
+		sel := nsSelector(tq)
+		if sel == nil {
+			return
+		}
+
+		// Check for ThanosQuerier instances selecting objects in the
+		// namespace.
+		selector, err := metav1.LabelSelectorAsSelector(sel)
+		if err != nil {
+			o.logger.Error("",
+				"err", fmt.Errorf("failed to convert namespace selector: %w", err),
+				"name", tq.Name,
+				"namespace", tq.Namespace,
+				"selector", sel,
+			)
+			return
+		}
+
+		if selector.Matches(labels.Set(ns.Labels)) {
+			o.rr.EnqueueForReconciliation(tq)
+			return
+		}
+	})
+	if err != nil {
+		o.logger.Error("listing all ThanosQuerier instances from cache failed",
+			"err", err,
+		)
+	}
+}
+
+func applyConfigurationFromThanosQuerier(tq *monitoringv1alpha1.ThanosQuerier) *monitoringv1alpha1ac.ThanosQuerierApplyConfiguration {
+	tqac := monitoringv1alpha1ac.ThanosQuerierStatus().
+		WithPaused(tq.Status.Paused).
+		WithReplicas(tq.Status.Replicas).
+		WithAvailableReplicas(tq.Status.AvailableReplicas).
+		WithUpdatedReplicas(tq.Status.UpdatedReplicas).
+		WithUnavailableReplicas(tq.Status.UnavailableReplicas).
+		WithEndpoints(tq.Status.Endpoints...)
+
+	for _, condition := range tq.Status.Conditions {
+		tqac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return monitoringv1alpha1ac.ThanosQuerier(tq.Name, tq.Namespace).WithStatus(tqac)
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var (
+	defaultTestConfig = Config{
+		ThanosDefaultBaseImage: operator.DefaultThanosBaseImage,
+	}
+
+	objStorageConfig = &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "thanos-objstore"},
+		Key:                  "config.yaml",
+	}
+)
+
+func TestStatefulSetLabelingAndAnnotations(t *testing.T) {
+	ts := &monitoringv1alpha1.ThanosStore{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.ThanosStoreKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "ns",
+			Labels:      map[string]string{"testlabel": "testlabelvalue"},
+			Annotations: map[string]string{"testannotation": "testannotationvalue"},
+		},
+		Spec: monitoringv1alpha1.ThanosStoreSpec{
+			CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+				ObjectStorageConfig: objStorageConfig,
+				PodMetadata: &monitoringv1.EmbeddedObjectMetadata{
+					Labels: map[string]string{"podlabel": "value"},
+				},
+			},
+			Replicas: ptr.To(int32(2)),
+		},
+	}
+
+	sset, err := makeStatefulSet(ts, defaultTestConfig, "hash")
+	require.NoError(t, err)
+
+	require.Equal(t, "thanos-store-test", sset.Name)
+	require.Equal(t, map[string]string{
+		"testlabel":                    "testlabelvalue",
+		"managed-by":                   "prometheus-operator",
+		"thanos-store":                 "test",
+		"app.kubernetes.io/instance":   "test",
+		"app.kubernetes.io/managed-by": "prometheus-operator",
+		"app.kubernetes.io/name":       "thanos-store",
+	}, sset.Labels)
+	require.Equal(t, map[string]string{
+		"testannotation":                "testannotationvalue",
+		operator.InputHashAnnotationKey: "hash",
+	}, sset.Annotations)
+
+	require.Equal(t, "value", sset.Spec.Template.Labels["podlabel"])
+	require.Equal(t, "thanos-store", sset.Spec.Template.Annotations[operator.DefaultContainerAnnotationKey])
+	require.Equal(t, int32(2), *sset.Spec.Replicas)
+
+	require.Len(t, sset.OwnerReferences, 1)
+	require.Equal(t, monitoringv1alpha1.ThanosStoreKind, sset.OwnerReferences[0].Kind)
+}
+
+func TestStatefulSetArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1alpha1.ThanosStoreSpec
+		expected []string
+		err      bool
+	}{
+		{
+			name: "no object storage configuration",
+			err:  true,
+		},
+		{
+			name: "object storage secret",
+			spec: monitoringv1alpha1.ThanosStoreSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfig: objStorageConfig,
+				},
+			},
+			expected: []string{
+				"store",
+				"--data-dir=/thanos/data",
+				"--grpc-address=:10901",
+				"--http-address=:10902",
+				"--objstore.config-file=/etc/thanos/config/objstorage-config/config.yaml",
+			},
+		},
+		{
+			name: "time range and additional args",
+			spec: monitoringv1alpha1.ThanosStoreSpec{
+				CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{
+					ObjectStorageConfigFile: ptr.To("/etc/objstore/config.yaml"),
+					AdditionalArgs: []monitoringv1.Argument{
+						{Name: "store.enable-index-header-lazy-reader"},
+					},
+				},
+				MinTime: ptr.To("-4w"),
+				MaxTime: ptr.To("-2h"),
+			},
+			expected: []string{
+				"store",
+				"--data-dir=/thanos/data",
+				"--grpc-address=:10901",
+				"--http-address=:10902",
+				"--objstore.config-file=/etc/objstore/config.yaml",
+				"--min-time=-4w",
+				"--max-time=-2h",
+				"--store.enable-index-header-lazy-reader",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := &monitoringv1alpha1.ThanosStore{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				Spec:       tc.spec,
+			}
+
+			sset, err := makeStatefulSet(ts, defaultTestConfig, "")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, sset.Spec.Template.Spec.Containers, 1)
+			require.Equal(t, tc.expected, sset.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestStatefulSetService(t *testing.T) {
+	ts := &monitoringv1alpha1.ThanosStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+	}
+
+	svc := makeStatefulSetService(ts, defaultTestConfig)
+	require.Equal(t, governingServiceName, svc.Name)
+	require.Equal(t, "None", svc.Spec.ClusterIP)
+	require.Len(t, svc.Spec.Ports, 2)
+	require.Equal(t, "grpc", svc.Spec.Ports[0].Name)
+	require.Equal(t, int32(10901), svc.Spec.Ports[0].Port)
+	require.Equal(t, "web", svc.Spec.Ports[1].Name)
+}
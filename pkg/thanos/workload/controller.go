@@ -0,0 +1,495 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workload implements the controller shared by the Thanos components
+// which are deployed as a StatefulSet reading blocks from object storage
+// (ThanosCompactor and ThanosStore).
+package workload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8sutil"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const resyncPeriod = 5 * time.Minute
+
+// Object is a Thanos custom resource managed by the Controller. It is always
+// a pointer type which can be compared to its zero value.
+type Object interface {
+	comparable
+	runtime.Object
+	operator.StatusGetter
+	operator.GoverningObject
+
+	ObjectStorageFields() *monitoringv1alpha1.CommonThanosObjectStorageFields
+	WorkloadStatus() *monitoringv1alpha1.ThanosWorkloadStatus
+}
+
+// Component describes the Thanos component managed by the Controller.
+type Component[T Object] struct {
+	// Name of the component (e.g. "thanos-compactor"). It is used as the
+	// application name label of the StatefulSets and in the logs and
+	// metrics of the controller.
+	Name string
+	// Kind of the custom resource (e.g. "ThanosCompactor").
+	Kind string
+	// Resource is the plural name of the custom resource.
+	Resource string
+
+	// StatefulSetName returns the name of the StatefulSet from the name of
+	// the object.
+	StatefulSetName func(string) string
+	// MakeStatefulSet generates the StatefulSet of the object.
+	MakeStatefulSet func(T, Config, string) (*appsv1.StatefulSet, error)
+	// MakeService generates the governing service of the object.
+	MakeService func(T, Config) *v1.Service
+	// ApplyStatus applies the status subresource of the object.
+	ApplyStatus func(context.Context, monitoringclient.Interface, T, *monitoringv1alpha1ac.ThanosWorkloadStatusApplyConfiguration) error
+}
+
+// Config defines the operator's parameters for the Thanos controllers.
+// Whenever the value of one of these parameters is changed, it triggers an
+// update of the managed statefulsets.
+type Config struct {
+	ThanosDefaultBaseImage string
+	Annotations            operator.Map
+	Labels                 operator.Map
+}
+
+type controllerOptions struct {
+	canReadStorageClass bool
+}
+
+type ControllerOption func(*controllerOptions)
+
+// WithStorageClassValidation tells that the controller should verify that the
+// spec references a valid StorageClass name.
+func WithStorageClassValidation() ControllerOption {
+	return func(o *controllerOptions) {
+		o.canReadStorageClass = true
+	}
+}
+
+// Controller manages the life cycle of the statefulsets of a Thanos component.
+type Controller[T Object] struct {
+	controllerOptions
+
+	component Component[T]
+
+	kclient kubernetes.Interface
+	mclient monitoringclient.Interface
+
+	logger *slog.Logger
+
+	controllerID string
+
+	objInfs  *informers.ForResource
+	ssetInfs *informers.ForResource
+
+	rr *operator.ResourceReconciler
+
+	metrics         *operator.Metrics
+	reconciliations *operator.ReconciliationTracker
+
+	config Config
+}
+
+// New creates a new controller for the given component.
+func New[T Object](component Component[T], restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Controller[T], error) {
+	logger = logger.With("component", component.Name+"-controller")
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
+	}
+
+	mclient, err := monitoringclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating monitoring client failed: %w", err)
+	}
+
+	// All the metrics exposed by the controller get the controller="<component name>" label.
+	r = prometheus.WrapRegistererWith(prometheus.Labels{"controller": component.Name}, r)
+
+	o := &Controller[T]{
+		component:       component,
+		kclient:         client,
+		mclient:         mclient,
+		logger:          logger,
+		metrics:         operator.NewMetrics(r),
+		reconciliations: &operator.ReconciliationTracker{},
+		controllerID:    c.ControllerID,
+		config: Config{
+			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+			Annotations:            c.Annotations,
+			Labels:                 c.Labels,
+		},
+	}
+	for _, opt := range options {
+		opt(&o.controllerOptions)
+	}
+
+	o.objInfs, err = informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			mclient,
+			resyncPeriod,
+			nil,
+		),
+		monitoringv1alpha1.SchemeGroupVersion.WithResource(component.Resource),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s informers: %w", component.Resource, err)
+	}
+
+	o.rr = operator.NewResourceReconciler(
+		o.logger,
+		o,
+		o.objInfs,
+		o.metrics,
+		component.Kind,
+		r,
+		o.controllerID,
+	)
+
+	o.ssetInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.kclient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = fmt.Sprintf("%s,%s=%s", operator.ManagedByOperatorLabelSelector(), operator.ApplicationNameLabelKey, component.Name)
+			},
+		),
+		appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
+	}
+
+	return o, nil
+}
+
+// waitForCacheSync waits for the informers' caches to be synced.
+func (o *Controller[T]) waitForCacheSync(ctx context.Context) error {
+	for _, infs := range []struct {
+		name                 string
+		informersForResource *informers.ForResource
+	}{
+		{o.component.Kind, o.objInfs},
+		{"StatefulSet", o.ssetInfs},
+	} {
+		for _, inf := range infs.informersForResource.GetInformers() {
+			if !operator.WaitForNamedCacheSync(ctx, o.component.Name, o.logger.With("informer", infs.name), inf.Informer()) {
+				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
+			}
+		}
+	}
+
+	o.logger.Info("successfully synced all caches")
+	return nil
+}
+
+// addHandlers adds the eventhandlers to the informers.
+func (o *Controller[T]) addHandlers() {
+	o.objInfs.AddEventHandler(o.rr)
+	o.ssetInfs.AddEventHandler(o.rr)
+}
+
+// Run the controller.
+func (o *Controller[T]) Run(ctx context.Context) error {
+	go o.rr.Run(ctx)
+	defer o.rr.Stop()
+
+	go o.objInfs.Start(ctx.Done())
+	go o.ssetInfs.Start(ctx.Done())
+	if err := o.waitForCacheSync(ctx); err != nil {
+		return err
+	}
+
+	// Refresh the status of the existing objects.
+	_ = o.objInfs.ListAll(labels.Everything(), func(obj any) {
+		o.rr.EnqueueForStatus(obj.(T))
+	})
+
+	o.addHandlers()
+
+	go operator.StatusPoller(ctx, o)
+
+	o.metrics.Ready().Set(1)
+	<-ctx.Done()
+	return nil
+}
+
+// Iterate implements the operator.StatusReconciler interface.
+func (o *Controller[T]) Iterate(processFn func(operator.StatusGetter)) {
+	if err := o.objInfs.ListAll(labels.Everything(), func(obj any) {
+		processFn(obj.(T))
+	}); err != nil {
+		o.logger.Error("failed to list "+o.component.Kind+" objects", "err", err)
+	}
+}
+
+// RefreshStatusFor implements the operator.StatusReconciler interface.
+func (o *Controller[T]) RefreshStatusFor(obj metav1.Object) {
+	o.rr.EnqueueForStatus(obj)
+}
+
+// Sync implements the operator.Syncer interface.
+func (o *Controller[T]) Sync(ctx context.Context, key string) error {
+	err := o.sync(ctx, key)
+	o.reconciliations.SetStatus(key, err)
+
+	return err
+}
+
+func (o *Controller[T]) sync(ctx context.Context, key string) error {
+	obj, err := operator.GetObjectFromKey[T](o.objInfs, key)
+	if err != nil {
+		return err
+	}
+
+	var zero T
+	if obj == zero {
+		o.reconciliations.ForgetObject(key)
+		// Dependent resources are cleaned up by K8s via OwnerReferences
+		return nil
+	}
+
+	if obj.ObjectStorageFields().Paused {
+		return nil
+	}
+
+	// Check if the object is marked for deletion.
+	if o.rr.DeletionInProgress(obj) {
+		o.reconciliations.ForgetObject(key)
+		return nil
+	}
+
+	logger := o.logger.With("key", key)
+	logger.Info("sync " + o.component.Name)
+
+	if err := operator.CheckStorageClass(ctx, o.canReadStorageClass, o.kclient, obj.ObjectStorageFields().Storage); err != nil {
+		return err
+	}
+
+	// Create governing service if it doesn't exist.
+	if _, err := k8sutil.CreateOrUpdateService(ctx, o.kclient.CoreV1().Services(obj.GetNamespace()), o.component.MakeService(obj, o.config)); err != nil {
+		return fmt.Errorf("synchronizing governing service failed: %w", err)
+	}
+
+	existingStatefulSet, err := o.getStatefulSetFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	ssetClient := o.kclient.AppsV1().StatefulSets(obj.GetNamespace())
+	if existingStatefulSet == nil {
+		sset, err := o.component.MakeStatefulSet(obj, o.config, "")
+		if err != nil {
+			return fmt.Errorf("making %s statefulset failed: %w", o.component.Name, err)
+		}
+
+		operator.SanitizeSTS(sset)
+		if _, err := ssetClient.Create(ctx, sset, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating %s statefulset failed: %w", o.component.Name, err)
+		}
+
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(existingStatefulSet) {
+		return nil
+	}
+
+	newSSetInputHash, err := createSSetInputHash(obj, o.config, existingStatefulSet.Spec)
+	if err != nil {
+		return err
+	}
+
+	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
+		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+		return nil
+	}
+
+	sset, err := o.component.MakeStatefulSet(obj, o.config, newSSetInputHash)
+	if err != nil {
+		return fmt.Errorf("failed to generate statefulset: %w", err)
+	}
+
+	operator.SanitizeSTS(sset)
+
+	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
+	err = k8sutil.UpdateStatefulSet(ctx, ssetClient, sset)
+	sErr, ok := err.(*apierrors.StatusError)
+
+	if ok && sErr.ErrStatus.Code == 422 && sErr.ErrStatus.Reason == metav1.StatusReasonInvalid {
+		o.metrics.StsDeleteCreateCounter().Inc()
+
+		// Gather only reason for failed update
+		failMsg := make([]string, len(sErr.ErrStatus.Details.Causes))
+		for i, cause := range sErr.ErrStatus.Details.Causes {
+			failMsg[i] = cause.Message
+		}
+
+		logger.Info("recreating "+o.component.Kind+" StatefulSet because the update operation wasn't possible", "reason", strings.Join(failMsg, ", "))
+		propagationPolicy := metav1.DeletePropagationForeground
+		if err := ssetClient.Delete(ctx, sset.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}); err != nil {
+			return fmt.Errorf("failed to delete StatefulSet to avoid forbidden action: %w", err)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating StatefulSet failed: %w", err)
+	}
+
+	return nil
+}
+
+// getStatefulSetFromKey returns a copy of the StatefulSet object
+// corresponding to the object identified by key.
+// If the object is not found, it returns a nil pointer without error.
+func (o *Controller[T]) getStatefulSetFromKey(key string) (*appsv1.StatefulSet, error) {
+	keyParts := strings.Split(key, "/")
+	ssetName := keyParts[0] + "/" + o.component.StatefulSetName(keyParts[1])
+
+	obj, err := o.ssetInfs.Get(ssetName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			o.logger.Info("StatefulSet not found", "key", ssetName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve StatefulSet from informer: %w", err)
+	}
+
+	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
+}
+
+// UpdateStatus implements the operator.Syncer interface.
+func (o *Controller[T]) UpdateStatus(ctx context.Context, key string) error {
+	obj, err := operator.GetObjectFromKey[T](o.objInfs, key)
+	if err != nil {
+		return err
+	}
+
+	var zero T
+	if obj == zero {
+		return nil
+	}
+
+	if o.rr.DeletionInProgress(obj) {
+		return nil
+	}
+
+	sset, err := o.getStatefulSetFromKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get StatefulSet: %w", err)
+	}
+
+	if sset != nil && o.rr.DeletionInProgress(sset) {
+		return nil
+	}
+
+	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
+	}
+
+	status := obj.WorkloadStatus()
+	availableCondition := stsReporter.Update(obj)
+	reconciledCondition := o.reconciliations.GetCondition(key, obj.GetGeneration())
+	status.Conditions = operator.UpdateConditions(status.Conditions, availableCondition, reconciledCondition)
+	status.Paused = obj.ObjectStorageFields().Paused
+
+	if err = o.component.ApplyStatus(ctx, o.mclient, obj, applyConfigurationFromStatus(status)); err != nil {
+		return fmt.Errorf("failed to apply status subresource: %w", err)
+	}
+
+	return nil
+}
+
+func createSSetInputHash(obj metav1.Object, c Config, ss appsv1.StatefulSetSpec) (string, error) {
+	// The controller should ignore any changes to RevisionHistoryLimit field because
+	// it may be modified by external actors.
+	ss.RevisionHistoryLimit = nil
+
+	hash, err := hashstructure.Hash(struct {
+		Labels          map[string]string
+		Annotations     map[string]string
+		Generation      int64
+		Config          Config
+		StatefulSetSpec appsv1.StatefulSetSpec
+	}{
+		Labels:          obj.GetLabels(),
+		Annotations:     obj.GetAnnotations(),
+		Generation:      obj.GetGeneration(),
+		Config:          c,
+		StatefulSetSpec: ss,
+	},
+		nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate combined hash: %w", err)
+	}
+
+	return fmt.Sprintf("%d", hash), nil
+}
+
+func applyConfigurationFromStatus(status *monitoringv1alpha1.ThanosWorkloadStatus) *monitoringv1alpha1ac.ThanosWorkloadStatusApplyConfiguration {
+	sac := monitoringv1alpha1ac.ThanosWorkloadStatus().
+		WithPaused(status.Paused).
+		WithReplicas(status.Replicas).
+		WithAvailableReplicas(status.AvailableReplicas).
+		WithUpdatedReplicas(status.UpdatedReplicas).
+		WithUnavailableReplicas(status.UnavailableReplicas)
+
+	for _, condition := range status.Conditions {
+		sac.WithConditions(
+			monitoringv1ac.Condition().
+				WithType(condition.Type).
+				WithStatus(condition.Status).
+				WithLastTransitionTime(condition.LastTransitionTime).
+				WithReason(condition.Reason).
+				WithMessage(condition.Message).
+				WithObservedGeneration(condition.ObservedGeneration),
+		)
+	}
+
+	return sac
+}
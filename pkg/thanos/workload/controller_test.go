@@ -0,0 +1,74 @@
+// Copyright 2025 The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestCreateSSetInputHash(t *testing.T) {
+	ts := &monitoringv1alpha1.ThanosStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test",
+			Namespace:  "ns",
+			Generation: 1,
+		},
+	}
+	config := Config{ThanosDefaultBaseImage: "quay.io/thanos/thanos"}
+
+	hash, err := createSSetInputHash(ts, config, appsv1.StatefulSetSpec{})
+	require.NoError(t, err)
+
+	// Changes to the revision history limit are ignored.
+	other, err := createSSetInputHash(ts, config, appsv1.StatefulSetSpec{RevisionHistoryLimit: ptr.To(int32(5))})
+	require.NoError(t, err)
+	require.Equal(t, hash, other)
+
+	ts.Generation = 2
+	other, err = createSSetInputHash(ts, config, appsv1.StatefulSetSpec{})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+
+	ts.Generation = 1
+	other, err = createSSetInputHash(ts, Config{ThanosDefaultBaseImage: "example.com/thanos"}, appsv1.StatefulSetSpec{})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+}
+
+func TestApplyConfigurationFromStatus(t *testing.T) {
+	tc := &monitoringv1alpha1.ThanosCompactor{
+		Spec: monitoringv1alpha1.ThanosCompactorSpec{
+			CommonThanosObjectStorageFields: monitoringv1alpha1.CommonThanosObjectStorageFields{Paused: true},
+		},
+	}
+	tc.SetReplicas(1)
+	tc.SetAvailableReplicas(1)
+
+	status := tc.WorkloadStatus()
+	status.Paused = tc.ObjectStorageFields().Paused
+
+	sac := applyConfigurationFromStatus(status)
+	require.True(t, *sac.Paused)
+	require.Equal(t, int32(1), *sac.Replicas)
+	require.Equal(t, int32(1), *sac.AvailableReplicas)
+	require.Equal(t, int32(0), *sac.UnavailableReplicas)
+}
@@ -390,6 +390,62 @@ func (f *Framework) CreateOrUpdatePrometheusOperatorWithOpts(
 		return nil, fmt.Errorf("initialize PrometheusAgent v1alpha1 CRD: %w", err)
 	}
 
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ThanosQuerierName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ThanosQueriers(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ThanosQuerier v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ThanosCompactorName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ThanosCompactors(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ThanosCompactor v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ThanosStoreName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ThanosStores(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ThanosStore v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.MonitoringQuotaName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.MonitoringQuotas(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize MonitoringQuota v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ScrapeClassDefinitionName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ScrapeClassDefinitions(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ScrapeClassDefinition v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ReferenceGrantName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ReferenceGrants(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ReferenceGrant v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.AlertmanagerSilenceName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.AlertmanagerSilences(v1.NamespaceAll).List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize AlertmanagerSilence v1alpha1 CRD: %w", err)
+	}
+
+	err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ClusterAlertmanagerConfigName, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return f.MonClientV1alpha1.ClusterAlertmanagerConfigs().List(ctx, opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("initialize ClusterAlertmanagerConfig v1alpha1 CRD: %w", err)
+	}
+
 	if opts.EnableScrapeConfigs {
 		err = f.CreateOrUpdateCRDAndWaitUntilReady(ctx, monitoringv1alpha1.ScrapeConfigName, func(opts metav1.ListOptions) (runtime.Object, error) {
 			return f.MonClientV1alpha1.ScrapeConfigs(v1.NamespaceAll).List(ctx, opts)